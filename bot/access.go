@@ -2,11 +2,13 @@ package bot
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 
 	"github.com/diamondburned/arikawa/v3/discord"
 	"github.com/jxs13/league-discord-bot/internal/discordutils"
+	"github.com/jxs13/league-discord-bot/internal/i18n"
 	"github.com/jxs13/league-discord-bot/sqlc"
 )
 
@@ -17,11 +19,20 @@ const (
 )
 
 var (
-	ErrAccessForbidden = errors.New("access forbidden")
+	ErrAccessForbidden = i18n.Errorf("error.access_forbidden")
 )
 
 type PermissionEnum string
 
+// grantedBy returns all permissions that grant the given permission.
+// Write access implies read access.
+func (p PermissionEnum) grantedBy() []PermissionEnum {
+	if p == READ {
+		return []PermissionEnum{READ, WRITE}
+	}
+	return []PermissionEnum{p}
+}
+
 func (b *Bot) checkAccess(ctx context.Context, q *sqlc.Queries, e *discord.InteractionEvent, permission PermissionEnum, noGuild ...bool) error {
 
 	withGuild := true
@@ -50,15 +61,21 @@ func (b *Bot) checkAccess(ctx context.Context, q *sqlc.Queries, e *discord.Inter
 }
 
 func (b *Bot) hasEventAccess(ctx context.Context, q *sqlc.Queries, e *discord.InteractionEvent, permission PermissionEnum) (bool, error) {
-	if e.Channel.SelfPermissions.Has(discord.PermissionAdministrator) {
+	if e.Channel != nil && e.Channel.SelfPermissions.Has(discord.PermissionAdministrator) {
 		return true, nil
 	}
 
 	if permission == ADMIN {
-		return e.Channel.SelfPermissions.Has(discord.PermissionAdministrator), nil
+		return false, nil
+	}
+
+	// guild interactions only contain the member, the member's roles are part of the event
+	var roleIDs []discord.RoleID
+	if e.Member != nil {
+		roleIDs = e.Member.RoleIDs
 	}
 
-	ok, err := b.hasAccess(ctx, q, e.GuildID, e.User, permission)
+	ok, err := hasAccess(ctx, q, e.GuildID, e.Sender(), roleIDs, permission)
 	if err != nil {
 		return false, fmt.Errorf("error checking access: %w", err)
 	}
@@ -66,26 +83,33 @@ func (b *Bot) hasEventAccess(ctx context.Context, q *sqlc.Queries, e *discord.In
 	return ok, nil
 }
 
-func (b *Bot) hasAccess(ctx context.Context, q *sqlc.Queries, guildID discord.GuildID, user *discord.User, permission PermissionEnum) (bool, error) {
+// hasAccess returns true in case that either the user or one of the given roles was granted the permission.
+func hasAccess(ctx context.Context, q *sqlc.Queries, guildID discord.GuildID, user *discord.User, roleIDs []discord.RoleID, permission PermissionEnum) (bool, error) {
 
-	ok, err := b.hasUserAccess(ctx, q, guildID, user, permission)
-	if err != nil {
-		return false, err
-	}
+	for _, p := range permission.grantedBy() {
+		ok, err := hasUserAccess(ctx, q, guildID, user, p)
+		if err != nil {
+			return false, err
+		}
 
-	if ok {
-		return true, nil
-	}
+		if ok {
+			return true, nil
+		}
 
-	ok, err = b.hasRoleAccess(ctx, q, guildID, user, permission)
-	if err != nil {
-		return false, err
+		ok, err = hasRoleAccess(ctx, q, guildID, roleIDs, p)
+		if err != nil {
+			return false, err
+		}
+
+		if ok {
+			return true, nil
+		}
 	}
 
-	return ok, nil
+	return false, nil
 }
 
-func (b *Bot) hasUserAccess(ctx context.Context, q *sqlc.Queries, guildID discord.GuildID, user *discord.User, permission PermissionEnum) (bool, error) {
+func hasUserAccess(ctx context.Context, q *sqlc.Queries, guildID discord.GuildID, user *discord.User, permission PermissionEnum) (bool, error) {
 	if permission == "" || user == nil {
 		return false, nil
 	}
@@ -96,28 +120,22 @@ func (b *Bot) hasUserAccess(ctx context.Context, q *sqlc.Queries, guildID discor
 		Permission: string(permission),
 	})
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
 		return false, err
 	}
 
 	return i == 1, nil
 }
 
-func (b *Bot) hasRoleAccess(ctx context.Context, q *sqlc.Queries, guildID discord.GuildID, user *discord.User, permission PermissionEnum) (bool, error) {
-	if permission == "" || user == nil {
+func hasRoleAccess(ctx context.Context, q *sqlc.Queries, guildID discord.GuildID, roleIDs []discord.RoleID, permission PermissionEnum) (bool, error) {
+	if permission == "" || len(roleIDs) == 0 {
 		return false, nil
 	}
 
-	member, err := b.state.Member(guildID, user.ID)
-	if err != nil {
-		return false, err
-	}
-
-	if len(member.RoleIDs) == 0 {
-		return false, nil
-	}
-
-	memberRoleIDs := make([]string, 0, len(member.RoleIDs))
-	for _, roleID := range member.RoleIDs {
+	memberRoleIDs := make([]string, 0, len(roleIDs))
+	for _, roleID := range roleIDs {
 		memberRoleIDs = append(memberRoleIDs, roleID.String())
 	}
 
@@ -127,6 +145,9 @@ func (b *Bot) hasRoleAccess(ctx context.Context, q *sqlc.Queries, guildID discor
 		RoleIds:    memberRoleIDs,
 	})
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
 		return false, err
 	}
 
@@ -140,7 +161,7 @@ func (b *Bot) checkGuildEnabled(ctx context.Context, q *sqlc.Queries, guildID di
 	}
 
 	if !int64ToBool(enabled) {
-		return errors.New("bot is disabled until it has sufficient permissions: you can reenable the bot by using the `configure` slash command")
+		return i18n.Errorf("error.bot_disabled")
 	}
 
 	return nil
@@ -150,13 +171,98 @@ func (b *Bot) checkIsGuildChannel(event *discord.InteractionEvent, channelID dis
 	c, err := b.state.Channel(channelID)
 	if err != nil {
 		if discordutils.IsStatus4XX(err) {
-			return errors.New("channel not found or bot not in channel")
+			return i18n.Errorf("error.channel_not_found")
 		}
 		return err
 	}
 
 	if c.GuildID != event.GuildID {
-		return errors.New("channel must be in your server")
+		return i18n.Errorf("error.channel_not_in_guild")
+	}
+	return nil
+}
+
+// checkMatchModeratorAccess allows moderators of the match as well as users with write access.
+func (b *Bot) checkMatchModeratorAccess(ctx context.Context, q *sqlc.Queries, e *discord.InteractionEvent, matchID int64) error {
+	err := b.checkGuildEnabled(ctx, q, e.GuildID)
+	if err != nil {
+		return err
+	}
+
+	ok, err := b.hasEventAccess(ctx, q, e, WRITE)
+	if err != nil {
+		return fmt.Errorf("%w, please contact the owner of the bot", err)
+	}
+	if ok {
+		return nil
+	}
+
+	ok, err = q.IsMatchModerator(ctx, sqlc.IsMatchModeratorParams{
+		MatchID: matchID,
+		UserID:  e.SenderID().String(),
+	})
+	if err != nil {
+		return fmt.Errorf("error checking match moderator: %w", err)
+	}
+	if !ok {
+		return ErrAccessForbidden
+	}
+	return nil
+}
+
+// checkMatchCaptainAccess allows captains of the match teams as well as users with write access.
+func (b *Bot) checkMatchCaptainAccess(ctx context.Context, q *sqlc.Queries, e *discord.InteractionEvent, matchID int64) error {
+	err := b.checkGuildEnabled(ctx, q, e.GuildID)
+	if err != nil {
+		return err
+	}
+
+	ok, err := b.hasEventAccess(ctx, q, e, WRITE)
+	if err != nil {
+		return fmt.Errorf("%w, please contact the owner of the bot", err)
+	}
+	if ok {
+		return nil
+	}
+
+	ok, err = q.IsMatchCaptain(ctx, sqlc.IsMatchCaptainParams{
+		MatchID: matchID,
+		UserID:  e.SenderID().String(),
+	})
+	if err != nil {
+		return fmt.Errorf("error checking match captain: %w", err)
+	}
+	if !ok {
+		return ErrAccessForbidden
+	}
+	return nil
+}
+
+// checkTeamCaptainAccess allows captains of the registered team as well as users with write access.
+func (b *Bot) checkTeamCaptainAccess(ctx context.Context, q *sqlc.Queries, e *discord.InteractionEvent, roleIDStr string) error {
+	err := b.checkGuildEnabled(ctx, q, e.GuildID)
+	if err != nil {
+		return err
+	}
+
+	ok, err := b.hasEventAccess(ctx, q, e, WRITE)
+	if err != nil {
+		return fmt.Errorf("%w, please contact the owner of the bot", err)
+	}
+	if ok {
+		return nil
+	}
+
+	ok, err = q.IsTeamCaptain(ctx, sqlc.IsTeamCaptainParams{
+		GuildID: e.GuildID.String(),
+		RoleID:  roleIDStr,
+		UserID:  e.SenderID().String(),
+	})
+	if err != nil {
+		return fmt.Errorf("error checking team captain: %w", err)
+	}
+	if !ok {
+		return ErrAccessForbidden
 	}
 	return nil
 }
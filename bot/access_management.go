@@ -0,0 +1,262 @@
+package bot
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/diamondburned/arikawa/v3/api"
+	"github.com/diamondburned/arikawa/v3/api/cmdroute"
+	"github.com/diamondburned/arikawa/v3/discord"
+	"github.com/diamondburned/arikawa/v3/utils/json/option"
+	"github.com/jxs13/league-discord-bot/internal/format"
+	"github.com/jxs13/league-discord-bot/internal/i18n"
+	"github.com/jxs13/league-discord-bot/internal/options"
+	"github.com/jxs13/league-discord-bot/sqlc"
+)
+
+// accessTargets returns the optional role and user of an access command.
+// At least one of both must be provided.
+func accessTargets(data cmdroute.CommandData) (roleID discord.RoleID, hasRole bool, userID discord.UserID, hasUser bool, err error) {
+	roleID, hasRole, err = options.OptionalRoleID("role", data.Options)
+	if err != nil {
+		return 0, false, 0, false, err
+	}
+
+	userID, hasUser, err = options.OptionalUserID("user", data.Options)
+	if err != nil {
+		return 0, false, 0, false, err
+	}
+
+	if !hasRole && !hasUser {
+		return 0, false, 0, false, i18n.Errorf("error.access_target_missing")
+	}
+
+	return roleID, hasRole, userID, hasUser, nil
+}
+
+func (b *Bot) commandAccessGrant(ctx context.Context, data cmdroute.CommandData) (resp *api.InteractionResponseData) {
+	var (
+		guildID    = data.Event.GuildID
+		guildIDStr = guildID.String()
+		permission = PermissionEnum(data.Options.Find("level").String())
+	)
+
+	var sb strings.Builder
+	err := b.TxQueries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		err := b.checkAccess(ctx, q, data.Event, ADMIN)
+		if err != nil {
+			return err
+		}
+
+		if permission != READ && permission != WRITE {
+			return i18n.Errorf("error.access_level_invalid", permission)
+		}
+
+		roleID, hasRole, userID, hasUser, err := accessTargets(data)
+		if err != nil {
+			return err
+		}
+
+		if hasRole {
+			err = b.checkRoleIDs(guildID, roleID)
+			if err != nil {
+				return err
+			}
+
+			if permission == WRITE {
+				err = q.AddGuildRoleWriteAccess(ctx, sqlc.AddGuildRoleWriteAccessParams{
+					GuildID: guildIDStr,
+					RoleID:  roleID.String(),
+				})
+			} else {
+				err = q.AddGuildRoleReadAccess(ctx, sqlc.AddGuildRoleReadAccessParams{
+					GuildID: guildIDStr,
+					RoleID:  roleID.String(),
+				})
+			}
+			if err != nil {
+				return fmt.Errorf("failed to grant role access: %w", err)
+			}
+			sb.WriteString(i18n.T(i18n.FromContext(ctx), "access.granted_role", format.MarkdownInlineCodeBlock(string(permission)), roleID.Mention()))
+		}
+
+		if hasUser {
+			err = b.checkUserIDs(guildID, userID)
+			if err != nil {
+				return err
+			}
+
+			if permission == WRITE {
+				err = q.AddGuildUserWriteAccess(ctx, sqlc.AddGuildUserWriteAccessParams{
+					GuildID: guildIDStr,
+					UserID:  userID.String(),
+				})
+			} else {
+				err = q.AddGuildUserAccess(ctx, sqlc.AddGuildUserAccessParams{
+					GuildID: guildIDStr,
+					UserID:  userID.String(),
+				})
+			}
+			if err != nil {
+				return fmt.Errorf("failed to grant user access: %w", err)
+			}
+			sb.WriteString(i18n.T(i18n.FromContext(ctx), "access.granted_user", format.MarkdownInlineCodeBlock(string(permission)), userID.Mention()))
+		}
+
+		log.Printf("user %s granted %s access in guild %s", data.Event.SenderID(), permission, guildIDStr)
+		return nil
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return &api.InteractionResponseData{
+		Content:         option.NewNullableString(sb.String()),
+		Flags:           discord.EphemeralMessage,
+		AllowedMentions: &api.AllowedMentions{ /* none */ },
+	}
+}
+
+func (b *Bot) commandAccessRevoke(ctx context.Context, data cmdroute.CommandData) (resp *api.InteractionResponseData) {
+	guildIDStr := data.Event.GuildID.String()
+
+	var sb strings.Builder
+	err := b.TxQueries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		err := b.checkAccess(ctx, q, data.Event, ADMIN)
+		if err != nil {
+			return err
+		}
+
+		roleID, hasRole, userID, hasUser, err := accessTargets(data)
+		if err != nil {
+			return err
+		}
+
+		// roles and users may not exist anymore, which is why they are not validated here
+		if hasRole {
+			_, err = q.GetGuildRoleAccess(ctx, sqlc.GetGuildRoleAccessParams{
+				GuildID: guildIDStr,
+				RoleID:  roleID.String(),
+			})
+			if err != nil {
+				if !errors.Is(err, sql.ErrNoRows) {
+					return fmt.Errorf("failed to get role access: %w", err)
+				}
+				sb.WriteString(i18n.T(i18n.FromContext(ctx), "access.role_none", roleID.Mention()))
+			} else {
+				err = q.RemoveGuildRoleAccess(ctx, sqlc.RemoveGuildRoleAccessParams{
+					GuildID: guildIDStr,
+					RoleID:  roleID.String(),
+				})
+				if err != nil {
+					return fmt.Errorf("failed to revoke role access: %w", err)
+				}
+				sb.WriteString(i18n.T(i18n.FromContext(ctx), "access.revoked_role", roleID.Mention()))
+			}
+		}
+
+		if hasUser {
+			_, err = q.GetGuildUserAccess(ctx, sqlc.GetGuildUserAccessParams{
+				GuildID: guildIDStr,
+				UserID:  userID.String(),
+			})
+			if err != nil {
+				if !errors.Is(err, sql.ErrNoRows) {
+					return fmt.Errorf("failed to get user access: %w", err)
+				}
+				sb.WriteString(i18n.T(i18n.FromContext(ctx), "access.user_none", userID.Mention()))
+			} else {
+				err = q.RemoveGuildUserAccess(ctx, sqlc.RemoveGuildUserAccessParams{
+					GuildID: guildIDStr,
+					UserID:  userID.String(),
+				})
+				if err != nil {
+					return fmt.Errorf("failed to revoke user access: %w", err)
+				}
+				sb.WriteString(i18n.T(i18n.FromContext(ctx), "access.revoked_user", userID.Mention()))
+			}
+		}
+
+		log.Printf("user %s revoked access in guild %s", data.Event.SenderID(), guildIDStr)
+		return nil
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return &api.InteractionResponseData{
+		Content:         option.NewNullableString(sb.String()),
+		Flags:           discord.EphemeralMessage,
+		AllowedMentions: &api.AllowedMentions{ /* none */ },
+	}
+}
+
+func (b *Bot) commandAccessList(ctx context.Context, data cmdroute.CommandData) (resp *api.InteractionResponseData) {
+	guildIDStr := data.Event.GuildID.String()
+
+	var content string
+	err := b.Queries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		err := b.checkAccess(ctx, q, data.Event, ADMIN)
+		if err != nil {
+			return err
+		}
+
+		roles, err := q.ListGuildRoleAccess(ctx, guildIDStr)
+		if err != nil {
+			return fmt.Errorf("failed to list role access: %w", err)
+		}
+
+		users, err := q.ListGuildUserAccess(ctx, guildIDStr)
+		if err != nil {
+			return fmt.Errorf("failed to list user access: %w", err)
+		}
+
+		content = formatAccessList(i18n.FromContext(ctx), roles, users)
+		return nil
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return &api.InteractionResponseData{
+		Content:         option.NewNullableString(content),
+		Flags:           discord.EphemeralMessage,
+		AllowedMentions: &api.AllowedMentions{ /* none */ },
+	}
+}
+
+func formatAccessList(lang i18n.Language, roles []sqlc.RoleAccess, users []sqlc.UserAccess) string {
+	if len(roles) == 0 && len(users) == 0 {
+		return i18n.T(lang, "access.list_empty")
+	}
+
+	lines := make([]string, 0, len(roles)+len(users)+2)
+	if len(roles) > 0 {
+		lines = append(lines, format.MarkdownFat(i18n.T(lang, "access.roles")))
+		for _, r := range roles {
+			lines = append(lines, fmt.Sprintf("- <@&%s> %s", r.RoleID, format.MarkdownInlineCodeBlock(r.Permission)))
+		}
+	}
+	if len(users) > 0 {
+		lines = append(lines, format.MarkdownFat(i18n.T(lang, "access.users")))
+		for _, u := range users {
+			lines = append(lines, fmt.Sprintf("- <@%s> %s", u.UserID, format.MarkdownInlineCodeBlock(u.Permission)))
+		}
+	}
+
+	var sb strings.Builder
+	for _, line := range lines {
+		// discord messages are limited to 2000 characters
+		if sb.Len()+len(line) > 1900 {
+			sb.WriteString("...")
+			break
+		}
+		sb.WriteString(line)
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
@@ -0,0 +1,96 @@
+package bot
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/diamondburned/arikawa/v3/discord"
+	"github.com/jxs13/league-discord-bot/migrations"
+	"github.com/jxs13/league-discord-bot/sqlc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite"
+)
+
+func newTestQueries(t *testing.T) *sqlc.Queries {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "test.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	err = migrations.Migrate(context.Background(), db)
+	require.NoError(t, err)
+
+	q := sqlc.New(db)
+	t.Cleanup(func() { _ = q.Close() })
+	return q
+}
+
+func TestHasEventAccessGuildInteraction(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		q        = newTestQueries(t)
+		b        = &Bot{}
+		guildID  = discord.GuildID(1)
+		roleID   = discord.RoleID(2)
+		userID   = discord.UserID(3)
+		otherID  = discord.UserID(4)
+		writerID = discord.UserID(5)
+	)
+
+	err := q.AddGuildConfig(ctx, sqlc.AddGuildConfigParams{
+		GuildID:    guildID.String(),
+		Enabled:    1,
+		CategoryID: "10",
+	})
+	require.NoError(t, err)
+
+	err = q.AddGuildRoleWriteAccess(ctx, sqlc.AddGuildRoleWriteAccessParams{
+		GuildID: guildID.String(),
+		RoleID:  roleID.String(),
+	})
+	require.NoError(t, err)
+
+	err = q.AddGuildUserWriteAccess(ctx, sqlc.AddGuildUserWriteAccessParams{
+		GuildID: guildID.String(),
+		UserID:  writerID.String(),
+	})
+	require.NoError(t, err)
+
+	// guild interactions only contain the member, the user field is nil
+	event := func(userID discord.UserID, roleIDs ...discord.RoleID) *discord.InteractionEvent {
+		return &discord.InteractionEvent{
+			GuildID: guildID,
+			Channel: &discord.Channel{},
+			Member: &discord.Member{
+				User:    discord.User{ID: userID},
+				RoleIDs: roleIDs,
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		event      *discord.InteractionEvent
+		permission PermissionEnum
+		want       bool
+	}{
+		{"granted role write", event(userID, roleID), WRITE, true},
+		{"granted role read", event(userID, roleID), READ, true},
+		{"granted role admin", event(userID, roleID), ADMIN, false},
+		{"granted user write", event(writerID), WRITE, true},
+		{"no grant", event(otherID, discord.RoleID(6)), WRITE, false},
+		{"no roles", event(otherID), READ, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, err := b.hasEventAccess(ctx, q, tt.event, tt.permission)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, ok)
+		})
+	}
+}
@@ -7,17 +7,25 @@ import (
 	"fmt"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/diamondburned/arikawa/v3/api"
 	"github.com/diamondburned/arikawa/v3/api/cmdroute"
 	"github.com/diamondburned/arikawa/v3/discord"
 	"github.com/diamondburned/arikawa/v3/utils/json/option"
+	"github.com/jxs13/league-discord-bot/internal/calendar"
 	"github.com/jxs13/league-discord-bot/internal/format"
+	"github.com/jxs13/league-discord-bot/internal/i18n"
 	"github.com/jxs13/league-discord-bot/internal/options"
 	"github.com/jxs13/league-discord-bot/internal/parse"
 	"github.com/jxs13/league-discord-bot/sqlc"
 )
 
+const (
+	MaxAnnouncementsPerGuild  = 10
+	MaxAnnouncementNameLength = 32
+)
+
 func (b *Bot) commandAnnouncementConfiguration(ctx context.Context, data cmdroute.CommandData) (resp *api.InteractionResponseData) {
 	guildID := data.Event.GuildID.String()
 
@@ -28,56 +36,50 @@ func (b *Bot) commandAnnouncementConfiguration(ctx context.Context, data cmdrout
 			return err
 		}
 
-		a, err := q.GetAnnouncement(ctx, guildID)
-		if err != nil {
-			if errors.Is(err, sql.ErrNoRows) {
-				content = "No announcements configured for this server."
-				return nil
+		var announcements []sqlc.Announcement
+		if o := data.Options.Find("announcement_name"); o.Type != 0 {
+			a, err := q.GetAnnouncement(ctx, sqlc.GetAnnouncementParams{
+				GuildID: guildID,
+				Name:    o.String(),
+			})
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return i18n.Errorf("error.announcement_not_found", o.String())
+				}
+				return err
+			}
+			announcements = append(announcements, a)
+		} else {
+			announcements, err = q.ListGuildAnnouncements(ctx, guildID)
+			if err != nil {
+				return err
 			}
-			return err
 		}
 
-		targetChannelID, err := parse.ChannelID(a.ChannelID)
-		if err != nil {
-			return err
+		if len(announcements) == 0 {
+			content = i18n.T(i18n.FromContext(ctx), "announcement.none")
+			return nil
 		}
 
-		interval := time.Duration(a.Interval) * time.Second
-
-		startsAt := time.Unix(a.StartsAt, 0)
-		endAt := time.Unix(a.EndsAt, 0)
-
 		var sb strings.Builder
-		sb.WriteString("Announcements are configured for this server:\n")
-		sb.WriteString("announcement_channel: ")
-		sb.WriteString(targetChannelID.Mention())
-		sb.WriteString("\n")
-		sb.WriteString("starts_at: ")
-		sb.WriteString(format.DiscordLongDateTime(startsAt))
-		sb.WriteString("\n")
-		sb.WriteString("ends_at: ")
-		sb.WriteString(format.DiscordLongDateTime(endAt))
-		sb.WriteString("\n")
-		sb.WriteString("interval: ")
-		sb.WriteString(format.MarkdownInlineCodeBlock(interval.String()))
-		sb.WriteString("\n")
-
-		if a.CustomTextBefore != "" {
-			sb.WriteString("custom_text_before: ")
-			sb.WriteString(format.MarkdownInlineCodeBlock(a.CustomTextBefore))
-			sb.WriteString("\n")
-		}
-		if a.CustomTextAfter != "" {
-			sb.WriteString("custom_text_after: ")
-			sb.WriteString(format.MarkdownInlineCodeBlock(a.CustomTextAfter))
+		sb.WriteString(i18n.T(i18n.FromContext(ctx), "announcement.configured"))
+		for _, a := range announcements {
+			text, err := formatAnnouncementConfiguration(a)
+			if err != nil {
+				return err
+			}
 			sb.WriteString("\n")
+			sb.WriteString(text)
 		}
-		content = sb.String()
 
+		content = sb.String()
+		if len(content) > 2000 {
+			content = content[:2000-3] + "..."
+		}
 		return nil
 	})
 	if err != nil {
-		return errorResponse(err)
+		return errorResponse(ctx, err)
 	}
 
 	return &api.InteractionResponseData{
@@ -86,6 +88,68 @@ func (b *Bot) commandAnnouncementConfiguration(ctx context.Context, data cmdrout
 	}
 }
 
+func formatAnnouncementConfiguration(a sqlc.Announcement) (string, error) {
+	targetChannelID, err := parse.ChannelID(a.ChannelID)
+	if err != nil {
+		return "", err
+	}
+
+	startsAt := time.Unix(a.StartsAt, 0)
+	endAt := time.Unix(a.EndsAt, 0)
+	nextAt := time.Unix(a.NextAnnouncedAt, 0)
+
+	var sb strings.Builder
+	sb.WriteString("announcement_name: ")
+	sb.WriteString(format.MarkdownInlineCodeBlock(a.Name))
+	sb.WriteString("\n")
+	sb.WriteString("announcement_channel: ")
+	sb.WriteString(targetChannelID.Mention())
+	sb.WriteString("\n")
+	if a.Division != "" {
+		sb.WriteString("division_name: ")
+		sb.WriteString(format.MarkdownInlineCodeBlock(a.Division))
+		sb.WriteString("\n")
+	}
+	sb.WriteString("starts_at: ")
+	sb.WriteString(format.DiscordLongDateTime(startsAt))
+	sb.WriteString("\n")
+	sb.WriteString("ends_at: ")
+	sb.WriteString(format.DiscordLongDateTime(endAt))
+	sb.WriteString("\n")
+	if a.Schedule != "" {
+		sb.WriteString("schedule: ")
+		sb.WriteString(format.MarkdownInlineCodeBlock(a.Schedule))
+		sb.WriteString("\n")
+	} else {
+		interval := time.Duration(a.Interval) * time.Second
+		sb.WriteString("interval: ")
+		sb.WriteString(format.MarkdownInlineCodeBlock(interval.String()))
+		sb.WriteString("\n")
+	}
+	if a.Location != "" {
+		sb.WriteString("location: ")
+		sb.WriteString(format.MarkdownInlineCodeBlock(a.Location))
+		sb.WriteString("\n")
+	}
+	if !nextAt.After(endAt) {
+		sb.WriteString("next_announcement: ")
+		sb.WriteString(format.DiscordLongDateTime(nextAt))
+		sb.WriteString("\n")
+	}
+
+	if a.CustomTextBefore != "" {
+		sb.WriteString("custom_text_before: ")
+		sb.WriteString(format.MarkdownInlineCodeBlock(a.CustomTextBefore))
+		sb.WriteString("\n")
+	}
+	if a.CustomTextAfter != "" {
+		sb.WriteString("custom_text_after: ")
+		sb.WriteString(format.MarkdownInlineCodeBlock(a.CustomTextAfter))
+		sb.WriteString("\n")
+	}
+	return sb.String(), nil
+}
+
 func (b *Bot) commandAnnouncementsDisable(ctx context.Context, data cmdroute.CommandData) (resp *api.InteractionResponseData) {
 	guildID := data.Event.GuildID.String()
 
@@ -96,25 +160,31 @@ func (b *Bot) commandAnnouncementsDisable(ctx context.Context, data cmdroute.Com
 			return err
 		}
 
-		_, err = q.GetAnnouncement(ctx, guildID)
+		name := data.Options.Find("announcement_name").String()
+		_, err = q.GetAnnouncement(ctx, sqlc.GetAnnouncementParams{
+			GuildID: guildID,
+			Name:    name,
+		})
 		if err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
-				content = "No announcements configured for this server."
-				return nil
+				return i18n.Errorf("error.announcement_not_found", name)
 			}
 			return err
 		}
 
-		err = q.DeleteAnnouncement(ctx, guildID)
+		err = q.DeleteAnnouncement(ctx, sqlc.DeleteAnnouncementParams{
+			GuildID: guildID,
+			Name:    name,
+		})
 		if err != nil {
 			return err
 		}
-		content = "Announcements disabled for this server."
+		content = i18n.T(i18n.FromContext(ctx), "announcement.disabled", format.MarkdownInlineCodeBlock(name))
 
 		return b.refreshAnnouncementJob(ctx, q)
 	})
 	if err != nil {
-		return errorResponse(err)
+		return errorResponse(ctx, err)
 	}
 
 	return &api.InteractionResponseData{
@@ -132,6 +202,31 @@ func (b *Bot) commandAnnouncementsEnable(ctx context.Context, data cmdroute.Comm
 			return err
 		}
 
+		guildID := data.Event.GuildID.String()
+		name := strings.TrimSpace(data.Options.Find("announcement_name").String())
+		if name == "" || utf8.RuneCountInString(name) > MaxAnnouncementNameLength {
+			return i18n.Errorf("error.announcement_name_length", MaxAnnouncementNameLength)
+		}
+
+		_, err = q.GetAnnouncement(ctx, sqlc.GetAnnouncementParams{
+			GuildID: guildID,
+			Name:    name,
+		})
+		exists := err == nil
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("error getting announcement: %w", err)
+		}
+
+		if !exists {
+			cnt, err := q.CountGuildAnnouncements(ctx, guildID)
+			if err != nil {
+				return fmt.Errorf("error counting announcements: %w", err)
+			}
+			if cnt >= MaxAnnouncementsPerGuild {
+				return i18n.Errorf("error.announcement_limit", MaxAnnouncementsPerGuild)
+			}
+		}
+
 		targetChannelID, err := options.ChannelID("announcement_channel", data.Options)
 		if err != nil {
 			return err
@@ -142,7 +237,25 @@ func (b *Bot) commandAnnouncementsEnable(ctx context.Context, data cmdroute.Comm
 			return err
 		}
 
-		interval, err := options.Duration("interval", time.Minute, 8760*time.Hour, data.Options)
+		interval, intervalOk, err := options.DurationOption("interval", time.Minute, 8760*time.Hour, data.Options)
+		if err != nil {
+			return err
+		}
+
+		expr := strings.TrimSpace(data.Options.Find("schedule").String())
+		if intervalOk == (expr != "") {
+			return i18n.Errorf("error.announcement_schedule_or_interval")
+		}
+
+		var sched calendar.Schedule = calendar.Interval(interval)
+		if expr != "" {
+			sched, err = calendar.Parse(expr)
+			if err != nil {
+				return err
+			}
+		}
+
+		loc, err := parse.Location(data.Options.Find("location").String())
 		if err != nil {
 			return err
 		}
@@ -168,35 +281,60 @@ func (b *Bot) commandAnnouncementsEnable(ctx context.Context, data cmdroute.Comm
 		}
 
 		if startsAt.After(endsAt) {
-			return errors.New("starts_at must be before ends_at")
+			return i18n.Errorf("error.announcement_range")
+		}
+
+		division, err := divisionOption(ctx, q, guildID, divisionNameOptionName, data.Options)
+		if err != nil {
+			return err
+		}
+
+		// a fixed interval starts exactly at starts_at, calendar schedules at their first occurrence from then on
+		firstAt := startsAt
+		if expr != "" {
+			firstAt = sched.Next(startsAt.Add(-time.Second))
+		}
+		if firstAt.After(endsAt) {
+			return i18n.Errorf("error.announcement_schedule_range", expr)
 		}
 
 		customTextBefore := data.Options.Find("custom_text_before").String()
 		customTextAfter := data.Options.Find("custom_text_after").String()
 
 		err = q.AddAnnouncement(ctx, sqlc.AddAnnouncementParams{
-			GuildID:          data.Event.GuildID.String(),
+			GuildID:          guildID,
+			Name:             name,
 			ChannelID:        targetChannelID.String(),
 			Interval:         int64(interval / time.Second),
+			Schedule:         expr,
+			Location:         loc.String(),
 			StartsAt:         startsAt.Unix(),
 			EndsAt:           endsAt.Unix(),
 			CustomTextBefore: customTextBefore,
 			CustomTextAfter:  customTextAfter,
-			LastAnnouncedAt:  startsAt.Unix() - int64(interval/time.Second), // needs to be correcty initialized
+			LastAnnouncedAt:  firstAt.Unix() - int64(interval/time.Second),
+			NextAnnouncedAt:  firstAt.Unix(),
+			Division:         division,
 		})
 		if err != nil {
 			return err
 		}
 
-		content = fmt.Sprintf(
-			"Announcements enabled for this server. First announcement will be at %s in channel %s",
-			format.DiscordLongDateTime(startsAt),
+		key := "announcement.enabled"
+		if exists {
+			key = "announcement.updated"
+		}
+		content = i18n.T(
+			i18n.FromContext(ctx),
+			key,
+			format.MarkdownInlineCodeBlock(name),
+			format.DiscordLongDateTime(firstAt),
 			targetChannelID.Mention(),
 		)
 		return b.refreshJobSchedules(ctx, q)
 	})
 	if err != nil {
-		return errorResponse(err)
+		return errorResponse(ctx, err)
 	}
 
 	return &api.InteractionResponseData{
@@ -204,3 +342,26 @@ func (b *Bot) commandAnnouncementsEnable(ctx context.Context, data cmdroute.Comm
 		Content: option.NewNullableString(content),
 	}
 }
+
+// announcementSchedule returns the schedule of the announcement and the location in which it is evaluated.
+// Announcements without a calendar schedule recur at their fixed interval.
+func announcementSchedule(a sqlc.Announcement) (calendar.Schedule, *time.Location, error) {
+	loc := time.Local
+	if a.Location != "" {
+		l, err := parse.Location(a.Location)
+		if err != nil {
+			return nil, nil, err
+		}
+		loc = l
+	}
+
+	if a.Schedule == "" {
+		return calendar.Interval(time.Duration(a.Interval) * time.Second), loc, nil
+	}
+
+	sched, err := calendar.Parse(a.Schedule)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sched, loc, nil
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 	"time"
 
@@ -13,6 +14,8 @@ import (
 	"github.com/diamondburned/arikawa/v3/discord"
 	"github.com/jxs13/league-discord-bot/internal/discordutils"
 	"github.com/jxs13/league-discord-bot/internal/format"
+	"github.com/jxs13/league-discord-bot/internal/i18n"
+	"github.com/jxs13/league-discord-bot/internal/msgtemplate"
 	"github.com/jxs13/league-discord-bot/internal/parse"
 	"github.com/jxs13/league-discord-bot/sqlc"
 )
@@ -56,7 +59,7 @@ func (b *Bot) asyncAnnouncements() (err error) {
 func (b *Bot) sendGuildAnnouncement(ctx context.Context, q *sqlc.Queries, announcement sqlc.Announcement) (err error) {
 	defer func() {
 		if err != nil {
-			err = fmt.Errorf("error sending match pre announcement for guild %s and channel %s: %w", announcement.GuildID, announcement.ChannelID, err)
+			err = fmt.Errorf("error sending match pre announcement %s for guild %s and channel %s: %w", announcement.Name, announcement.GuildID, announcement.ChannelID, err)
 		}
 	}()
 
@@ -65,7 +68,16 @@ func (b *Bot) sendGuildAnnouncement(ctx context.Context, q *sqlc.Queries, announ
 		return fmt.Errorf("error parsing channel ID: %w", err)
 	}
 
-	msgs, ok, err := b.generateGuildAnnouncement(ctx, q, announcement)
+	sched, loc, err := announcementSchedule(announcement)
+	if err != nil {
+		return fmt.Errorf("error parsing schedule: %w", err)
+	}
+
+	// the announcement covers all matches until its next point in time
+	intervalStart := time.Unix(announcement.NextAnnouncedAt, 0).In(loc)
+	intervalEnd := sched.Next(intervalStart)
+
+	msgs, ok, err := b.generateGuildAnnouncement(ctx, q, announcement, intervalStart, intervalEnd)
 	if err != nil {
 		return err
 	}
@@ -73,26 +85,30 @@ func (b *Bot) sendGuildAnnouncement(ctx context.Context, q *sqlc.Queries, announ
 		// no matches to announce
 		// maybe there are matches next time
 		// for now continue to the next announcement interval.
-		return q.ContinueAnnouncement(ctx, announcement.GuildID)
+		return q.ContinueAnnouncement(ctx, sqlc.ContinueAnnouncementParams{
+			GuildID:         announcement.GuildID,
+			Name:            announcement.Name,
+			NextAnnouncedAt: intervalEnd.Unix(),
+		})
 	}
 
-	for _, text := range msgs {
-		_, err = b.state.SendMessageComplex(targetChannelID, api.SendMessageData{
-			Content: text,
-			Flags:   discord.SuppressEmbeds,
-			AllowedMentions: &api.AllowedMentions{
-				Parse: []api.AllowedMentionType{
-					api.AllowUserMention,
-					api.AllowRoleMention,
-					api.AllowEveryoneMention,
-				},
+	for _, msg := range msgs {
+		msg.AllowedMentions = &api.AllowedMentions{
+			Parse: []api.AllowedMentionType{
+				api.AllowUserMention,
+				api.AllowRoleMention,
+				api.AllowEveryoneMention,
 			},
-		})
+		}
+		_, err = b.state.SendMessageComplex(targetChannelID, msg)
 		if err != nil {
 			if discordutils.IsStatus4XX(err) {
-				// channel not found or bot not in channel, disable preannouncements
-				log.Printf("sending announcement message failed: channel not found or bot not in channel %s: %v", targetChannelID, err)
-				err = q.DeleteAnnouncement(ctx, announcement.GuildID)
+				// channel not found or bot not in channel, disable this preannouncement
+				log.Printf("sending announcement %s message failed: channel not found or bot not in channel %s: %v", announcement.Name, targetChannelID, err)
+				err = q.DeleteAnnouncement(ctx, sqlc.DeleteAnnouncementParams{
+					GuildID: announcement.GuildID,
+					Name:    announcement.Name,
+				})
 				if err != nil {
 					return fmt.Errorf("error deleting pre announcement: %w", err)
 				}
@@ -105,15 +121,43 @@ func (b *Bot) sendGuildAnnouncement(ctx context.Context, q *sqlc.Queries, announ
 		}
 	}
 
-	// move last_announcement to the next point in time which is now but more exact w/o time drift(last_annoncement + interval)
-	return q.ContinueAnnouncement(ctx, announcement.GuildID)
+	// move last_announcement to the point in time which is now but more exact w/o time drift and compute the next one
+	return q.ContinueAnnouncement(ctx, sqlc.ContinueAnnouncementParams{
+		GuildID:         announcement.GuildID,
+		Name:            announcement.Name,
+		NextAnnouncedAt: intervalEnd.Unix(),
+	})
+}
+
+// announcementEntry is a single match of an announcement.
+type announcementEntry struct {
+	// Channel is the mention of the match channel or the match number, in case the channel was not created yet
+	Channel     string
+	ScheduledAt time.Time
+	TeamNames   []string
+	// RatingSuffixes contains the formatted rating of each team, empty for unrated teams
+	RatingSuffixes []string
+	Moderators     []string
+	Streams        []string
+	// Lineups contains the number of confirmed participants of each team, empty without participation requirements
+	Lineups             []int
+	ParticipantsPerTeam int64
 }
 
-func (b *Bot) generateGuildAnnouncement(ctx context.Context, q *sqlc.Queries, announcement sqlc.Announcement) (_ []string, ok bool, err error) {
+// generateGuildAnnouncement renders the matches scheduled between the start and the end of the interval.
+// Dates are shown and grouped in the location of intervalStart.
+// Announcements of a division only contain the matches of that division.
+func (b *Bot) generateGuildAnnouncement(
+	ctx context.Context,
+	q *sqlc.Queries,
+	announcement sqlc.Announcement,
+	intervalStart time.Time,
+	intervalEnd time.Time,
+) (_ []api.SendMessageData, ok bool, err error) {
 	matches, err := q.ListGuildMatchesScheduledBetween(ctx, sqlc.ListGuildMatchesScheduledBetweenParams{
 		GuildID: announcement.GuildID,
-		MinAt:   announcement.LastAnnouncedAt + announcement.Interval,
-		MaxAt:   announcement.LastAnnouncedAt + 2*announcement.Interval, // 1st for current time and 2nd for next time
+		MinAt:   intervalStart.Unix(),
+		MaxAt:   intervalEnd.Unix(),
 	})
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -121,18 +165,25 @@ func (b *Bot) generateGuildAnnouncement(ctx context.Context, q *sqlc.Queries, an
 		}
 		return nil, false, err
 	}
+
+	if announcement.Division != "" {
+		matches = slices.DeleteFunc(matches, func(m sqlc.Match) bool {
+			return m.Division != announcement.Division
+		})
+	}
 	if len(matches) == 0 {
 		return nil, false, nil
 	}
 
-	result := make([]string, 0, 1)
-	// we got matches to announce
-	var sb strings.Builder
-	sb.Grow(min(2000, len(announcement.CustomTextAfter)+len(announcement.CustomTextBefore)+len(matches)*256))
-	sb.WriteString(announcement.CustomTextBefore)
+	lang, err := guildLanguage(ctx, q, announcement.GuildID)
+	if err != nil {
+		return nil, false, err
+	}
 
-	intervalStart := time.Unix(announcement.LastAnnouncedAt+announcement.Interval, 0)
-	intervalEnd := time.Unix(announcement.LastAnnouncedAt+2*announcement.Interval, 0)
+	mode, err := guildOutputMode(ctx, q, announcement.GuildID)
+	if err != nil {
+		return nil, false, err
+	}
 
 	startYear, startMonth, startDay := intervalStart.Date()
 	endYear, endMonth, endDay := intervalEnd.Date()
@@ -144,129 +195,214 @@ func (b *Bot) generateGuildAnnouncement(ctx context.Context, q *sqlc.Queries, an
 		formatFunc = format.DiscordLongDate
 	}
 
-	sb.WriteString("\n")
-	sb.WriteString(
-		fmt.Sprintf("**Upcoming matches:**\n\n%s - %s\n",
-			formatFunc(intervalStart),
-			formatFunc(intervalEnd),
-		),
-	)
+	header, err := renderMessage(ctx, q, announcement.GuildID, msgtemplate.KindAnnouncementHeader, msgtemplate.Data{
+		IntervalStart: formatFunc(intervalStart),
+		IntervalEnd:   formatFunc(intervalEnd),
+	})
+	if err != nil {
+		return nil, false, err
+	}
 
+	entries := make([]announcementEntry, 0, len(matches))
 	for _, m := range matches {
-		guildID, err := parse.GuildID(m.GuildID)
+		entry, err := b.announcementEntry(ctx, q, lang, m)
 		if err != nil {
 			return nil, false, err
 		}
+		entry.ScheduledAt = entry.ScheduledAt.In(intervalStart.Location())
+		entries = append(entries, entry)
+	}
 
-		channelID, err := parse.ChannelID(m.ChannelID)
-		if err != nil {
-			return nil, false, err
-		}
+	if mode == outputModeEmbed {
+		return announcementEmbedMessages(lang, announcement, header, entries), true, nil
+	}
 
-		teams, err := b.listMatchTeamRoleIDs(ctx, q, channelID)
-		if err != nil {
-			return nil, false, err
-		}
+	msgs, err := announcementTextMessages(ctx, q, lang, announcement, header, entries)
+	if err != nil {
+		return nil, false, err
+	}
+	return msgs, true, nil
+}
 
-		moderators, err := b.listMatchModeratorUserIDs(ctx, q, channelID)
-		if err != nil {
-			return nil, false, err
-		}
+func (b *Bot) announcementEntry(ctx context.Context, q *sqlc.Queries, lang i18n.Language, m sqlc.Match) (entry announcementEntry, err error) {
+	guildID, err := parse.GuildID(m.GuildID)
+	if err != nil {
+		return entry, err
+	}
+
+	channel, err := matchMention(m)
+	if err != nil {
+		return entry, err
+	}
+
+	teams, err := b.listMatchTeamRoleIDs(ctx, q, m.MatchID)
+	if err != nil {
+		return entry, err
+	}
+
+	moderators, err := b.listMatchModeratorUserIDs(ctx, q, m.MatchID)
+	if err != nil {
+		return entry, err
+	}
 
-		streamers, err := b.listMatchStreamerUserIDs(ctx, q, channelID)
+	streamers, err := b.listMatchStreamerUserIDs(ctx, q, m.MatchID)
+	if err != nil {
+		return entry, err
+	}
+
+	nameMap, err := b.teamNames(ctx, q, guildID, teams)
+	if err != nil {
+		return entry, err
+	}
+
+	entry = announcementEntry{
+		Channel:        channel,
+		ScheduledAt:    time.Unix(m.ScheduledAt, 0),
+		TeamNames:      make([]string, 0, len(teams)),
+		RatingSuffixes: make([]string, 0, len(teams)),
+		Moderators:     make([]string, 0, len(moderators)),
+		Streams:        make([]string, 0, len(streamers)),
+	}
+
+	for _, id := range teams {
+		name, ok := nameMap[id]
+		if !ok {
+			return entry, fmt.Errorf("team %s not found in team name map", id)
+		}
+		ratingSuffix, err := teamRatingSuffix(ctx, q, m.GuildID, id)
 		if err != nil {
-			return nil, false, err
+			return entry, err
 		}
+		entry.TeamNames = append(entry.TeamNames, name)
+		entry.RatingSuffixes = append(entry.RatingSuffixes, ratingSuffix)
+	}
 
-		roleMap, err := b.resolveRoleIDs(guildID, teams)
+	req, err := q.GetParticipationRequirements(ctx, m.MatchID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return entry, fmt.Errorf("error getting participation requirements: %w", err)
+	} else if err == nil && req.ParticipantsPerTeam > 0 {
+		starters, _, err := listLineups(ctx, q, m.MatchID)
 		if err != nil {
-			return nil, false, err
+			return entry, err
 		}
 
-		scheduledAt := time.Unix(m.ScheduledAt, 0)
-		teamNames := make([]string, 0, len(teams))
-
+		entry.ParticipantsPerTeam = req.ParticipantsPerTeam
+		entry.Lineups = make([]int, 0, len(teams))
 		for _, id := range teams {
-			team, ok := roleMap[id]
-			if !ok {
-				return nil, false, fmt.Errorf("team %s not found in role id map", id)
-			}
-			teamNames = append(teamNames, format.MarkdownFat(team.Name))
+			entry.Lineups = append(entry.Lineups, len(starters[id]))
 		}
+	}
 
-		moderatorNames := make([]string, 0, len(moderators))
-		for _, id := range moderators {
-			moderator, err := b.state.Member(guildID, id)
-			if err != nil {
-				if discordutils.IsStatus4XX(err) {
-					// user not found, ignore
-					continue
-				}
-				return nil, false, fmt.Errorf("error getting moderator %s: %w", id, err)
-
+	for _, id := range moderators {
+		moderator, err := b.state.Member(guildID, id)
+		if err != nil {
+			if discordutils.IsStatus4XX(err) {
+				// user not found, ignore
+				continue
 			}
-			moderatorNames = append(moderatorNames, moderator.User.Username)
+			return entry, fmt.Errorf("error getting moderator %s: %w", id, err)
+
+		}
+		entry.Moderators = append(entry.Moderators, moderator.User.Username)
+	}
+
+	for _, s := range streamers {
+		if s.Info.Url == "" {
+			continue
 		}
 
-		streamerLines := make([]string, 0, len(streamers))
-		for _, s := range streamers {
-			if s.Info.Url == "" {
+		streamer, err := b.state.Member(guildID, s.UserID)
+		if err != nil {
+			if discordutils.IsStatus4XX(err) {
+				// user not found, ignore
 				continue
 			}
+			return entry, fmt.Errorf("error getting streamer %s: %w", s.UserID, err)
+		}
 
-			streamer, err := b.state.Member(guildID, s.UserID)
-			if err != nil {
-				if discordutils.IsStatus4XX(err) {
-					// user not found, ignore
-					continue
-				}
-				return nil, false, fmt.Errorf("error getting streamer %s: %w", s.UserID, err)
-			}
+		entry.Streams = append(entry.Streams, i18n.T(lang, "announcement.stream", streamer.User.DisplayName, s.Info.Url))
+	}
+	return entry, nil
+}
 
-			streamerLines = append(streamerLines, fmt.Sprintf("%s at %s", streamer.User.DisplayName, s.Info.Url))
+// announcementTextMessages renders the announcement as plain text which is split into messages of at most 2000 characters.
+func announcementTextMessages(
+	ctx context.Context,
+	q *sqlc.Queries,
+	lang i18n.Language,
+	announcement sqlc.Announcement,
+	header string,
+	entries []announcementEntry,
+) ([]api.SendMessageData, error) {
+	result := make([]string, 0, 1)
+	// we got matches to announce
+	var sb strings.Builder
+	sb.Grow(min(2000, len(announcement.CustomTextAfter)+len(announcement.CustomTextBefore)+len(entries)*256))
+	sb.WriteString(announcement.CustomTextBefore)
+	sb.WriteString("\n")
+	sb.WriteString(header)
+
+	for _, e := range entries {
+		teamNames := make([]string, 0, len(e.TeamNames))
+		for idx, name := range e.TeamNames {
+			teamNames = append(teamNames, format.MarkdownFat(name)+e.RatingSuffixes[idx])
 		}
 
 		var mb strings.Builder
 		mb.Grow(256)
 
-		mb.WriteString("\n")
-		mb.WriteString("* ")
-		mb.WriteString(format.DiscordLongDateTime(scheduledAt))
-		mb.WriteString("\n")
-		if len(teams) > 0 {
-			if len(teams) == 1 {
-				mb.WriteString("Team: ")
+		if len(teamNames) > 0 {
+			if len(teamNames) == 1 {
+				mb.WriteString(i18n.T(lang, "announcement.team"))
 			} else {
-				mb.WriteString("Teams: ")
+				mb.WriteString(i18n.T(lang, "announcement.teams"))
 			}
-			mb.WriteString(strings.Join(teamNames, " vs "))
+			mb.WriteString(strings.Join(teamNames, i18n.T(lang, "announcement.teams_separator")))
+			mb.WriteString("\n")
+		}
+
+		if e.ParticipantsPerTeam > 0 {
+			mb.WriteString(i18n.T(lang, "announcement.lineup"))
+			mb.WriteString(announcementLineups(lang, e))
 			mb.WriteString("\n")
 		}
 
-		if len(moderatorNames) > 0 {
-			if len(moderatorNames) == 1 {
-				mb.WriteString("Moderator: ")
+		if len(e.Moderators) > 0 {
+			if len(e.Moderators) == 1 {
+				mb.WriteString(i18n.T(lang, "announcement.moderator"))
 			} else {
-				mb.WriteString("Moderators: ")
+				mb.WriteString(i18n.T(lang, "announcement.moderators"))
 			}
-			mb.WriteString(strings.Join(moderatorNames, ", "))
+			mb.WriteString(strings.Join(e.Moderators, ", "))
 			mb.WriteString("\n")
 		}
-		if len(streamerLines) > 0 {
-			if len(streamerLines) == 1 {
-				mb.WriteString("Streamer: ")
+		if len(e.Streams) > 0 {
+			if len(e.Streams) == 1 {
+				mb.WriteString(i18n.T(lang, "announcement.streamer"))
 			} else {
-				mb.WriteString("Streamers:\n")
+				mb.WriteString(i18n.T(lang, "announcement.streamers"))
 			}
-			mb.WriteString(strings.Join(streamerLines, "\n"))
+			mb.WriteString(strings.Join(e.Streams, "\n"))
 			mb.WriteString("\n\n")
 		}
 
-		if sb.Len()+len(announcement.CustomTextAfter)+mb.Len() > 2000 {
+		entry, err := renderMessage(ctx, q, announcement.GuildID, msgtemplate.KindAnnouncementMatch, msgtemplate.Data{
+			Channel:     e.Channel,
+			Teams:       strings.Join(teamNames, i18n.T(lang, "announcement.teams_separator")),
+			Moderators:  strings.Join(e.Moderators, ", "),
+			Streamers:   strings.Join(e.Streams, ", "),
+			Roster:      mb.String(),
+			ScheduledAt: format.DiscordLongDateTime(e.ScheduledAt),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if sb.Len()+len(announcement.CustomTextAfter)+len(entry) > 2000 {
 			result = append(result, sb.String())
 			sb.Reset()
 		}
-		sb.WriteString(mb.String())
+		sb.WriteString(entry)
 	}
 
 	if sb.Len()+len(announcement.CustomTextAfter) > 2000 {
@@ -281,5 +417,109 @@ func (b *Bot) generateGuildAnnouncement(ctx context.Context, q *sqlc.Queries, an
 		result = append(result, sb.String())
 	}
 
-	return result, true, nil
+	msgs := make([]api.SendMessageData, 0, len(result))
+	for _, text := range result {
+		msgs = append(msgs, api.SendMessageData{
+			Content: text,
+			Flags:   discord.SuppressEmbeds,
+		})
+	}
+	return msgs, nil
+}
+
+// announcementEmbedMessages renders the announcement with one embed per day.
+// The custom texts and the header are sent as content of the first message.
+func announcementEmbedMessages(
+	lang i18n.Language,
+	announcement sqlc.Announcement,
+	header string,
+	entries []announcementEntry,
+) []api.SendMessageData {
+	embeds := make([]discord.Embed, 0, 1)
+	for idx, e := range entries {
+		year, month, day := e.ScheduledAt.Date()
+		if idx > 0 {
+			prevYear, prevMonth, prevDay := entries[idx-1].ScheduledAt.Date()
+			sameDay := year == prevYear && month == prevMonth && day == prevDay
+			last := &embeds[len(embeds)-1]
+			if sameDay && len(last.Fields) < embedFieldsLimit {
+				last.Fields = append(last.Fields, announcementEmbedField(lang, e))
+				continue
+			}
+		}
+
+		dayStart := time.Date(year, month, day, 0, 0, 0, 0, e.ScheduledAt.Location())
+		embeds = append(embeds, discord.Embed{
+			Description: format.MarkdownFat(format.DiscordLongDate(dayStart)),
+			Color:       embedColor,
+			Fields:      []discord.EmbedField{announcementEmbedField(lang, e)},
+		})
+	}
+
+	content := format.Truncate(announcement.CustomTextBefore+"\n"+header+announcement.CustomTextAfter, 2000)
+	batches := splitEmbeds(embeds)
+	msgs := make([]api.SendMessageData, 0, len(batches))
+	for idx, batch := range batches {
+		msg := api.SendMessageData{
+			Embeds: batch,
+		}
+		if idx == 0 {
+			msg.Content = content
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs
+}
+
+func announcementEmbedField(lang i18n.Language, e announcementEntry) discord.EmbedField {
+	var sb strings.Builder
+	sb.WriteString(format.DiscordShortTime(e.ScheduledAt))
+	sb.WriteString(" (")
+	sb.WriteString(format.DiscordRelativeTime(e.ScheduledAt))
+	sb.WriteString(")\n")
+	sb.WriteString(e.Channel)
+	sb.WriteString("\n")
+
+	if e.ParticipantsPerTeam > 0 {
+		sb.WriteString(i18n.T(lang, "announcement.lineup"))
+		sb.WriteString(announcementLineups(lang, e))
+		sb.WriteString("\n")
+	}
+
+	if len(e.Moderators) > 0 {
+		if len(e.Moderators) == 1 {
+			sb.WriteString(i18n.T(lang, "announcement.moderator"))
+		} else {
+			sb.WriteString(i18n.T(lang, "announcement.moderators"))
+		}
+		sb.WriteString(strings.Join(e.Moderators, ", "))
+		sb.WriteString("\n")
+	}
+	if len(e.Streams) > 0 {
+		if len(e.Streams) == 1 {
+			sb.WriteString(i18n.T(lang, "announcement.streamer"))
+		} else {
+			sb.WriteString(i18n.T(lang, "announcement.streamers"))
+		}
+		sb.WriteString(strings.Join(e.Streams, "\n"))
+	}
+
+	teamNames := make([]string, 0, len(e.TeamNames))
+	for idx, name := range e.TeamNames {
+		teamNames = append(teamNames, name+e.RatingSuffixes[idx])
+	}
+
+	return discord.EmbedField{
+		Name:  format.Truncate(strings.Join(teamNames, i18n.T(lang, "announcement.teams_separator")), embedFieldNameLimit),
+		Value: format.Truncate(sb.String(), embedFieldValueLimit),
+	}
+}
+
+// announcementLineups formats the number of confirmed participants of each team, e.g. 3/5 vs 5/5.
+func announcementLineups(lang i18n.Language, e announcementEntry) string {
+	counts := make([]string, 0, len(e.Lineups))
+	for _, n := range e.Lineups {
+		counts = append(counts, fmt.Sprintf("%d/%d", n, e.ParticipantsPerTeam))
+	}
+	return strings.Join(counts, i18n.T(lang, "announcement.teams_separator"))
 }
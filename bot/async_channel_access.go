@@ -35,6 +35,10 @@ var (
 		discord.PermissionUseSlashCommands
 )
 
+// channelRetryInterval is the delay after which pending match channels are created again,
+// in case a guild reached the maximum number of channels.
+const channelRetryInterval = 10 * time.Minute
+
 func (b *Bot) asyncGrantChannelAccess() (err error) {
 	defer func() {
 		if err != nil {
@@ -42,6 +46,11 @@ func (b *Bot) asyncGrantChannelAccess() (err error) {
 		}
 	}()
 
+	err = b.openAccessibleMatchChannels()
+	if err != nil {
+		return err
+	}
+
 	err = b.TxQueries(b.ctx, func(ctx context.Context, q *sqlc.Queries) error {
 		accessible, err := q.ListNowAccessibleChannels(ctx)
 		if err != nil {
@@ -57,13 +66,18 @@ func (b *Bot) asyncGrantChannelAccess() (err error) {
 		}
 
 		guildAccessible := make(map[discord.GuildID][]*GuildEventParam, len(accessible))
-		orphanedMatches := make([]string, 0)
+		orphanedMatches := make([]int64, 0)
 
 		for _, ac := range accessible {
+			if ac.ChannelID == "" {
+				// the channel could not be created yet
+				continue
+			}
+
 			eventParam, err := b.grantSingleChannelAccess(ctx, q, ac)
 			if err != nil {
 				if discordutils.IsStatus4XX(err) {
-					orphanedMatches = append(orphanedMatches, ac.ChannelID)
+					orphanedMatches = append(orphanedMatches, ac.MatchID)
 					continue
 				}
 
@@ -76,7 +90,7 @@ func (b *Bot) asyncGrantChannelAccess() (err error) {
 
 		if len(orphanedMatches) > 0 {
 			// refreshes the job schedules
-			err = b.deleteOphanedMatches(ctx, q, orphanedMatches...)
+			err = b.archiveOrphanedMatches(ctx, q, orphanedMatches...)
 			if err != nil {
 				return err
 			}
@@ -120,7 +134,89 @@ func (b *Bot) asyncGrantChannelAccess() (err error) {
 	return nil
 }
 
-func (b *Bot) grantSingleChannelAccess(ctx context.Context, q *sqlc.Queries, a sqlc.ListNowAccessibleChannelsRow) (param *GuildEventParam, err error) {
+// openAccessibleMatchChannels creates the channels of the matches whose channel access window opened.
+// Every channel is created in its own transaction, as channels cannot be rolled back.
+// Matches whose channel cannot be created yet, e.g. because the guild reached the maximum number of
+// concurrent matches or its channel limit, stay pending and are retried after channelRetryInterval.
+func (b *Bot) openAccessibleMatchChannels() error {
+	var matches []sqlc.Match
+	err := b.Queries(b.ctx, func(ctx context.Context, q *sqlc.Queries) (err error) {
+		matches, err = q.ListNowAccessibleChannels(ctx)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("error listing accessible matches: %w", err)
+	}
+
+	blocked := false
+	for _, m := range matches {
+		if m.ChannelID != "" {
+			continue
+		}
+
+		err = b.TxQueries(b.ctx, func(ctx context.Context, q *sqlc.Queries) (err error) {
+			guildID, err := parse.GuildID(m.GuildID)
+			if err != nil {
+				return err
+			}
+
+			ok, err := b.matchChannelAvailable(ctx, q, guildID)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				log.Printf("guild %s reached the maximum number of channels, postponing channel of match %d", guildID, m.Number)
+				blocked = true
+				return nil
+			}
+
+			c, err := b.openMatchChannel(ctx, q, m)
+			if err != nil {
+				if discordutils.IsStatus4XX(err) {
+					log.Printf("failed to create channel of match %d in guild %s, retrying later: %v", m.Number, guildID, err)
+					blocked = true
+					return nil
+				}
+				return err
+			}
+			defer func() {
+				if err != nil {
+					// the transaction is rolled back, so the channel must not be kept
+					if err := b.state.DeleteChannel(c.ID, api.AuditLogReason(err.Error())); err != nil {
+						log.Printf("error deleting channel %s: %v", c.ID, err)
+					}
+				}
+			}()
+
+			// the schedule board links the new channel
+			err = b.refreshScheduleBoard(ctx, q, c.GuildID)
+			if err != nil {
+				return err
+			}
+
+			// reminders and deadlines of the match were kept pending until its channel exists
+			err = b.refreshNotificationJob(ctx, q)
+			if err != nil {
+				return err
+			}
+			return b.refreshParticipationRequirementJob(ctx, q)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	b.jobMu.Lock()
+	if blocked {
+		b.channelRetryAt = time.Now().Add(channelRetryInterval).Unix()
+	} else {
+		b.channelRetryAt = 0
+	}
+	b.jobMu.Unlock()
+	return nil
+}
+
+func (b *Bot) grantSingleChannelAccess(ctx context.Context, q *sqlc.Queries, a sqlc.Match) (param *GuildEventParam, err error) {
 	var (
 		channelID = a.ChannelID
 	)
@@ -141,17 +237,17 @@ func (b *Bot) grantSingleChannelAccess(ctx context.Context, q *sqlc.Queries, a s
 		return nil, fmt.Errorf("error getting channel: %w", err)
 	}
 
-	teamRoleIDs, err := b.listMatchTeamRoleIDs(ctx, q, cid)
+	teamRoleIDs, err := b.listMatchTeamRoleIDs(ctx, q, a.MatchID)
 	if err != nil {
 		return nil, fmt.Errorf("error getting match team role IDs: %w", err)
 	}
 
-	modUserIDs, err := b.listMatchModeratorUserIDs(ctx, q, cid)
+	modUserIDs, err := b.listMatchModeratorUserIDs(ctx, q, a.MatchID)
 	if err != nil {
 		return nil, fmt.Errorf("error getting match moderator role IDs: %w", err)
 	}
 
-	streamers, err := b.listMatchStreamerUserIDs(ctx, q, cid)
+	streamers, err := b.listMatchStreamerUserIDs(ctx, q, a.MatchID)
 	if err != nil {
 		return nil, err
 	}
@@ -209,7 +305,7 @@ func (b *Bot) grantSingleChannelAccess(ctx context.Context, q *sqlc.Queries, a s
 	err = q.UpdateMatchChannelAccessibility(
 		ctx,
 		sqlc.UpdateMatchChannelAccessibilityParams{
-			ChannelID:         channelID,
+			MatchID:           a.MatchID,
 			ChannelAccessible: 1,
 		})
 	if err != nil {
@@ -218,6 +314,7 @@ func (b *Bot) grantSingleChannelAccess(ctx context.Context, q *sqlc.Queries, a s
 
 	param = &GuildEventParam{
 		GuildID:          c.GuildID,
+		MatchID:          a.MatchID,
 		ChannelID:        c.ID,
 		ScheduledAt:      a.ScheduledAt,
 		DeleteAt:         a.ChannelDeleteAt,
@@ -230,6 +327,7 @@ func (b *Bot) grantSingleChannelAccess(ctx context.Context, q *sqlc.Queries, a s
 
 type GuildEventParam struct {
 	GuildID          discord.GuildID
+	MatchID          int64
 	ChannelID        discord.ChannelID
 	ScheduledAt      int64
 	DeleteAt         int64
@@ -263,55 +361,30 @@ func (b *Bot) createGuildEvent(ctx context.Context, q *sqlc.Queries, param *Guil
 		return nil
 	}
 
-	teamRoles := make([]*discord.Role, 0, len(param.TeamRoleIDs))
+	nameMap, err := b.teamNames(ctx, q, param.GuildID, param.TeamRoleIDs)
+	if err != nil {
+		return fmt.Errorf("failed to get team names: %w", err)
+	}
+
 	tids := make([]string, 0, len(param.TeamRoleIDs))
+	teamNames := make([]string, 0, len(param.TeamRoleIDs))
 	for _, tid := range param.TeamRoleIDs {
 		tids = append(tids, tid.Mention())
-
-		role, err := b.state.Role(param.GuildID, tid)
-		if err != nil {
-			return fmt.Errorf("failed to get role of team %s: %w", tid, err)
-		}
-		teamRoles = append(teamRoles, role)
+		teamNames = append(teamNames, nameMap[tid])
 	}
 
 	teamMention := strings.Join(tids, " vs ") + "\n\nStreamed by " + streamer.Mention()
-	roleNames := make([]string, 0, len(teamRoles))
-	for _, role := range teamRoles {
-		roleNames = append(roleNames, role.Name)
-	}
-	teamNameMention := strings.Join(roleNames, " vs ")
+	teamNameMention := strings.Join(teamNames, " vs ")
 
 	const reason = "automatically created event because the participating teams were granted access to the match channel"
 	var (
-		now1       = time.Now().Add(time.Minute)
-		now2       = now1.Add(time.Minute)
-		startsAt   = time.Unix(param.ScheduledAt, 0)
-		endsAt     = time.Unix(param.DeleteAt, 0)
-		startsAtTs = discord.NewTimestamp(startsAt)
-		endsAtTs   = discord.NewTimestamp(endsAt)
+		startsAt, endsAt = guildEventTimes(param.ScheduledAt, param.DeleteAt)
+		startsAtTs       = discord.NewTimestamp(startsAt)
+		endsAtTs         = discord.NewTimestamp(endsAt)
 	)
 
-	if startsAt.Before(now1) {
-		// event is in the past, set it to now
-		startsAt = now1
-		startsAtTs = discord.NewTimestamp(now1)
-	}
-
-	if endsAt.Before(now1) {
-		// event is in the past, set it to now
-		endsAt = now2
-		endsAtTs = discord.NewTimestamp(now2)
-	}
-
-	if startsAt.After(endsAt) {
-		// event is in the past, set it to now
-		endsAt = startsAt.Add(time.Minute)
-		endsAtTs = discord.NewTimestamp(endsAt)
-	}
-
 	event, err := b.state.CreateScheduledEvent(param.GuildID, reason, api.CreateScheduledEventData{
-		Name:        fmt.Sprintf("Match: %s", teamNameMention),
+		Name:        eventName(teamNameMention),
 		Description: teamMention,
 		EntityType:  discord.ExternalEntity,
 		EntityMetadata: &discord.EntityMetadata{
@@ -331,8 +404,8 @@ func (b *Bot) createGuildEvent(ctx context.Context, q *sqlc.Queries, param *Guil
 	}
 
 	err = q.UpdateMatchEventID(ctx, sqlc.UpdateMatchEventIDParams{
-		ChannelID: param.ChannelID.String(),
-		EventID:   event.ID.String(),
+		MatchID: param.MatchID,
+		EventID: event.ID.String(),
 	})
 	if err != nil {
 		return fmt.Errorf("failed to set match event ID %s for channel %s: %w", event.ID, param.ChannelID, err)
@@ -347,3 +420,40 @@ func (b *Bot) createGuildEvent(ctx context.Context, q *sqlc.Queries, param *Guil
 
 	return nil
 }
+
+// eventName returns the name of a scheduled event, which discord limits to 100 characters.
+func eventName(teamNames string) string {
+	const maxEventNameLength = 100
+	name := []rune("Match: " + teamNames)
+	if len(name) > maxEventNameLength {
+		return string(name[:maxEventNameLength-1]) + "…"
+	}
+	return string(name)
+}
+
+// guildEventTimes returns the start and end time of a scheduled event.
+// Discord does not allow events in the past, which is why both are moved into the near future if necessary.
+func guildEventTimes(scheduledAt, deleteAt int64) (startsAt, endsAt time.Time) {
+	var (
+		now1 = time.Now().Add(time.Minute)
+		now2 = now1.Add(time.Minute)
+	)
+	startsAt = time.Unix(scheduledAt, 0)
+	endsAt = time.Unix(deleteAt, 0)
+
+	if startsAt.Before(now1) {
+		// event is in the past, set it to now
+		startsAt = now1
+	}
+
+	if endsAt.Before(now1) {
+		// event is in the past, set it to now
+		endsAt = now2
+	}
+
+	if startsAt.After(endsAt) {
+		// event is in the past, set it to now
+		endsAt = startsAt.Add(time.Minute)
+	}
+	return startsAt, endsAt
+}
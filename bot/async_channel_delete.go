@@ -10,6 +10,8 @@ import (
 
 	"github.com/diamondburned/arikawa/v3/api"
 	"github.com/jxs13/league-discord-bot/internal/discordutils"
+	"github.com/jxs13/league-discord-bot/internal/format"
+	"github.com/jxs13/league-discord-bot/internal/i18n"
 	"github.com/jxs13/league-discord-bot/internal/parse"
 	"github.com/jxs13/league-discord-bot/sqlc"
 )
@@ -34,7 +36,8 @@ func (b *Bot) asyncDeleteExpiredChannels() (err error) {
 			return nil
 		}
 
-		orphanedMatches := make([]string, 0)
+		// matches outlive their channels, so that their results remain available
+		archivedMatches := make([]int64, 0, len(deletes))
 		for _, del := range deletes {
 			var (
 				deleteAt    = time.Unix(del.ChannelDeleteAt, 0).Truncate(time.Second)
@@ -59,22 +62,35 @@ func (b *Bot) asyncDeleteExpiredChannels() (err error) {
 				}
 			}
 
+			archivedMatches = append(archivedMatches, del.MatchID)
+			if del.ChannelID == "" {
+				// e.g. cancelled matches whose channel was never created
+				if del.CancelledAt == 0 {
+					// overdue matches must not vanish unnoticed
+					err = b.reportMissedMatch(ctx, q, del)
+					if err != nil {
+						return err
+					}
+				}
+				continue
+			}
+
 			cid, err := parse.ChannelID(del.ChannelID)
 			if err != nil {
 				return err
 			}
 
-			reason := fmt.Sprintf(
-				"Match channel is being deleted due to it's lifetime being reached at %s. The corresponding match was at %s.",
-				deleteAt,
-				scheduledAt,
-			)
+			lang, err := guildLanguage(ctx, q, del.GuildID)
+			if err != nil {
+				return err
+			}
+
+			reason := i18n.T(lang, "match.channel_deleted", deleteAt, scheduledAt)
 			err = b.state.DeleteChannel(cid, api.AuditLogReason(reason))
 			if err != nil {
 				if discordutils.IsStatus4XX(err) {
-					// not found -> delete match manually
-					log.Printf("channel %s not found (%v), adding to orphaned list for deletion", cid, err)
-					orphanedMatches = append(orphanedMatches, del.ChannelID)
+					// not found -> archive match without deleting the channel
+					log.Printf("channel %s not found (%v), archiving match %d", cid, err, del.Number)
 					continue
 				}
 				return err
@@ -87,16 +103,10 @@ func (b *Bot) asyncDeleteExpiredChannels() (err error) {
 			)
 		}
 
-		if len(orphanedMatches) > 0 {
-			err = b.deleteOphanedMatches(ctx, q, orphanedMatches...)
-			if err != nil {
-				return fmt.Errorf("error deleting orphaned matches: %w", err)
-			}
-		} else {
-			err = b.refreshJobSchedules(ctx, q)
-			if err != nil {
-				return err
-			}
+		// refreshes the job schedules
+		err = b.archiveOrphanedMatches(ctx, q, archivedMatches...)
+		if err != nil {
+			return fmt.Errorf("error archiving matches of deleted channels: %w", err)
 		}
 
 		return nil
@@ -109,3 +119,52 @@ func (b *Bot) asyncDeleteExpiredChannels() (err error) {
 	// because it might have been set in the transaction closure
 	return nil
 }
+
+// reportMissedMatch notifies the creator of a match whose lifetime ended before its channel could be created,
+// e.g. because it was overdue when its season was confirmed or the guild had no room for further channels.
+func (b *Bot) reportMissedMatch(ctx context.Context, q *sqlc.Queries, match sqlc.Match) error {
+	log.Printf("match %d in guild %s ended before its channel was created", match.Number, match.GuildID)
+
+	guildID, err := parse.GuildID(match.GuildID)
+	if err != nil {
+		return err
+	}
+
+	userID, err := parse.UserID(match.CreatedBy)
+	if err != nil {
+		return err
+	}
+
+	lang, err := guildLanguage(ctx, q, match.GuildID)
+	if err != nil {
+		return err
+	}
+
+	guildName := guildID.String()
+	if g, err := b.state.Guild(guildID); err == nil {
+		guildName = g.Name
+	}
+
+	dm, err := b.state.CreatePrivateChannel(userID)
+	if err == nil {
+		_, err = b.state.SendMessageComplex(dm.ID, api.SendMessageData{
+			Content: i18n.T(
+				lang,
+				"match.missed",
+				matchName(match.Number),
+				format.MarkdownFat(guildName),
+				format.DiscordLongDateTime(time.Unix(match.ScheduledAt, 0)),
+			),
+			AllowedMentions: &api.AllowedMentions{ /* none */ },
+		})
+	}
+	if err != nil {
+		if discordutils.IsStatus4XX(err) {
+			// users may not accept direct messages
+			log.Printf("failed to notify user %s about missed match %d: %v", userID, match.Number, err)
+			return nil
+		}
+		return fmt.Errorf("error notifying user %s about missed match %d: %w", userID, match.Number, err)
+	}
+	return nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/jxs13/league-discord-bot/internal/discordutils"
 	"github.com/jxs13/league-discord-bot/internal/format"
 	"github.com/jxs13/league-discord-bot/internal/maputils"
+	"github.com/jxs13/league-discord-bot/internal/msgtemplate"
 	"github.com/jxs13/league-discord-bot/internal/parse"
 	"github.com/jxs13/league-discord-bot/sqlc"
 )
@@ -39,68 +40,94 @@ func (b *Bot) asyncNotifications() (err error) {
 			return nil
 		}
 
-		cnm := make(map[discord.ChannelID][]sqlc.Notification, len(notifications))
+		mnm := make(map[int64][]sqlc.Notification, len(notifications))
 		for _, n := range notifications {
-			channelID, err := parse.ChannelID(n.ChannelID)
-			if err != nil {
-				return err
-			}
-			l, ok := cnm[channelID]
+			l, ok := mnm[n.MatchID]
 			if !ok {
 				l = make([]sqlc.Notification, 0, 2)
 			}
 			l = append(l, n)
-			cnm[channelID] = l
+			mnm[n.MatchID] = l
 		}
 
-		orphanedMatches := make([]string, 0)
+		orphanedMatches := make([]int64, 0)
 	outer:
-		for _, channelID := range maputils.SortedKeys(cnm) {
-			notifications := cnm[channelID]
-			channelIDStr := channelID.String()
+		for _, matchID := range maputils.SortedKeys(mnm) {
+			notifications := mnm[matchID]
 
-			match, err := q.GetMatch(ctx, channelIDStr)
+			match, err := q.GetMatch(ctx, matchID)
 			if err != nil {
 				return fmt.Errorf("error getting match: %w", err)
 			}
 
+			if match.ChannelID == "" {
+				// reminders of matches whose channel does not exist yet are kept pending,
+				// only the reminders of archived matches are due without a channel
+				for _, n := range notifications {
+					err = q.DeleteNotification(ctx, sqlc.DeleteNotificationParams{
+						MatchID:  matchID,
+						NotifyAt: n.NotifyAt,
+					})
+					if err != nil {
+						return fmt.Errorf("error deleting notification: %w", err)
+					}
+				}
+				log.Printf("dropped %d notifications of archived match %d", len(notifications), match.Number)
+				continue
+			}
+
+			channelID, err := parse.ChannelID(match.ChannelID)
+			if err != nil {
+				return err
+			}
+
 			// we need to remind the teams, moderators and streamers
-			teamRoleIDs, err := b.listMatchTeamRoleIDs(ctx, q, channelID)
+			teamRoleIDs, err := b.listMatchTeamRoleIDs(ctx, q, matchID)
 			if err != nil {
 				return err
 			}
 
-			modUserIDs, err := b.listMatchModeratorUserIDs(ctx, q, channelID)
+			modUserIDs, err := b.listMatchModeratorUserIDs(ctx, q, matchID)
 			if err != nil {
 				return err
 			}
 
-			streamers, err := b.listMatchStreamerUserIDs(ctx, q, channelID)
+			streamers, err := b.listMatchStreamerUserIDs(ctx, q, matchID)
 			if err != nil {
 				return err
 			}
 			scheduledAt := time.Unix(match.ScheduledAt, 0)
 
+			embed, err := b.reminderEmbed(ctx, q, match.GuildID, matchID)
+			if err != nil {
+				return err
+			}
+
 			for _, n := range notifications {
 
 				var msg api.SendMessageData
 				if n.CustomText == "" {
-					text := ""
 					untilMatch := time.Until(scheduledAt)
-					if untilMatch >= time.Minute {
-						text = fmt.Sprintf("The match is starting in about %s. ", format.Duration(untilMatch))
-					} else {
-						text = "The match is starting now!"
-					}
-
-					msg = FormatNotification(
-						text,
-						"",
+					msg, err = formatNotification(
+						ctx,
+						q,
+						match.GuildID,
+						msgtemplate.KindReminder,
+						msgtemplate.Data{
+							Channel:     channelID.Mention(),
+							ScheduledAt: format.DiscordLongDateTime(scheduledAt),
+							TimeUntil:   format.Duration(untilMatch),
+							StartingNow: untilMatch < time.Minute,
+						},
 						teamRoleIDs,
 						modUserIDs,
 						streamers,
 						nil,
+						embed,
 					)
+					if err != nil {
+						return err
+					}
 				} else {
 					am := AllowedMentions(
 						teamRoleIDs,
@@ -120,8 +147,8 @@ func (b *Bot) asyncNotifications() (err error) {
 				if err != nil {
 					if discordutils.IsStatus4XX(err) {
 						// channel not found -> delete match manually
-						log.Printf("channel %s not found, adding to orphaned list for deletion", channelID)
-						orphanedMatches = append(orphanedMatches, channelIDStr)
+						log.Printf("channel %s not found, adding to orphaned list for archiving", channelID)
+						orphanedMatches = append(orphanedMatches, matchID)
 
 						// we do not need to delete the notifications, because we will delete the match
 						// and everything referencing it, especially all the notifications
@@ -132,8 +159,8 @@ func (b *Bot) asyncNotifications() (err error) {
 				}
 
 				err = q.DeleteNotification(ctx, sqlc.DeleteNotificationParams{
-					ChannelID: channelIDStr,
-					NotifyAt:  n.NotifyAt,
+					MatchID:  matchID,
+					NotifyAt: n.NotifyAt,
 				})
 				if err != nil {
 					return fmt.Errorf("error deleting notification: %w", err)
@@ -149,7 +176,7 @@ func (b *Bot) asyncNotifications() (err error) {
 
 		if len(orphanedMatches) > 0 {
 			// refreshes all jobs
-			err = b.deleteOphanedMatches(ctx, q, orphanedMatches...)
+			err = b.archiveOrphanedMatches(ctx, q, orphanedMatches...)
 			if err != nil {
 				return err
 			}
@@ -170,3 +197,31 @@ func (b *Bot) asyncNotifications() (err error) {
 	// because it might have been set in the transaction closure
 	return nil
 }
+
+// reminderEmbed returns the match card which is attached to generated reminders of guilds
+// that use the embed output mode, otherwise nil.
+func (b *Bot) reminderEmbed(ctx context.Context, q *sqlc.Queries, guildID string, matchID int64) (*discord.Embed, error) {
+	mode, err := guildOutputMode(ctx, q, guildID)
+	if err != nil {
+		return nil, err
+	}
+	if mode != outputModeEmbed {
+		return nil, nil
+	}
+
+	lang, err := guildLanguage(ctx, q, guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	card, err := b.loadMatchCard(ctx, q, matchID)
+	if err != nil {
+		return nil, err
+	}
+	// the access period of the channel and the participation entry are only relevant for the match message
+	card.AccessibleAt, card.DeleteAt = time.Time{}, time.Time{}
+	card.EntryOpen = false
+
+	embed := matchEmbed(lang, card)
+	return &embed, nil
+}
@@ -6,14 +6,17 @@ import (
 	"errors"
 	"fmt"
 	"log"
-	"net/http"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/diamondburned/arikawa/v3/api"
 	"github.com/diamondburned/arikawa/v3/discord"
 	"github.com/jxs13/league-discord-bot/internal/discordutils"
+	"github.com/jxs13/league-discord-bot/internal/format"
+	"github.com/jxs13/league-discord-bot/internal/i18n"
+	"github.com/jxs13/league-discord-bot/internal/msgtemplate"
 	"github.com/jxs13/league-discord-bot/internal/parse"
-	"github.com/jxs13/league-discord-bot/internal/sliceutils"
 	"github.com/jxs13/league-discord-bot/sqlc"
 )
 
@@ -37,18 +40,29 @@ func (b *Bot) asyncCheckParticipationDeadline() (err error) {
 			return nil
 		}
 
-		orphanedMatches := make([]string, 0)
+		orphanedMatches := make([]int64, 0)
 		for _, req := range requirements {
-			err = q.CloseParticipationEntry(ctx, req.ChannelID)
+			err = q.CloseParticipationEntry(ctx, req.MatchID)
 			if err != nil {
 				return fmt.Errorf("error closing participation entry: %w", err)
 			}
 
-			match, err := q.GetMatch(ctx, req.ChannelID)
+			match, err := q.GetMatch(ctx, req.MatchID)
 			if err != nil {
 				return fmt.Errorf("error getting match: %w", err)
 			}
 
+			if match.ChannelID == "" {
+				// deadlines of matches whose channel does not exist yet are deferred,
+				// only the deadlines of archived matches are due without a channel
+				err = q.DeleteMatchNotifications(ctx, match.MatchID)
+				if err != nil {
+					return fmt.Errorf("error deleting match notifications: %w", err)
+				}
+				log.Printf("closed participation entry for archived match %d, deadline at: %s", match.Number, time.Unix(req.DeadlineAt, 0))
+				continue
+			}
+
 			guildID, err := parse.GuildID(match.GuildID)
 			if err != nil {
 				return err
@@ -59,58 +73,63 @@ func (b *Bot) asyncCheckParticipationDeadline() (err error) {
 				return err
 			}
 
-			msgID, err := parse.MessageID(match.MessageID)
+			// the lineups are final, the join and leave buttons are not needed anymore
+			err = b.removeMessageComponents(channelID, match.MessageID)
 			if err != nil {
 				return err
 			}
 
-			teamRoleIDs, err := b.listMatchTeamRoleIDs(ctx, q, channelID)
+			teamRoleIDs, err := b.listMatchTeamRoleIDs(ctx, q, match.MatchID)
 			if err != nil {
 				return err
 			}
 
-			modUserIds, err := b.listMatchModeratorUserIDs(ctx, q, channelID)
+			modUserIds, err := b.listMatchModeratorUserIDs(ctx, q, match.MatchID)
 			if err != nil {
 				return err
 			}
 
-			streamers, err := b.listMatchStreamerUserIDs(ctx, q, channelID)
+			streamers, err := b.listMatchStreamerUserIDs(ctx, q, match.MatchID)
 			if err != nil {
 				return err
 			}
 
-			participants, full, err := b.getConfirmedParticipants(
-				guildID,
-				channelID,
-				msgID,
+			participants, substitutes, full, err := getConfirmedParticipants(
+				ctx,
+				q,
+				match.MatchID,
 				req.ParticipantsPerTeam,
 				teamRoleIDs...,
 			)
 			if err != nil {
-				if discordutils.IsStatus4XX(err) {
-					// channel not found -> delete match manually
-					log.Printf("channel %s or message %s not found, adding to orphaned list for deletion", channelID, msgID)
-					orphanedMatches = append(orphanedMatches, match.ChannelID)
-					continue
-				}
-				return fmt.Errorf("error getting confirmed participants: %w", err)
+				return err
 			}
 			if !full {
 				// delete future all match notifiactions, because the requirements were not met
 				// so the match will not take place
-				err = q.DeleteMatchNotifications(ctx, match.ChannelID)
+				err = q.DeleteMatchNotifications(ctx, match.MatchID)
 				if err != nil {
 					return fmt.Errorf("error deleting match notifications: %w", err)
 				}
 
-				msg := FormatNotification(
-					fmt.Sprintf("Not enough participants for match %s, closing participation entry", channelID.Mention()),
-					"",
+				msg, err := formatNotification(
+					ctx,
+					q,
+					match.GuildID,
+					msgtemplate.KindParticipationFailed,
+					msgtemplate.Data{
+						Channel:     channelID.Mention(),
+						ScheduledAt: format.DiscordLongDateTime(time.Unix(match.ScheduledAt, 0)),
+					},
 					teamRoleIDs,
 					modUserIds,
 					streamers,
 					nil,
+					nil,
 				)
+				if err != nil {
+					return err
+				}
 
 				if match.EventID != "" {
 					// delete scheduled event
@@ -129,34 +148,53 @@ func (b *Bot) asyncCheckParticipationDeadline() (err error) {
 					log.Printf("cancelled scheduled event %s in guild %s, reason: %s", event.ID, guildID, reason)
 				}
 
-				_, err := b.state.SendMessageComplex(channelID, msg)
+				_, err = b.state.SendMessageComplex(channelID, msg)
 				if err != nil {
 					if discordutils.IsStatus4XX(err) {
 						// channel not found -> delete match manually
-						log.Printf("channel %s not found, adding to orphaned list for deletion", channelID)
-						orphanedMatches = append(orphanedMatches, match.ChannelID)
+						log.Printf("channel %s not found, adding to orphaned list for archiving", channelID)
+						orphanedMatches = append(orphanedMatches, match.MatchID)
 						continue
 					}
 					return fmt.Errorf("error sending message: %w", err)
 				}
-				return nil
+				continue
 			}
 
-			msg := FormatNotification(
-				"Closing participation entry, we have reached enough players play the match!",
-				"",
+			lang, err := guildLanguage(ctx, q, match.GuildID)
+			if err != nil {
+				return err
+			}
+
+			msg, err := formatNotification(
+				ctx,
+				q,
+				match.GuildID,
+				msgtemplate.KindParticipationClosed,
+				msgtemplate.Data{
+					Channel:     channelID.Mention(),
+					ScheduledAt: format.DiscordLongDateTime(time.Unix(match.ScheduledAt, 0)),
+					Substitutes: formatSubstitutes(lang, teamRoleIDs, substitutes),
+				},
 				teamRoleIDs,
 				modUserIds,
 				streamers,
 				participants,
+				nil,
 			)
+			if err != nil {
+				return err
+			}
+			for _, rid := range teamRoleIDs {
+				msg.AllowedMentions.Users = append(msg.AllowedMentions.Users, substitutes[rid]...)
+			}
 
 			_, err = b.state.SendMessageComplex(channelID, msg)
 			if err != nil {
 				if discordutils.IsStatus4XX(err) {
 					// channel not found -> delete match manually
-					log.Printf("channel %s not found, adding to orphaned list for deletion", channelID)
-					orphanedMatches = append(orphanedMatches, match.ChannelID)
+					log.Printf("channel %s not found, adding to orphaned list for archiving", channelID)
+					orphanedMatches = append(orphanedMatches, match.MatchID)
 					continue
 				}
 				return fmt.Errorf("error sending message: %w", err)
@@ -167,7 +205,7 @@ func (b *Bot) asyncCheckParticipationDeadline() (err error) {
 
 		if len(orphanedMatches) > 0 {
 			// refreshes all jobs
-			err = b.deleteOphanedMatches(ctx, q, orphanedMatches...)
+			err = b.archiveOrphanedMatches(ctx, q, orphanedMatches...)
 			if err != nil {
 				return err
 			}
@@ -188,13 +226,14 @@ func (b *Bot) asyncCheckParticipationDeadline() (err error) {
 	return nil
 }
 
-func (b *Bot) getConfirmedParticipants(
-	guildID discord.GuildID,
-	channelID discord.ChannelID,
-	messageID discord.MessageID,
+// getConfirmedParticipants returns the starters and the substitutes of all teams and whether all lineups are full.
+func getConfirmedParticipants(
+	ctx context.Context,
+	q *sqlc.Queries,
+	matchID int64,
 	participantsPerTeam int64,
 	teamRoles ...discord.RoleID,
-) (teamParticipants map[discord.RoleID][]discord.UserID, full bool, err error) {
+) (teamParticipants, teamSubstitutes map[discord.RoleID][]discord.UserID, full bool, err error) {
 	defer func() {
 		if err != nil {
 			err = fmt.Errorf("error getting confirmed participants: %w", err)
@@ -202,60 +241,60 @@ func (b *Bot) getConfirmedParticipants(
 	}()
 
 	if len(teamRoles) == 0 {
-		return nil, false, errors.New("no team roles provided")
+		return nil, nil, false, errors.New("no team roles provided")
 	}
-	expectedParticipants := uint(participantsPerTeam) * uint(len(teamRoles))
 
-	// initialize buckets for each team role
-	buckets := make(map[discord.RoleID][]discord.UserID, len(teamRoles))
-	for _, role := range teamRoles {
-		buckets[role] = make([]discord.UserID, 0, expectedParticipants)
-	}
-
-	if expectedParticipants == 0 {
-		// no participants required, return empty buckets
-		return buckets, true, nil
-	}
-
-	users, err := b.state.Reactions(channelID, messageID, ReactionEmoji, 2*expectedParticipants+1) // +1 for the bot
+	starters, substitutes, err := listLineups(ctx, q, matchID)
 	if err != nil {
-		return nil, false, fmt.Errorf("error getting reactions: %w", err)
+		return nil, nil, false, err
 	}
 
-	for _, u := range users {
-		if b.isMe(u.ID) {
-			continue
+	// initialize buckets for each team role
+	teamParticipants = make(map[discord.RoleID][]discord.UserID, len(teamRoles))
+	teamSubstitutes = make(map[discord.RoleID][]discord.UserID, len(teamRoles))
+	full = true
+	for _, role := range teamRoles {
+		members := starters[role]
+		subs := substitutes[role]
+		if len(members) > int(participantsPerTeam) {
+			// surplus starters are the first substitutes
+			subs = append(slices.Clone(members[participantsPerTeam:]), subs...)
+			members = members[:participantsPerTeam]
 		}
-
-		member, err := b.state.Member(guildID, u.ID)
-		if err != nil {
-			if discordutils.IsStatus(err, http.StatusNotFound) {
-				continue
-			}
-			return nil, false, fmt.Errorf("error getting member: %w", err)
+		teamParticipants[role] = members
+		if len(subs) > 0 {
+			teamSubstitutes[role] = subs
 		}
 
-		r, ok := sliceutils.ContainsOne(member.RoleIDs, teamRoles...)
-		if !ok {
-			// not in any of the team roles
-			continue
+		if len(members) < int(participantsPerTeam) {
+			// not enough participants in this team
+			full = false
 		}
+	}
+
+	return teamParticipants, teamSubstitutes, full, nil
+}
+
+// formatSubstitutes lists the substitutes of the teams in the order in which they joined.
+func formatSubstitutes(lang i18n.Language, teamRoleIDs []discord.RoleID, substitutes map[discord.RoleID][]discord.UserID) string {
+	if len(substitutes) == 0 {
+		return ""
+	}
 
-		if len(buckets[r]) == int(participantsPerTeam) {
-			// bucket for that team is full, skip this user
+	var sb strings.Builder
+	sb.WriteString(i18n.T(lang, "roster.substitutes"))
+	for _, rid := range teamRoleIDs {
+		subs, ok := substitutes[rid]
+		if !ok {
 			continue
 		}
-		buckets[r] = append(buckets[r], member.User.ID)
-	}
 
-	full = true
-	for _, b := range buckets {
-		if len(b) < int(participantsPerTeam) {
-			// not enough participants in this team
-			full = false
-			break
+		sb.WriteString(" ")
+		sb.WriteString(rid.Mention())
+		sb.WriteString("\n")
+		for idx, uid := range subs {
+			sb.WriteString(fmt.Sprintf("%d. %s\n", idx+1, uid.Mention()))
 		}
 	}
-
-	return buckets, full, nil
+	return sb.String()
 }
@@ -0,0 +1,228 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/diamondburned/arikawa/v3/discord"
+	"github.com/jxs13/league-discord-bot/internal/discordutils"
+	"github.com/jxs13/league-discord-bot/internal/i18n"
+	"github.com/jxs13/league-discord-bot/internal/parse"
+	"github.com/jxs13/league-discord-bot/sqlc"
+)
+
+// ParticipationReconciliationInterval is the interval in which the participants are reconciled with
+// the participation reactions of the match messages.
+const ParticipationReconciliationInterval = 30 * time.Minute
+
+// asyncReconcileParticipation fixes participants and participation counters which drifted apart from the
+// reactions on the match messages, e.g. because reactions were added or removed while the bot was offline.
+// Only legacy match messages, which were created before the participation buttons, are reconciled.
+// They are recognized by their participants who joined via reaction.
+func (b *Bot) asyncReconcileParticipation() (err error) {
+	defer func() {
+		if err != nil {
+			log.Printf("error in participation reconciliation routine: %v", err)
+		}
+	}()
+
+	var requirements []sqlc.ListOpenLegacyParticipationRequirementsRow
+	err = b.Queries(b.ctx, func(ctx context.Context, q *sqlc.Queries) (err error) {
+		requirements, err = q.ListOpenLegacyParticipationRequirements(ctx)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("error listing open participation requirements: %w", err)
+	}
+
+	for _, req := range requirements {
+		// every match is reconciled on its own, so that a single broken match does not block all others
+		var promotions []*substitutePromotion
+		err = b.TxQueries(b.ctx, func(ctx context.Context, q *sqlc.Queries) (err error) {
+			promotions, err = b.reconcileMatchParticipation(ctx, q, req)
+			return err
+		})
+		if err != nil {
+			if discordutils.IsStatus4XX(err) {
+				// channel or message not found, the match is cleaned up by the participation deadline
+				log.Printf("skipping participation reconciliation of match %s: %v", req.ChannelID, err)
+				continue
+			}
+			return err
+		}
+
+		for _, p := range promotions {
+			b.announcePromotion(p)
+		}
+	}
+	return nil
+}
+
+// reconcileMatchParticipation returns the substitutes which were promoted to the lineups of their teams.
+func (b *Bot) reconcileMatchParticipation(ctx context.Context, q *sqlc.Queries, req sqlc.ListOpenLegacyParticipationRequirementsRow) (promotions []*substitutePromotion, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("failed to reconcile participation of match %s: %w", req.ChannelID, err)
+		}
+	}()
+
+	guildID, err := parse.GuildID(req.GuildID)
+	if err != nil {
+		return nil, err
+	}
+
+	channelID, err := parse.ChannelID(req.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	msgID, err := parse.MessageID(req.MessageID)
+	if err != nil {
+		return nil, err
+	}
+
+	participants, err := q.ListParticipants(ctx, req.MatchID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing participants: %w", err)
+	}
+
+	users, err := b.state.Reactions(channelID, msgID, ReactionEmoji, 0)
+	if err != nil {
+		return nil, fmt.Errorf("error getting reactions: %w", err)
+	}
+
+	var (
+		reacted = make(map[discord.UserID]bool, len(users))
+		joined  = make(map[discord.UserID]bool, len(participants))
+		changed = false
+		now     = time.Now()
+	)
+
+	for _, p := range participants {
+		uid, err := parse.UserID(p.UserID)
+		if err != nil {
+			return nil, err
+		}
+		joined[uid] = true
+	}
+
+	for _, u := range users {
+		if b.isMe(u.ID) {
+			continue
+		}
+		reacted[u.ID] = true
+
+		if joined[u.ID] {
+			continue
+		}
+
+		member, err := b.state.Member(guildID, u.ID)
+		if err != nil {
+			if discordutils.IsStatus(err, http.StatusNotFound) {
+				continue
+			}
+			return nil, fmt.Errorf("error getting member: %w", err)
+		}
+
+		team, err := participationTeamOfMember(ctx, q, req.MatchID, member)
+		if err != nil {
+			var terr *i18n.Error
+			if !errors.As(err, &terr) {
+				return nil, err
+			}
+			if terr.Key == "error.participation_multiple_teams" {
+				// joining the lineup of an arbitrary team would be a guess
+				log.Printf("participation reconciliation: skipping user %s in match %s, who is a member of multiple teams", u.ID, channelID)
+			}
+			// not in any or in multiple of the team roles
+			continue
+		}
+
+		substitute, err := b.joinLineup(ctx, q, sqlc.ParticipationRequirement{
+			MatchID:             req.MatchID,
+			ParticipantsPerTeam: req.ParticipantsPerTeam,
+		}, team.RoleID, u.ID, true, now)
+		if err != nil {
+			return nil, err
+		}
+		log.Printf("participation reconciliation: added missing participant %s to team %s in match %s (substitute: %t)", u.ID, team.RoleID, channelID, substitute)
+		changed = true
+	}
+
+	for _, p := range participants {
+		if !int64ToBool(p.Reaction) {
+			// joined via the participation buttons
+			continue
+		}
+
+		uid, err := parse.UserID(p.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if reacted[uid] {
+			continue
+		}
+
+		// substitutes might have been promoted by a previous removal
+		p, err = q.GetParticipant(ctx, sqlc.GetParticipantParams{
+			MatchID: p.MatchID,
+			UserID:  p.UserID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error getting participant: %w", err)
+		}
+
+		promotion, err := b.leaveLineup(ctx, q, p)
+		if err != nil {
+			return nil, err
+		}
+		if promotion != nil {
+			promotions = append(promotions, promotion)
+		}
+		log.Printf("participation reconciliation: removed participant %s of team %s without reaction in match %s", uid, p.RoleID, channelID)
+		changed = true
+	}
+
+	teams, err := q.ListMatchTeams(ctx, req.MatchID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing match teams: %w", err)
+	}
+
+	for _, t := range teams {
+		cnt, err := q.CountTeamStarters(ctx, sqlc.CountTeamStartersParams{
+			MatchID: t.MatchID,
+			RoleID:  t.RoleID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error counting team starters: %w", err)
+		}
+		if cnt == t.ConfirmedParticipants {
+			continue
+		}
+
+		log.Printf("participation reconciliation: team %s in match %s had %d confirmed participants, but %d starters joined",
+			t.RoleID,
+			channelID,
+			t.ConfirmedParticipants,
+			cnt,
+		)
+		err = q.SetMatchTeamConfirmedParticipants(ctx, sqlc.SetMatchTeamConfirmedParticipantsParams{
+			ConfirmedParticipants: cnt,
+			MatchID:               t.MatchID,
+			RoleID:                t.RoleID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error setting confirmed participants: %w", err)
+		}
+	}
+
+	if !changed {
+		return nil, nil
+	}
+
+	return promotions, b.editMatchMessage(ctx, q, req.MatchID)
+}
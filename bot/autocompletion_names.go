@@ -0,0 +1,81 @@
+package bot
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/diamondburned/arikawa/v3/api"
+	"github.com/diamondburned/arikawa/v3/discord"
+	"github.com/diamondburned/arikawa/v3/gateway"
+	"github.com/jxs13/league-discord-bot/sqlc"
+	"github.com/lithammer/fuzzysearch/fuzzy"
+)
+
+var teamOptionRegex = regexp.MustCompile(`^team(_\d+)?$`)
+
+// isTeamOptionName returns true for options which accept the name of a registered team.
+func isTeamOptionName(name string) bool {
+	return teamOptionRegex.MatchString(name)
+}
+
+// handleAutocompletionNameInteraction completes the names of brackets, swiss tournaments, announcements, divisions and registered teams.
+func (b *Bot) handleAutocompletionNameInteraction(e *gateway.InteractionCreateEvent) {
+	d, ok := e.Data.(*discord.AutocompleteInteraction)
+	if !ok {
+		return
+	}
+	focused := d.Options.Focused()
+
+	if focused.Name != "bracket_name" && focused.Name != "swiss_name" && focused.Name != "announcement_name" && focused.Name != divisionNameOptionName && !isTeamOptionName(focused.Name) {
+		return
+	}
+
+	var names []string
+	err := b.Queries(b.ctx, func(ctx context.Context, q *sqlc.Queries) (err error) {
+		switch {
+		case focused.Name == "bracket_name":
+			names, err = q.ListGuildBracketNames(ctx, e.GuildID.String())
+		case focused.Name == "swiss_name":
+			names, err = q.ListGuildSwissTournamentNames(ctx, e.GuildID.String())
+		case focused.Name == "announcement_name":
+			names, err = q.ListGuildAnnouncementNames(ctx, e.GuildID.String())
+		case focused.Name == divisionNameOptionName:
+			names, err = q.ListGuildDivisionNames(ctx, e.GuildID.String())
+		case isTeamOptionName(focused.Name):
+			var teams []sqlc.RegisteredTeam
+			teams, err = q.ListRegisteredTeams(ctx, e.GuildID.String())
+			for _, t := range teams {
+				names = append(names, t.Name)
+			}
+		}
+		return err
+	})
+	if err != nil {
+		log.Printf("failed to list %s values: %v", focused.Name, err)
+		return
+	}
+
+	ranks := fuzzy.RankFindFold(focused.String(), names)
+	if len(ranks) > 25 {
+		ranks = ranks[:25]
+	}
+
+	choices := make(api.AutocompleteStringChoices, 0, len(ranks))
+	for _, r := range ranks {
+		choices = append(choices, discord.StringChoice{
+			Name:  r.Target,
+			Value: r.Target,
+		})
+	}
+	resp := api.InteractionResponse{
+		Type: api.AutocompleteResult,
+		Data: &api.InteractionResponseData{
+			Choices: &choices,
+		},
+	}
+
+	if err := b.state.RespondInteraction(e.ID, e.Token, resp); err != nil {
+		log.Println("failed to send interaction callback:", err)
+	}
+}
@@ -17,7 +17,10 @@ import (
 	"github.com/diamondburned/arikawa/v3/state"
 	"github.com/diamondburned/arikawa/v3/utils/json/option"
 	"github.com/go-co-op/gocron/v2"
+	"github.com/jxs13/league-discord-bot/internal/bracket"
 	"github.com/jxs13/league-discord-bot/internal/format"
+	"github.com/jxs13/league-discord-bot/internal/i18n"
+	"github.com/jxs13/league-discord-bot/internal/msgtemplate"
 	"github.com/jxs13/league-discord-bot/internal/parse"
 	"github.com/jxs13/league-discord-bot/internal/timeutils"
 	"github.com/jxs13/league-discord-bot/sqlc"
@@ -48,6 +51,7 @@ type Bot struct {
 	channelDeleteJob            gocron.Job
 	notificationsJob            gocron.Job
 	participationRequirementJob gocron.Job
+	channelRetryAt              int64
 }
 
 type JobDefinition struct {
@@ -170,6 +174,29 @@ func New(
 				return
 			}
 
+			// reactions which were added or removed while the bot was offline are reconciled on startup
+			_, err = bot.scheduler.NewJob(
+				gocron.DurationJob(ParticipationReconciliationInterval),
+				gocron.NewTask(bot.asyncReconcileParticipation),
+				gocron.WithStartAt(gocron.WithStartImmediately()),
+				gocron.WithSingletonMode(gocron.LimitModeReschedule),
+			)
+			if err != nil {
+				bot.cancelCause(fmt.Errorf("failed to create participation reconciliation job: %w", err))
+				return
+			}
+
+			// matches which started or entered the time window of a schedule board are not tied to any event
+			_, err = bot.scheduler.NewJob(
+				gocron.DurationJob(ScheduleBoardRefreshInterval),
+				gocron.NewTask(bot.asyncRefreshScheduleBoards),
+				gocron.WithSingletonMode(gocron.LimitModeReschedule),
+			)
+			if err != nil {
+				bot.cancelCause(fmt.Errorf("failed to create schedule board job: %w", err))
+				return
+			}
+
 			if bot.backupInterval > 0 {
 				_, err = bot.scheduler.NewJob(
 					SelectJobDefinition(bot.backupInterval),
@@ -196,6 +223,12 @@ func New(
 				}
 			}
 
+			err = bot.TxQueries(ctx, bot.createPendingFixtureMatches)
+			if err != nil {
+				bot.cancelCause(fmt.Errorf("failed to create pending fixture matches: %w", err))
+				return
+			}
+
 			err = bot.TxQueries(ctx, bot.refreshJobSchedules)
 			if err != nil {
 				bot.cancelCause(fmt.Errorf("failed to initially refresh job schedules: %w", err))
@@ -217,19 +250,66 @@ func New(
 	s.AddHandler(bot.handleScheduledEventUpdate)
 
 	s.AddHandler(bot.handleAutocompletionLocationInteraction)
+	s.AddHandler(bot.handleAutocompletionNameInteraction)
 
 	r := cmdroute.NewRouter()
 	// Automatically defer handles if they're slow.
 	r.Use(cmdroute.Deferrable(s, cmdroute.DeferOpts{
 		Flags: discord.EphemeralMessage,
 	}))
+	r.Use(bot.withGuildLanguage)
 
 	// admin commands
 	r.AddFunc("configure", bot.commandGuildConfigure)
 	r.AddFunc("configuration", bot.commandGuildConfiguration)
+	r.AddFunc("access-grant", bot.commandAccessGrant)
+	r.AddFunc("access-revoke", bot.commandAccessRevoke)
+	r.AddFunc("access-list", bot.commandAccessList)
+	r.AddFunc("template-preview", bot.commandTemplatePreview)
+	r.AddFunc("template-set", bot.commandTemplateSet)
+	r.AddFunc("template-reset", bot.commandTemplateReset)
+	r.AddFunc("division-set", bot.commandDivisionSet)
+	r.AddFunc("division-delete", bot.commandDivisionDelete)
+	r.AddFunc("division-list", bot.commandDivisionList)
+	r.AddFunc("division-team-add", bot.commandDivisionTeamAdd)
+	r.AddFunc("division-team-remove", bot.commandDivisionTeamRemove)
 
 	// admin + user commands
+	r.AddFunc("team-register", bot.commandTeamRegister)
+	r.AddFunc("team-edit", bot.commandTeamEdit)
+	r.AddFunc("team-delete", bot.commandTeamDelete)
+	r.AddFunc("team-show", bot.commandTeamShow)
+	r.AddFunc("team-list", bot.commandTeamList)
+	r.AddFunc("team-roster-add", bot.commandTeamRosterAdd)
+	r.AddFunc("team-roster-remove", bot.commandTeamRosterRemove)
 	r.AddFunc("schedule-match", bot.commandScheduleMatch)
+	r.AddFunc("reschedule-match", bot.commandRescheduleMatch)
+	r.AddFunc("cancel-match", bot.commandCancelMatch)
+	r.AddFunc("report-result", bot.commandReportResult)
+	r.AddFunc("finalize-result", bot.commandFinalizeResult)
+	r.AddComponentFunc(ComponentResultConfirm, bot.buttonConfirmResult)
+	r.AddComponentFunc(ComponentResultDispute, bot.buttonDisputeResult)
+	r.AddComponentFunc(ComponentParticipationJoin, bot.buttonJoinParticipation)
+	r.AddComponentFunc(ComponentParticipationLeave, bot.buttonLeaveParticipation)
+
+	r.AddFunc("standings", bot.commandStandings)
+	r.AddFunc("standings-enable", bot.commandStandingsEnable)
+	r.AddFunc("standings-disable", bot.commandStandingsDisable)
+	r.AddFunc("schedule-board-enable", bot.commandScheduleBoardEnable)
+	r.AddFunc("schedule-board-disable", bot.commandScheduleBoardDisable)
+	r.AddFunc("rating", bot.commandRating)
+	r.AddFunc("season-generate", bot.commandSeasonGenerate)
+	r.AddComponentFunc(ComponentSeasonConfirm, bot.buttonSeasonConfirm)
+	r.AddComponentFunc(ComponentSeasonDiscard, bot.buttonSeasonDiscard)
+	r.AddComponentFunc(ComponentRescheduleAccept, bot.buttonAcceptReschedule)
+	r.AddComponentFunc(ComponentRescheduleReject, bot.buttonRejectReschedule)
+	r.AddFunc("bracket-create", bot.commandBracketCreate)
+	r.AddFunc("bracket-show", bot.commandBracketShow)
+	r.AddFunc("bracket-delete", bot.commandBracketDelete)
+	r.AddFunc("swiss-create", bot.commandSwissCreate)
+	r.AddFunc("swiss-next-round", bot.commandSwissNextRound)
+	r.AddFunc("swiss-standings", bot.commandSwissStandings)
+	r.AddFunc("swiss-delete", bot.commandSwissDelete)
 
 	r.AddFunc("notification-list", bot.commandNotificationsList)
 	r.AddFunc("notification-delete", bot.commandNotificationsDelete)
@@ -280,7 +360,7 @@ func (b *Bot) refreshAccessJob(ctx context.Context, q *sqlc.Queries) (err error)
 
 	b.channelAccessJob, err = b.rescheduleJob(
 		b.channelAccessJob,
-		accessible.ChannelAccessible,
+		b.channelAccessAt(accessible.ChannelAccessibleAt),
 		b.asyncGrantChannelAccess,
 	)
 	if err != nil {
@@ -358,7 +438,7 @@ func (b *Bot) refreshAnnouncementJob(ctx context.Context, q *sqlc.Queries) (err
 
 	b.announcementJob, err = b.rescheduleJob(
 		b.announcementJob,
-		announcement.LastAnnouncedAt+announcement.Interval,
+		announcement.NextAnnouncedAt,
 		b.asyncAnnouncements,
 	)
 	if err != nil {
@@ -368,6 +448,16 @@ func (b *Bot) refreshAnnouncementJob(ctx context.Context, q *sqlc.Queries) (err
 	return nil
 }
 
+// channelAccessAt postpones the creation of match channels that are due
+// in case a guild reached the maximum number of channels before.
+// Must be called with jobMu held.
+func (b *Bot) channelAccessAt(accessibleAt int64) int64 {
+	if accessibleAt > 0 && accessibleAt < b.channelRetryAt {
+		return b.channelRetryAt
+	}
+	return accessibleAt
+}
+
 func (b *Bot) refreshJobSchedules(ctx context.Context, q *sqlc.Queries) (err error) {
 	defer func() {
 		if err != nil {
@@ -405,7 +495,7 @@ func (b *Bot) refreshJobSchedules(ctx context.Context, q *sqlc.Queries) (err err
 
 	b.channelAccessJob, err = b.rescheduleJob(
 		b.channelAccessJob,
-		accessible.ChannelAccessible,
+		b.channelAccessAt(accessible.ChannelAccessibleAt),
 		b.asyncGrantChannelAccess,
 	)
 	if err != nil {
@@ -441,7 +531,7 @@ func (b *Bot) refreshJobSchedules(ctx context.Context, q *sqlc.Queries) (err err
 
 	b.announcementJob, err = b.rescheduleJob(
 		b.announcementJob,
-		announcement.LastAnnouncedAt+announcement.Interval,
+		announcement.NextAnnouncedAt,
 		b.asyncAnnouncements,
 	)
 	if err != nil {
@@ -522,10 +612,11 @@ func (b *Bot) DefaultReminderIntervals() string {
 	return format.ReminderIntervals(b.defaultNotificationOffsets)
 }
 
-func errorResponse(err error) *api.InteractionResponseData {
+func errorResponse(ctx context.Context, err error) *api.InteractionResponseData {
 	log.Println(err)
+	lang := i18n.FromContext(ctx)
 	return &api.InteractionResponseData{
-		Content:         option.NewNullableString("**Error:** " + err.Error()),
+		Content:         option.NewNullableString(i18n.T(lang, "error.prefix") + i18n.Message(lang, err)),
 		Flags:           discord.EphemeralMessage,
 		AllowedMentions: &api.AllowedMentions{ /* none */ },
 	}
@@ -576,6 +667,125 @@ func (b *Bot) overrideCommands() error {
 			),
 			Options: []discord.CommandOption{},
 		},
+		{
+			Name:           "access-grant",
+			Description:    "Grant a role or user read or write access to the bot commands",
+			NoDMPermission: true,
+			DefaultMemberPermissions: discord.NewPermissions(
+				discord.PermissionAdministrator,
+			),
+			Options: []discord.CommandOption{
+				&discord.StringOption{
+					OptionName:  "level",
+					Description: "Access level, write access includes read access",
+					Required:    true,
+					Choices: []discord.StringChoice{
+						{Name: "read", Value: string(READ)},
+						{Name: "write", Value: string(WRITE)},
+					},
+				},
+				&discord.RoleOption{
+					OptionName:  "role",
+					Description: "Role which should be granted access",
+					Required:    false,
+				},
+				&discord.UserOption{
+					OptionName:  "user",
+					Description: "User who should be granted access",
+					Required:    false,
+				},
+			},
+		},
+		{
+			Name:           "access-revoke",
+			Description:    "Revoke the access of a role or user to the bot commands",
+			NoDMPermission: true,
+			DefaultMemberPermissions: discord.NewPermissions(
+				discord.PermissionAdministrator,
+			),
+			Options: []discord.CommandOption{
+				&discord.RoleOption{
+					OptionName:  "role",
+					Description: "Role whose access should be revoked",
+					Required:    false,
+				},
+				&discord.UserOption{
+					OptionName:  "user",
+					Description: "User whose access should be revoked",
+					Required:    false,
+				},
+			},
+		},
+		{
+			Name:           "access-list",
+			Description:    "List all roles and users with access to the bot commands",
+			NoDMPermission: true,
+			DefaultMemberPermissions: discord.NewPermissions(
+				discord.PermissionAdministrator,
+			),
+		},
+		{
+			Name:           "template-preview",
+			Description:    "Preview the message template of a message kind with example values",
+			NoDMPermission: true,
+			DefaultMemberPermissions: discord.NewPermissions(
+				discord.PermissionAdministrator,
+			),
+			Options: []discord.CommandOption{
+				&discord.StringOption{
+					OptionName:  "kind",
+					Description: "Kind of the message",
+					Required:    true,
+					Choices:     templateKindChoices(),
+				},
+				&discord.StringOption{
+					OptionName:  "template",
+					Description: "Unsaved template to preview, e.g. {{.Teams}} play at {{.ScheduledAt}}. Use \\n for line breaks.",
+					MinLength:   option.NewInt(1),
+					MaxLength:   option.NewInt(msgtemplate.MaxLength),
+					Required:    false,
+				},
+			},
+		},
+		{
+			Name:           "template-set",
+			Description:    "Set the message template of a message kind",
+			NoDMPermission: true,
+			DefaultMemberPermissions: discord.NewPermissions(
+				discord.PermissionAdministrator,
+			),
+			Options: []discord.CommandOption{
+				&discord.StringOption{
+					OptionName:  "kind",
+					Description: "Kind of the message",
+					Required:    true,
+					Choices:     templateKindChoices(),
+				},
+				&discord.StringOption{
+					OptionName:  "template",
+					Description: "Template, e.g. {{.Teams}} play at {{.ScheduledAt}} in {{.Channel}}. Use \\n for line breaks.",
+					MinLength:   option.NewInt(1),
+					MaxLength:   option.NewInt(msgtemplate.MaxLength),
+					Required:    true,
+				},
+			},
+		},
+		{
+			Name:           "template-reset",
+			Description:    "Reset the message template of a message kind to the default template",
+			NoDMPermission: true,
+			DefaultMemberPermissions: discord.NewPermissions(
+				discord.PermissionAdministrator,
+			),
+			Options: []discord.CommandOption{
+				&discord.StringOption{
+					OptionName:  "kind",
+					Description: "Kind of the message",
+					Required:    true,
+					Choices:     templateKindChoices(),
+				},
+			},
+		},
 		{
 			Name:           "configure",
 			Description:    "Configure the bot for the current guild",
@@ -614,187 +824,1155 @@ func (b *Bot) overrideCommands() error {
 					MinLength:   option.NewInt(2),
 					MaxLength:   option.NewInt(11),
 				},
+				&discord.IntegerOption{
+					OptionName:  "points_win",
+					Description: "Standings points for winning a match",
+					Min:         option.NewInt(-100),
+					Max:         option.NewInt(100),
+				},
+				&discord.IntegerOption{
+					OptionName:  "points_draw",
+					Description: "Standings points for a draw",
+					Min:         option.NewInt(-100),
+					Max:         option.NewInt(100),
+				},
+				&discord.IntegerOption{
+					OptionName:  "points_loss",
+					Description: "Standings points for losing a match",
+					Min:         option.NewInt(-100),
+					Max:         option.NewInt(100),
+				},
+				&discord.StringOption{
+					OptionName:  "language",
+					Description: "Language of the messages generated by the bot",
+					Choices:     languageChoices(),
+				},
+				&discord.StringOption{
+					OptionName:  "output_mode",
+					Description: "Send match, reminder and announcement messages as plain text or as embeds",
+					Choices:     outputModeChoices(),
+				},
 			},
 		},
 		{
-			Name:           "schedule-match",
-			Description:    "Schedule a new match",
+			Name:           "division-set",
+			Description:    "Create or change a division with its own match category and configuration",
 			NoDMPermission: true,
 			DefaultMemberPermissions: discord.NewPermissions(
-				discord.PermissionViewChannel,
-				discord.PermissionSendMessages,
+				discord.PermissionAdministrator,
 			),
-
 			Options: []discord.CommandOption{
 				&discord.StringOption{
-					OptionName:  "scheduled_at",
-					Description: fmt.Sprintf("Time when the match starts. Must be in this format: %s", parse.LayoutDateTime),
-					MinLength:   option.NewInt(len(parse.LayoutDateTime)),
-					MaxLength:   option.NewInt(len(parse.LayoutDateTime)),
-					Required:    true,
-				},
-				&discord.StringOption{
-					OptionName:   "location",
-					Description:  "Timzone location, e.g. Europe/Berlin.",
+					OptionName:   divisionNameOptionName,
+					Description:  "Unique name of the division, an existing division is changed, e.g. Premier",
 					MinLength:    option.NewInt(1),
+					MaxLength:    option.NewInt(MaxDivisionNameLength),
 					Required:     true,
 					Autocomplete: true,
 				},
-				&discord.RoleOption{
-					OptionName:  "team_1_role",
-					Description: "Role of the first team.",
-					Required:    true,
-				},
-				&discord.RoleOption{
-					OptionName:  "team_2_role",
-					Description: "Role of the second team.",
-					Required:    true,
-				},
-				&discord.UserOption{
-					OptionName:  "moderator",
-					Description: "Moderator",
-					Required:    true,
+				&discord.StringOption{
+					OptionName:  "channel_access_offset",
+					Description: "How long before the match the user can access the match channel (default: server configuration)",
+					MinLength:   option.NewInt(2),
+					MaxLength:   option.NewInt(11),
 				},
-				&discord.IntegerOption{
-					OptionName:  "participants_per_team",
-					Description: "Number of required participants per team. (3on3 -> 3)",
-					Min:         option.NewInt(0),
-					Required:    false,
+				&discord.StringOption{
+					OptionName:  "notification_offsets",
+					Description: "Intervals at which to remind before a match e.g. 24h,1h,15m,5m,30s (default: server configuration)",
 				},
-				&discord.UserOption{
-					OptionName:  "streamer",
-					Description: "Streamer",
-					Required:    false,
+				&discord.StringOption{
+					OptionName:  "requirements_offset",
+					Description: "Time before the match until which the participation requirements need to be met e.g. 24h, 30m, 0s",
+					MinLength:   option.NewInt(2),
+					MaxLength:   option.NewInt(11),
 				},
 				&discord.StringOption{
-					OptionName:  "stream_url",
-					Description: "url of the streamer or stream",
-					Required:    false,
+					OptionName:  "channel_delete_offset",
+					Description: "Deadline after the match at which the channel is deleted e.g. 1h, 0s, 50m, 1h50m,30s",
+					MinLength:   option.NewInt(2),
+					MaxLength:   option.NewInt(11),
 				},
 			},
 		},
 		{
-			Name:           "notification-list",
-			Description:    "list all notifications for a specific match",
+			Name:           "division-delete",
+			Description:    "Delete a division without matches together with its category",
 			NoDMPermission: true,
 			DefaultMemberPermissions: discord.NewPermissions(
-				discord.PermissionViewChannel,
-				discord.PermissionSendMessages,
+				discord.PermissionAdministrator,
 			),
-
 			Options: []discord.CommandOption{
-				&discord.ChannelOption{
-					OptionName:  "match_channel",
-					Description: "Match channel for which to get the notifications",
-					Required:    true,
+				&discord.StringOption{
+					OptionName:   divisionNameOptionName,
+					Description:  "Name of the division",
+					MinLength:    option.NewInt(1),
+					MaxLength:    option.NewInt(MaxDivisionNameLength),
+					Required:     true,
+					Autocomplete: true,
 				},
 			},
 		},
 		{
-			Name:           "notification-delete",
-			Description:    "delete a notification from the notification list",
+			Name:           "division-list",
+			Description:    "List the divisions of this server with their configuration and teams",
 			NoDMPermission: true,
 			DefaultMemberPermissions: discord.NewPermissions(
 				discord.PermissionViewChannel,
 				discord.PermissionSendMessages,
 			),
-
-			Options: []discord.CommandOption{
-				&discord.ChannelOption{
-					OptionName:  "match_channel",
-					Description: "Match channel for which to get the notification",
-					Required:    true,
-				},
-				&discord.IntegerOption{
-					OptionName:  "list_number",
-					Description: "Notification number in the notification list",
-					Required:    true,
-					Min:         option.NewInt(1),
-					Max:         option.NewInt(50),
-				},
-			},
 		},
 		{
-			Name:           "notification-add",
-			Description:    "add a new generated or custom notification to a match channel.",
+			Name:           "division-team-add",
+			Description:    "Add a team to a division, a team is a member of at most one division",
 			NoDMPermission: true,
 			DefaultMemberPermissions: discord.NewPermissions(
 				discord.PermissionViewChannel,
 				discord.PermissionSendMessages,
 			),
-
 			Options: []discord.CommandOption{
-				&discord.ChannelOption{
-					OptionName:  "match_channel",
-					Description: "Match channel for which to get the notification",
-					Required:    true,
-				},
 				&discord.StringOption{
-					OptionName:  "notify_at",
-					Description: fmt.Sprintf("Time when the notification is triggered. Must be in this format: %s", parse.LayoutDateTime),
-					MinLength:   option.NewInt(len(parse.LayoutDateTime)),
-					MaxLength:   option.NewInt(len(parse.LayoutDateTime)),
-					Required:    true,
-				},
-				&discord.StringOption{
-					OptionName:   "location",
-					Description:  "Timzone location, e.g. Europe/Berlin.",
+					OptionName:   divisionNameOptionName,
+					Description:  "Name of the division",
 					MinLength:    option.NewInt(1),
+					MaxLength:    option.NewInt(MaxDivisionNameLength),
 					Required:     true,
 					Autocomplete: true,
 				},
 				&discord.StringOption{
-					OptionName:  "custom_text",
-					Description: "Leave empty for a default generated message",
-					Required:    false,
+					OptionName:   "team",
+					Description:  "Registered name or role of the team",
+					MinLength:    option.NewInt(1),
+					Required:     true,
+					Autocomplete: true,
 				},
 			},
 		},
 		{
-			Name:           "announcements-disable",
-			Description:    "Disable periodic (daily, weekly, monthly, etc.) announcements of scheduled matches",
-			NoDMPermission: true,
-			DefaultMemberPermissions: discord.NewPermissions(
-				discord.PermissionAdministrator,
-			),
-		},
-		{
-			Name:           "announcements-configuration",
-			Description:    "Get the current server announcement configuration",
+			Name:           "division-team-remove",
+			Description:    "Remove a team from its division",
 			NoDMPermission: true,
 			DefaultMemberPermissions: discord.NewPermissions(
-				discord.PermissionAdministrator,
+				discord.PermissionViewChannel,
+				discord.PermissionSendMessages,
 			),
+			Options: []discord.CommandOption{
+				&discord.StringOption{
+					OptionName:   "team",
+					Description:  "Registered name or role of the team",
+					MinLength:    option.NewInt(1),
+					Required:     true,
+					Autocomplete: true,
+				},
+			},
 		},
 		{
-			Name:           "announcements-enable",
-			Description:    "Enable periodic announcements hourly, daily, weekly, monthly, etc. ahead of scheduled matches",
+			Name:           "team-register",
+			Description:    "Register a team with its role, name, tag, logo and captains",
 			NoDMPermission: true,
 			DefaultMemberPermissions: discord.NewPermissions(
-				discord.PermissionAdministrator,
+				discord.PermissionViewChannel,
+				discord.PermissionSendMessages,
 			),
+
 			Options: []discord.CommandOption{
-				&discord.ChannelOption{
-					OptionName:  "announcement_channel",
-					Description: "Channel where the announcement should be sent to",
+				&discord.RoleOption{
+					OptionName:  "role",
+					Description: "Role of the team",
 					Required:    true,
 				},
 				&discord.StringOption{
-					OptionName:  "starts_at",
-					Description: fmt.Sprintf("Time when the first announcement starts. Must be in this format: %s", parse.LayoutDateTime),
-					MinLength:   option.NewInt(len(parse.LayoutDateTime)),
-					MaxLength:   option.NewInt(len(parse.LayoutDateTime)),
+					OptionName:  "name",
+					Description: "Name of the team",
+					MinLength:   option.NewInt(1),
+					MaxLength:   option.NewInt(MaxTeamNameLength),
 					Required:    true,
 				},
 				&discord.StringOption{
-					OptionName:  "ends_at",
-					Description: fmt.Sprintf("Time when the announcements should stop. Must be in this format: %s", parse.LayoutDateTime),
-					MinLength:   option.NewInt(len(parse.LayoutDateTime)),
-					MaxLength:   option.NewInt(len(parse.LayoutDateTime)),
-					Required:    true,
+					OptionName:  "tag",
+					Description: "Short tag of the team, e.g. ABC",
+					MinLength:   option.NewInt(1),
+					MaxLength:   option.NewInt(MaxTeamTagLength),
+					Required:    false,
 				},
 				&discord.StringOption{
-					OptionName:   "location",
+					OptionName:  "logo_url",
+					Description: "URL of the team logo",
+					Required:    false,
+				},
+				&discord.StringOption{
+					OptionName:  "captains",
+					Description: "Mentions of the team captains, e.g. @user1 @user2",
+					Required:    false,
+				},
+			},
+		},
+		{
+			Name:           "team-edit",
+			Description:    "Change a registered team, only the given values are changed",
+			NoDMPermission: true,
+			DefaultMemberPermissions: discord.NewPermissions(
+				discord.PermissionViewChannel,
+				discord.PermissionSendMessages,
+			),
+
+			Options: []discord.CommandOption{
+				&discord.StringOption{
+					OptionName:   "team",
+					Description:  "Name of the registered team",
+					MinLength:    option.NewInt(1),
+					Required:     true,
+					Autocomplete: true,
+				},
+				&discord.StringOption{
+					OptionName:  "name",
+					Description: "New name of the team",
+					MinLength:   option.NewInt(1),
+					MaxLength:   option.NewInt(MaxTeamNameLength),
+					Required:    false,
+				},
+				&discord.StringOption{
+					OptionName:  "tag",
+					Description: "New tag of the team, - removes the tag",
+					MinLength:   option.NewInt(1),
+					MaxLength:   option.NewInt(MaxTeamTagLength),
+					Required:    false,
+				},
+				&discord.StringOption{
+					OptionName:  "logo_url",
+					Description: "New URL of the team logo, - removes the logo",
+					Required:    false,
+				},
+				&discord.StringOption{
+					OptionName:  "captains",
+					Description: "Mentions of the team captains, which replace all previous captains",
+					Required:    false,
+				},
+			},
+		},
+		{
+			Name:           "team-delete",
+			Description:    "Delete a registered team, its role and its matches are kept",
+			NoDMPermission: true,
+			DefaultMemberPermissions: discord.NewPermissions(
+				discord.PermissionViewChannel,
+				discord.PermissionSendMessages,
+			),
+
+			Options: []discord.CommandOption{
+				&discord.StringOption{
+					OptionName:   "team",
+					Description:  "Name of the registered team",
+					MinLength:    option.NewInt(1),
+					Required:     true,
+					Autocomplete: true,
+				},
+			},
+		},
+		{
+			Name:           "team-show",
+			Description:    "Show a registered team with its captains and roster",
+			NoDMPermission: true,
+			DefaultMemberPermissions: discord.NewPermissions(
+				discord.PermissionViewChannel,
+				discord.PermissionSendMessages,
+			),
+
+			Options: []discord.CommandOption{
+				&discord.StringOption{
+					OptionName:   "team",
+					Description:  "Name of the registered team",
+					MinLength:    option.NewInt(1),
+					Required:     true,
+					Autocomplete: true,
+				},
+			},
+		},
+		{
+			Name:           "team-list",
+			Description:    "List all registered teams",
+			NoDMPermission: true,
+			DefaultMemberPermissions: discord.NewPermissions(
+				discord.PermissionViewChannel,
+				discord.PermissionSendMessages,
+			),
+		},
+		{
+			Name:           "team-roster-add",
+			Description:    "Add players to the roster of a registered team",
+			NoDMPermission: true,
+			DefaultMemberPermissions: discord.NewPermissions(
+				discord.PermissionViewChannel,
+				discord.PermissionSendMessages,
+			),
+
+			Options: []discord.CommandOption{
+				&discord.StringOption{
+					OptionName:   "team",
+					Description:  "Name of the registered team",
+					MinLength:    option.NewInt(1),
+					Required:     true,
+					Autocomplete: true,
+				},
+				&discord.StringOption{
+					OptionName:  "users",
+					Description: "Mentions of the players, e.g. @user1 @user2",
+					MinLength:   option.NewInt(1),
+					Required:    true,
+				},
+			},
+		},
+		{
+			Name:           "team-roster-remove",
+			Description:    "Remove players from the roster of a registered team",
+			NoDMPermission: true,
+			DefaultMemberPermissions: discord.NewPermissions(
+				discord.PermissionViewChannel,
+				discord.PermissionSendMessages,
+			),
+
+			Options: []discord.CommandOption{
+				&discord.StringOption{
+					OptionName:   "team",
+					Description:  "Name of the registered team",
+					MinLength:    option.NewInt(1),
+					Required:     true,
+					Autocomplete: true,
+				},
+				&discord.StringOption{
+					OptionName:  "users",
+					Description: "Mentions of the players, e.g. @user1 @user2",
+					MinLength:   option.NewInt(1),
+					Required:    true,
+				},
+			},
+		},
+		{
+			Name:           "schedule-match",
+			Description:    "Schedule a new match",
+			NoDMPermission: true,
+			DefaultMemberPermissions: discord.NewPermissions(
+				discord.PermissionViewChannel,
+				discord.PermissionSendMessages,
+			),
+
+			Options: []discord.CommandOption{
+				&discord.StringOption{
+					OptionName:  "scheduled_at",
+					Description: fmt.Sprintf("Time when the match starts. Must be in this format: %s", parse.LayoutDateTime),
+					MinLength:   option.NewInt(len(parse.LayoutDateTime)),
+					MaxLength:   option.NewInt(len(parse.LayoutDateTime)),
+					Required:    true,
+				},
+				&discord.StringOption{
+					OptionName:   "location",
+					Description:  "Timzone location, e.g. Europe/Berlin.",
+					MinLength:    option.NewInt(1),
+					Required:     true,
+					Autocomplete: true,
+				},
+				&discord.UserOption{
+					OptionName:  "moderator",
+					Description: "Moderator",
+					Required:    true,
+				},
+				&discord.RoleOption{
+					OptionName:  "team_1_role",
+					Description: "Role of the first team.",
+					Required:    false,
+				},
+				&discord.StringOption{
+					OptionName:   "team_1",
+					Description:  "Registered name of the first team, instead of its role.",
+					Required:     false,
+					Autocomplete: true,
+				},
+				&discord.RoleOption{
+					OptionName:  "team_2_role",
+					Description: "Role of the second team.",
+					Required:    false,
+				},
+				&discord.StringOption{
+					OptionName:   "team_2",
+					Description:  "Registered name of the second team, instead of its role.",
+					Required:     false,
+					Autocomplete: true,
+				},
+				&discord.IntegerOption{
+					OptionName:  "participants_per_team",
+					Description: "Number of required participants per team. (3on3 -> 3)",
+					Min:         option.NewInt(0),
+					Required:    false,
+				},
+				&discord.UserOption{
+					OptionName:  "streamer",
+					Description: "Streamer",
+					Required:    false,
+				},
+				&discord.StringOption{
+					OptionName:  "stream_url",
+					Description: "url of the streamer or stream",
+					Required:    false,
+				},
+				&discord.RoleOption{
+					OptionName:  "team_3_role",
+					Description: "Role of the third team.",
+					Required:    false,
+				},
+				&discord.StringOption{
+					OptionName:   "team_3",
+					Description:  "Registered name of the third team, instead of its role.",
+					Required:     false,
+					Autocomplete: true,
+				},
+				&discord.RoleOption{
+					OptionName:  "team_4_role",
+					Description: "Role of the fourth team.",
+					Required:    false,
+				},
+				&discord.StringOption{
+					OptionName:   "team_4",
+					Description:  "Registered name of the fourth team, instead of its role.",
+					Required:     false,
+					Autocomplete: true,
+				},
+				&discord.RoleOption{
+					OptionName:  "team_5_role",
+					Description: "Role of the fifth team.",
+					Required:    false,
+				},
+				&discord.StringOption{
+					OptionName:   "team_5",
+					Description:  "Registered name of the fifth team, instead of its role.",
+					Required:     false,
+					Autocomplete: true,
+				},
+				&discord.RoleOption{
+					OptionName:  "team_6_role",
+					Description: "Role of the sixth team.",
+					Required:    false,
+				},
+				&discord.StringOption{
+					OptionName:   "team_6",
+					Description:  "Registered name of the sixth team, instead of its role.",
+					Required:     false,
+					Autocomplete: true,
+				},
+				&discord.RoleOption{
+					OptionName:  "team_7_role",
+					Description: "Role of the seventh team.",
+					Required:    false,
+				},
+				&discord.StringOption{
+					OptionName:   "team_7",
+					Description:  "Registered name of the seventh team, instead of its role.",
+					Required:     false,
+					Autocomplete: true,
+				},
+				&discord.RoleOption{
+					OptionName:  "team_8_role",
+					Description: "Role of the eighth team.",
+					Required:    false,
+				},
+				&discord.StringOption{
+					OptionName:   "team_8",
+					Description:  "Registered name of the eighth team, instead of its role.",
+					Required:     false,
+					Autocomplete: true,
+				},
+				&discord.StringOption{
+					OptionName:   divisionNameOptionName,
+					Description:  "Division of the match (default: the common division of the teams)",
+					MinLength:    option.NewInt(1),
+					MaxLength:    option.NewInt(MaxDivisionNameLength),
+					Required:     false,
+					Autocomplete: true,
+				},
+			},
+		},
+		{
+			Name:           "reschedule-match",
+			Description:    "Move an existing match to a new point in time",
+			NoDMPermission: true,
+			DefaultMemberPermissions: discord.NewPermissions(
+				discord.PermissionViewChannel,
+				discord.PermissionSendMessages,
+			),
+
+			Options: []discord.CommandOption{
+				&discord.StringOption{
+					OptionName:  "scheduled_at",
+					Description: fmt.Sprintf("New time when the match starts. Must be in this format: %s", parse.LayoutDateTime),
+					MinLength:   option.NewInt(len(parse.LayoutDateTime)),
+					MaxLength:   option.NewInt(len(parse.LayoutDateTime)),
+					Required:    true,
+				},
+				&discord.StringOption{
+					OptionName:   "location",
+					Description:  "Timzone location, e.g. Europe/Berlin.",
+					MinLength:    option.NewInt(1),
+					Required:     true,
+					Autocomplete: true,
+				},
+				&discord.ChannelOption{
+					OptionName:  "match_channel",
+					Description: "Match channel of the match that should be rescheduled",
+					Required:    false,
+				},
+				&discord.IntegerOption{
+					OptionName:  "match_number",
+					Description: "Number of the match that should be rescheduled, if it has no channel yet",
+					Min:         option.NewInt(1),
+					Required:    false,
+				},
+			},
+		},
+		{
+			Name:           "cancel-match",
+			Description:    "Cancel a match and notify all of its participants",
+			NoDMPermission: true,
+			DefaultMemberPermissions: discord.NewPermissions(
+				discord.PermissionViewChannel,
+				discord.PermissionSendMessages,
+			),
+
+			Options: []discord.CommandOption{
+				&discord.StringOption{
+					OptionName:  "reason",
+					Description: "Reason for the cancellation that is shown to all participants",
+					MinLength:   option.NewInt(1),
+					MaxLength:   option.NewInt(512),
+					Required:    true,
+				},
+				&discord.ChannelOption{
+					OptionName:  "match_channel",
+					Description: "Match channel of the match that should be cancelled",
+					Required:    false,
+				},
+				&discord.IntegerOption{
+					OptionName:  "match_number",
+					Description: "Number of the match that should be cancelled, if it has no channel yet",
+					Min:         option.NewInt(1),
+					Required:    false,
+				},
+				&discord.BooleanOption{
+					OptionName:  "delete_channel",
+					Description: "Delete the match channel instead of archiving it (default: false)",
+					Required:    false,
+				},
+			},
+		},
+		{
+			Name:           "report-result",
+			Description:    "Report the score and play time of a team in a match",
+			NoDMPermission: true,
+			DefaultMemberPermissions: discord.NewPermissions(
+				discord.PermissionViewChannel,
+				discord.PermissionSendMessages,
+			),
+
+			Options: []discord.CommandOption{
+				&discord.RoleOption{
+					OptionName:  "team_role",
+					Description: "Team role of the team for which the result is reported",
+					Required:    true,
+				},
+				&discord.IntegerOption{
+					OptionName:  "score",
+					Description: "Score of the team",
+					Min:         option.NewInt(0),
+					Required:    true,
+				},
+				&discord.StringOption{
+					OptionName:  "time",
+					Description: "Play time of the team, e.g. 12m30s",
+					MinLength:   option.NewInt(2),
+					Required:    true,
+				},
+				&discord.ChannelOption{
+					OptionName:  "match_channel",
+					Description: "Match channel of the match for which the result is reported",
+					Required:    false,
+				},
+				&discord.IntegerOption{
+					OptionName:  "match_number",
+					Description: "Number of the match for which the result is reported, if it has no channel",
+					Min:         option.NewInt(1),
+					Required:    false,
+				},
+				&discord.AttachmentOption{
+					OptionName:  "screenshot",
+					Description: "Screenshot of the result (at most 10 MiB)",
+					Required:    false,
+				},
+				&discord.AttachmentOption{
+					OptionName:  "demo",
+					Description: "Demo file of the match (at most 10 MiB)",
+					Required:    false,
+				},
+				&discord.BooleanOption{
+					OptionName:  "reopen",
+					Description: "Reopen a final result, moderators only (default: false)",
+					Required:    false,
+				},
+			},
+		},
+		{
+			Name:           "finalize-result",
+			Description:    "Finalize a reported match result without the confirmation of all teams",
+			NoDMPermission: true,
+			DefaultMemberPermissions: discord.NewPermissions(
+				discord.PermissionViewChannel,
+				discord.PermissionSendMessages,
+			),
+
+			Options: []discord.CommandOption{
+				&discord.ChannelOption{
+					OptionName:  "match_channel",
+					Description: "Match channel of the match whose result should be finalized",
+					Required:    false,
+				},
+				&discord.IntegerOption{
+					OptionName:  "match_number",
+					Description: "Number of the match whose result should be finalized, if its channel was deleted",
+					Min:         option.NewInt(1),
+					Required:    false,
+				},
+			},
+		},
+		{
+			Name:           "standings",
+			Description:    "Show the league standings based on all final match results",
+			NoDMPermission: true,
+			DefaultMemberPermissions: discord.NewPermissions(
+				discord.PermissionViewChannel,
+				discord.PermissionSendMessages,
+			),
+
+			Options: []discord.CommandOption{
+				&discord.StringOption{
+					OptionName:   divisionNameOptionName,
+					Description:  "Only show the standings of the matches of this division",
+					MinLength:    option.NewInt(1),
+					MaxLength:    option.NewInt(MaxDivisionNameLength),
+					Required:     false,
+					Autocomplete: true,
+				},
+			},
+		},
+		{
+			Name:           "standings-enable",
+			Description:    "Keep a pinned standings message in a channel that is updated automatically",
+			NoDMPermission: true,
+			DefaultMemberPermissions: discord.NewPermissions(
+				discord.PermissionViewChannel,
+				discord.PermissionSendMessages,
+			),
+
+			Options: []discord.CommandOption{
+				&discord.ChannelOption{
+					OptionName:  "standings_channel",
+					Description: "Channel in which the standings message is pinned",
+					Required:    true,
+				},
+				&discord.StringOption{
+					OptionName:   divisionNameOptionName,
+					Description:  "Only show the standings of the matches of this division",
+					MinLength:    option.NewInt(1),
+					MaxLength:    option.NewInt(MaxDivisionNameLength),
+					Required:     false,
+					Autocomplete: true,
+				},
+			},
+		},
+		{
+			Name:           "standings-disable",
+			Description:    "Delete the pinned standings message",
+			NoDMPermission: true,
+			DefaultMemberPermissions: discord.NewPermissions(
+				discord.PermissionViewChannel,
+				discord.PermissionSendMessages,
+			),
+		},
+		{
+			Name:           "schedule-board-enable",
+			Description:    "Keep a list of upcoming matches in a channel that is edited in place",
+			NoDMPermission: true,
+			DefaultMemberPermissions: discord.NewPermissions(
+				discord.PermissionViewChannel,
+				discord.PermissionSendMessages,
+			),
+
+			Options: []discord.CommandOption{
+				&discord.ChannelOption{
+					OptionName:  "schedule_board_channel",
+					Description: "Channel in which the upcoming matches are shown",
+					Required:    true,
+				},
+				&discord.IntegerOption{
+					OptionName:  "days",
+					Description: fmt.Sprintf("Number of days ahead for which matches are shown, default %d", DefaultScheduleBoardDays),
+					Min:         option.NewInt(1),
+					Max:         option.NewInt(MaxScheduleBoardDays),
+				},
+			},
+		},
+		{
+			Name:           "schedule-board-disable",
+			Description:    "Delete the list of upcoming matches",
+			NoDMPermission: true,
+			DefaultMemberPermissions: discord.NewPermissions(
+				discord.PermissionViewChannel,
+				discord.PermissionSendMessages,
+			),
+		},
+		{
+			Name:           "season-generate",
+			Description:    "Generate a round-robin season and schedule all of its matches at once",
+			NoDMPermission: true,
+			DefaultMemberPermissions: discord.NewPermissions(
+				discord.PermissionViewChannel,
+				discord.PermissionSendMessages,
+			),
+
+			Options: []discord.CommandOption{
+				&discord.StringOption{
+					OptionName:  "teams",
+					Description: "Mentions of all team roles, e.g. @team1 @team2 @team3",
+					MinLength:   option.NewInt(1),
+					Required:    true,
+				},
+				&discord.StringOption{
+					OptionName:  "start_date",
+					Description: fmt.Sprintf("First day of the season. Must be in this format: %s", parse.LayoutDate),
+					MinLength:   option.NewInt(len(parse.LayoutDate)),
+					MaxLength:   option.NewInt(len(parse.LayoutDate)),
+					Required:    true,
+				},
+				&discord.StringOption{
+					OptionName:  "slots",
+					Description: "Weekly match slots, e.g. sat 18:00, sun 20:30",
+					MinLength:   option.NewInt(1),
+					Required:    true,
+				},
+				&discord.StringOption{
+					OptionName:   "location",
+					Description:  "Timzone location, e.g. Europe/Berlin.",
+					MinLength:    option.NewInt(1),
+					Required:     true,
+					Autocomplete: true,
+				},
+				&discord.StringOption{
+					OptionName:  "moderators",
+					Description: "Mentions of all moderators, matches are assigned to them in turns",
+					MinLength:   option.NewInt(1),
+					Required:    true,
+				},
+				&discord.BooleanOption{
+					OptionName:  "double_round_robin",
+					Description: "Every team plays against every other team twice (default: false)",
+					Required:    false,
+				},
+				&discord.IntegerOption{
+					OptionName:  "participants_per_team",
+					Description: "Number of required participants per team. (3on3 -> 3)",
+					Min:         option.NewInt(0),
+					Required:    false,
+				},
+			},
+		},
+		{
+			Name:           "bracket-create",
+			Description:    "Create a playoff bracket whose matches are scheduled once their teams are known",
+			NoDMPermission: true,
+			DefaultMemberPermissions: discord.NewPermissions(
+				discord.PermissionViewChannel,
+				discord.PermissionSendMessages,
+			),
+
+			Options: []discord.CommandOption{
+				&discord.StringOption{
+					OptionName:  "name",
+					Description: "Unique name of the bracket, e.g. playoffs",
+					MinLength:   option.NewInt(1),
+					MaxLength:   option.NewInt(MaxBracketNameLength),
+					Required:    true,
+				},
+				&discord.StringOption{
+					OptionName:  "kind",
+					Description: "Kind of elimination",
+					Required:    true,
+					Choices: []discord.StringChoice{
+						{Name: "single elimination", Value: string(bracket.SingleElimination)},
+						{Name: "double elimination", Value: string(bracket.DoubleElimination)},
+					},
+				},
+				&discord.StringOption{
+					OptionName:  "slots",
+					Description: "Weekly match slots, e.g. sat 18:00, sun 20:30",
+					MinLength:   option.NewInt(1),
+					Required:    true,
+				},
+				&discord.StringOption{
+					OptionName:   "location",
+					Description:  "Timzone location, e.g. Europe/Berlin.",
+					MinLength:    option.NewInt(1),
+					Required:     true,
+					Autocomplete: true,
+				},
+				&discord.StringOption{
+					OptionName:  "moderators",
+					Description: "Mentions of all moderators, matches are assigned to them in turns",
+					MinLength:   option.NewInt(1),
+					Required:    true,
+				},
+				&discord.StringOption{
+					OptionName:  "teams",
+					Description: "Mentions of the team roles ordered by seed (default: all teams of the standings)",
+					Required:    false,
+				},
+				&discord.IntegerOption{
+					OptionName:  "size",
+					Description: "Number of the best teams of the standings that are seeded (default: all)",
+					Min:         option.NewInt(2),
+					Max:         option.NewInt(MaxBracketTeams),
+					Required:    false,
+				},
+				&discord.BooleanOption{
+					OptionName:  "seed_by_standings",
+					Description: "Seed the given teams by their position in the current standings",
+					Required:    false,
+				},
+				&discord.ChannelOption{
+					OptionName:  "announcement_channel",
+					Description: "Channel in which advancing teams and upcoming bracket matches are announced",
+					Required:    false,
+				},
+				&discord.IntegerOption{
+					OptionName:  "participants_per_team",
+					Description: "Number of required participants per team. (3on3 -> 3)",
+					Min:         option.NewInt(0),
+					Required:    false,
+				},
+			},
+		},
+		{
+			Name:           "bracket-show",
+			Description:    "Show a playoff bracket",
+			NoDMPermission: true,
+			DefaultMemberPermissions: discord.NewPermissions(
+				discord.PermissionViewChannel,
+				discord.PermissionSendMessages,
+			),
+
+			Options: []discord.CommandOption{
+				&discord.StringOption{
+					OptionName:   "bracket_name",
+					Description:  "Name of the bracket",
+					MinLength:    option.NewInt(1),
+					MaxLength:    option.NewInt(MaxBracketNameLength),
+					Required:     true,
+					Autocomplete: true,
+				},
+			},
+		},
+		{
+			Name:           "bracket-delete",
+			Description:    "Delete a playoff bracket, already created match channels are kept",
+			NoDMPermission: true,
+			DefaultMemberPermissions: discord.NewPermissions(
+				discord.PermissionViewChannel,
+				discord.PermissionSendMessages,
+			),
+
+			Options: []discord.CommandOption{
+				&discord.StringOption{
+					OptionName:   "bracket_name",
+					Description:  "Name of the bracket",
+					MinLength:    option.NewInt(1),
+					MaxLength:    option.NewInt(MaxBracketNameLength),
+					Required:     true,
+					Autocomplete: true,
+				},
+			},
+		},
+		{
+			Name:           "swiss-create",
+			Description:    "Create a swiss tournament whose rounds are paired by the records of the teams",
+			NoDMPermission: true,
+			DefaultMemberPermissions: discord.NewPermissions(
+				discord.PermissionViewChannel,
+				discord.PermissionSendMessages,
+			),
+
+			Options: []discord.CommandOption{
+				&discord.StringOption{
+					OptionName:  "name",
+					Description: "Unique name of the swiss tournament, e.g. open-cup",
+					MinLength:   option.NewInt(1),
+					MaxLength:   option.NewInt(MaxSwissNameLength),
+					Required:    true,
+				},
+				&discord.StringOption{
+					OptionName:  "teams",
+					Description: "Mentions of all team roles, e.g. @team1 @team2 @team3",
+					MinLength:   option.NewInt(1),
+					Required:    true,
+				},
+				&discord.StringOption{
+					OptionName:  "slots",
+					Description: "Weekly match slots in which rounds take place, e.g. sat 18:00",
+					MinLength:   option.NewInt(1),
+					Required:    true,
+				},
+				&discord.StringOption{
+					OptionName:   "location",
+					Description:  "Timzone location, e.g. Europe/Berlin.",
+					MinLength:    option.NewInt(1),
+					Required:     true,
+					Autocomplete: true,
+				},
+				&discord.StringOption{
+					OptionName:  "moderators",
+					Description: "Mentions of all moderators, matches are assigned to them in turns",
+					MinLength:   option.NewInt(1),
+					Required:    true,
+				},
+				&discord.IntegerOption{
+					OptionName:  "participants_per_team",
+					Description: "Number of required participants per team. (3on3 -> 3)",
+					Min:         option.NewInt(0),
+					Required:    false,
+				},
+			},
+		},
+		{
+			Name:           "swiss-next-round",
+			Description:    "Pair and schedule the next round of a swiss tournament",
+			NoDMPermission: true,
+			DefaultMemberPermissions: discord.NewPermissions(
+				discord.PermissionViewChannel,
+				discord.PermissionSendMessages,
+			),
+
+			Options: []discord.CommandOption{
+				&discord.StringOption{
+					OptionName:   "swiss_name",
+					Description:  "Name of the swiss tournament",
+					MinLength:    option.NewInt(1),
+					MaxLength:    option.NewInt(MaxSwissNameLength),
+					Required:     true,
+					Autocomplete: true,
+				},
+				&discord.BooleanOption{
+					OptionName:  "skip_unfinished",
+					Description: "Count matches of the current round without a final result as not played (default: false)",
+					Required:    false,
+				},
+			},
+		},
+		{
+			Name:           "swiss-standings",
+			Description:    "Show the standings of a swiss tournament with Buchholz tiebreaks",
+			NoDMPermission: true,
+			DefaultMemberPermissions: discord.NewPermissions(
+				discord.PermissionViewChannel,
+				discord.PermissionSendMessages,
+			),
+
+			Options: []discord.CommandOption{
+				&discord.StringOption{
+					OptionName:   "swiss_name",
+					Description:  "Name of the swiss tournament",
+					MinLength:    option.NewInt(1),
+					MaxLength:    option.NewInt(MaxSwissNameLength),
+					Required:     true,
+					Autocomplete: true,
+				},
+			},
+		},
+		{
+			Name:           "swiss-delete",
+			Description:    "Delete a swiss tournament, already created match channels are kept",
+			NoDMPermission: true,
+			DefaultMemberPermissions: discord.NewPermissions(
+				discord.PermissionViewChannel,
+				discord.PermissionSendMessages,
+			),
+
+			Options: []discord.CommandOption{
+				&discord.StringOption{
+					OptionName:   "swiss_name",
+					Description:  "Name of the swiss tournament",
+					MinLength:    option.NewInt(1),
+					MaxLength:    option.NewInt(MaxSwissNameLength),
+					Required:     true,
+					Autocomplete: true,
+				},
+			},
+		},
+		{
+			Name:           "rating",
+			Description:    "Show the rating of a team and its trend, or the ratings of all teams",
+			NoDMPermission: true,
+			DefaultMemberPermissions: discord.NewPermissions(
+				discord.PermissionViewChannel,
+				discord.PermissionSendMessages,
+			),
+
+			Options: []discord.CommandOption{
+				&discord.RoleOption{
+					OptionName:  "team_role",
+					Description: "Team role of the team whose rating should be shown",
+					Required:    false,
+				},
+			},
+		},
+		{
+			Name:           "notification-list",
+			Description:    "list all notifications for a specific match",
+			NoDMPermission: true,
+			DefaultMemberPermissions: discord.NewPermissions(
+				discord.PermissionViewChannel,
+				discord.PermissionSendMessages,
+			),
+
+			Options: []discord.CommandOption{
+				&discord.ChannelOption{
+					OptionName:  "match_channel",
+					Description: "Match channel for which to get the notifications",
+					Required:    true,
+				},
+			},
+		},
+		{
+			Name:           "notification-delete",
+			Description:    "delete a notification from the notification list",
+			NoDMPermission: true,
+			DefaultMemberPermissions: discord.NewPermissions(
+				discord.PermissionViewChannel,
+				discord.PermissionSendMessages,
+			),
+
+			Options: []discord.CommandOption{
+				&discord.ChannelOption{
+					OptionName:  "match_channel",
+					Description: "Match channel for which to get the notification",
+					Required:    true,
+				},
+				&discord.IntegerOption{
+					OptionName:  "list_number",
+					Description: "Notification number in the notification list",
+					Required:    true,
+					Min:         option.NewInt(1),
+					Max:         option.NewInt(50),
+				},
+			},
+		},
+		{
+			Name:           "notification-add",
+			Description:    "add a new generated or custom notification to a match channel.",
+			NoDMPermission: true,
+			DefaultMemberPermissions: discord.NewPermissions(
+				discord.PermissionViewChannel,
+				discord.PermissionSendMessages,
+			),
+
+			Options: []discord.CommandOption{
+				&discord.ChannelOption{
+					OptionName:  "match_channel",
+					Description: "Match channel for which to get the notification",
+					Required:    true,
+				},
+				&discord.StringOption{
+					OptionName:  "notify_at",
+					Description: fmt.Sprintf("Time when the notification is triggered. Must be in this format: %s", parse.LayoutDateTime),
+					MinLength:   option.NewInt(len(parse.LayoutDateTime)),
+					MaxLength:   option.NewInt(len(parse.LayoutDateTime)),
+					Required:    true,
+				},
+				&discord.StringOption{
+					OptionName:   "location",
+					Description:  "Timzone location, e.g. Europe/Berlin.",
+					MinLength:    option.NewInt(1),
+					Required:     true,
+					Autocomplete: true,
+				},
+				&discord.StringOption{
+					OptionName:  "custom_text",
+					Description: "Leave empty for a default generated message",
+					Required:    false,
+				},
+			},
+		},
+		{
+			Name:           "announcements-disable",
+			Description:    "Disable periodic (daily, weekly, monthly, etc.) announcements of scheduled matches",
+			NoDMPermission: true,
+			DefaultMemberPermissions: discord.NewPermissions(
+				discord.PermissionAdministrator,
+			),
+			Options: []discord.CommandOption{
+				&discord.StringOption{
+					OptionName:   "announcement_name",
+					Description:  "Name of the announcement",
+					Required:     true,
+					Autocomplete: true,
+				},
+			},
+		},
+		{
+			Name:           "announcements-configuration",
+			Description:    "Get the current server announcement configuration",
+			NoDMPermission: true,
+			DefaultMemberPermissions: discord.NewPermissions(
+				discord.PermissionAdministrator,
+			),
+			Options: []discord.CommandOption{
+				&discord.StringOption{
+					OptionName:   "announcement_name",
+					Description:  "Name of the announcement (default: all announcements)",
+					Required:     false,
+					Autocomplete: true,
+				},
+			},
+		},
+		{
+			Name:           "announcements-enable",
+			Description:    "Enable periodic announcements hourly, daily, weekly, monthly, etc. ahead of scheduled matches",
+			NoDMPermission: true,
+			DefaultMemberPermissions: discord.NewPermissions(
+				discord.PermissionAdministrator,
+			),
+			Options: []discord.CommandOption{
+				&discord.StringOption{
+					OptionName:   "announcement_name",
+					Description:  "Unique name of the announcement, an existing announcement is replaced, e.g. weekly",
+					MinLength:    option.NewInt(1),
+					MaxLength:    option.NewInt(MaxAnnouncementNameLength),
+					Required:     true,
+					Autocomplete: true,
+				},
+				&discord.ChannelOption{
+					OptionName:  "announcement_channel",
+					Description: "Channel where the announcement should be sent to",
+					Required:    true,
+				},
+				&discord.StringOption{
+					OptionName:  "starts_at",
+					Description: fmt.Sprintf("Time when the first announcement starts. Must be in this format: %s", parse.LayoutDateTime),
+					MinLength:   option.NewInt(len(parse.LayoutDateTime)),
+					MaxLength:   option.NewInt(len(parse.LayoutDateTime)),
+					Required:    true,
+				},
+				&discord.StringOption{
+					OptionName:  "ends_at",
+					Description: fmt.Sprintf("Time when the announcements should stop. Must be in this format: %s", parse.LayoutDateTime),
+					MinLength:   option.NewInt(len(parse.LayoutDateTime)),
+					MaxLength:   option.NewInt(len(parse.LayoutDateTime)),
+					Required:    true,
+				},
+				&discord.StringOption{
+					OptionName:   "location",
 					Description:  "Timzone location, e.g. Europe/Berlin.",
 					MinLength:    option.NewInt(1),
 					Required:     true,
@@ -802,10 +1980,17 @@ func (b *Bot) overrideCommands() error {
 				},
 				&discord.StringOption{
 					OptionName:  "interval",
-					Description: "Interval at and for which the announcement should be sent. e.g. 24h (1 day), 168h (1 week)",
+					Description: "Fixed interval at and for which the announcement should be sent. e.g. 24h (1 day), 168h (1 week)",
 					MinLength:   option.NewInt(2),  // 1h is min
 					MaxLength:   option.NewInt(11), // 8760h00m00s is max
-					Required:    true,
+					Required:    false,
+				},
+				&discord.StringOption{
+					OptionName:  "schedule",
+					Description: "Calendar schedule in the location, e.g. mon 18:00, daily 09:00, monthly 1 09:00, cron 0 18 * * 1",
+					MinLength:   option.NewInt(1),
+					MaxLength:   option.NewInt(256),
+					Required:    false,
 				},
 				&discord.StringOption{
 					OptionName:  "custom_text_before",
@@ -817,10 +2002,20 @@ func (b *Bot) overrideCommands() error {
 					Description: "Custom text after the generated annoncement.",
 					Required:    false,
 				},
+				&discord.StringOption{
+					OptionName:   divisionNameOptionName,
+					Description:  "Only announce the matches of this division",
+					MinLength:    option.NewInt(1),
+					MaxLength:    option.NewInt(MaxDivisionNameLength),
+					Required:     false,
+					Autocomplete: true,
+				},
 			},
 		},
 	}
 
+	localizeCommands(userCommandList)
+
 	// update user facing commands
 	return cmdroute.OverwriteCommands(b.state, userCommandList)
 }
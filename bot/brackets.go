@@ -0,0 +1,867 @@
+package bot
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/diamondburned/arikawa/v3/api"
+	"github.com/diamondburned/arikawa/v3/api/cmdroute"
+	"github.com/diamondburned/arikawa/v3/discord"
+	"github.com/diamondburned/arikawa/v3/utils/json/option"
+	"github.com/jxs13/league-discord-bot/internal/bracket"
+	"github.com/jxs13/league-discord-bot/internal/discordutils"
+	"github.com/jxs13/league-discord-bot/internal/format"
+	"github.com/jxs13/league-discord-bot/internal/i18n"
+	"github.com/jxs13/league-discord-bot/internal/options"
+	"github.com/jxs13/league-discord-bot/internal/parse"
+	"github.com/jxs13/league-discord-bot/internal/season"
+	"github.com/jxs13/league-discord-bot/sqlc"
+)
+
+const (
+	MaxBracketTeams      = 64
+	MaxBracketNameLength = 32
+)
+
+func (b *Bot) commandBracketCreate(ctx context.Context, data cmdroute.CommandData) (resp *api.InteractionResponseData) {
+	var (
+		guildID    = data.Event.GuildID
+		guildIDStr = guildID.String()
+		now        = time.Now()
+		userIDStr  = data.Event.SenderID().String()
+	)
+
+	err := b.TxQueries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		err := b.checkAccess(ctx, q, data.Event, WRITE)
+		if err != nil {
+			return err
+		}
+
+		name := strings.TrimSpace(data.Options.Find("name").String())
+		if name == "" || len([]rune(name)) > MaxBracketNameLength {
+			return i18n.Errorf("error.swiss_name_length", MaxBracketNameLength)
+		}
+
+		kind := bracket.Kind(data.Options.Find("kind").String())
+
+		loc, err := parse.Location(data.Options.Find("location").String())
+		if err != nil {
+			return fmt.Errorf("invalid parameter 'location': %w", err)
+		}
+
+		slots, err := season.ParseSlots(data.Options.Find("slots").String())
+		if err != nil {
+			return fmt.Errorf("invalid parameter 'slots': %w", err)
+		}
+
+		moderators, err := parse.UserMentions(data.Options.Find("moderators").String())
+		if err != nil {
+			return fmt.Errorf("invalid parameter 'moderators': %w", err)
+		}
+		if len(moderators) == 0 {
+			return i18n.Errorf("error.moderators_required")
+		}
+
+		announcementChannelID, okChannel, err := options.OptionalChannelID("announcement_channel", data.Options)
+		if err != nil {
+			return err
+		}
+
+		participantsPerTeam, _, err := options.OptionalInteger("participants_per_team", data.Options)
+		if err != nil {
+			return err
+		}
+		if participantsPerTeam < 0 {
+			return i18n.Errorf("error.participants_per_team_negative")
+		}
+
+		seeds, err := b.bracketSeeds(ctx, q, guildID, data.Options)
+		if err != nil {
+			return err
+		}
+
+		err = b.checkRoleIDs(guildID, seeds...)
+		if err != nil {
+			return err
+		}
+
+		err = b.checkUserIDs(guildID, moderators...)
+		if err != nil {
+			return err
+		}
+
+		if okChannel {
+			err = b.checkIsGuildChannel(data.Event, announcementChannelID)
+			if err != nil {
+				return err
+			}
+		}
+
+		teamIDs := make([]string, 0, len(seeds))
+		for _, rid := range seeds {
+			teamIDs = append(teamIDs, rid.String())
+		}
+
+		bk, err := bracket.New(kind, teamIDs)
+		if err != nil {
+			return err
+		}
+
+		_, err = q.GetBracketByName(ctx, sqlc.GetBracketByNameParams{
+			GuildID: guildIDStr,
+			Name:    name,
+		})
+		if err == nil {
+			return i18n.Errorf("error.bracket_exists", format.MarkdownInlineCodeBlock(name))
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("error getting bracket: %w", err)
+		}
+
+		// bracket matches are scheduled as season fixtures, whose channels are created once their access window opens
+		seasonID, err := q.AddSeason(ctx, sqlc.AddSeasonParams{
+			GuildID:             guildIDStr,
+			ParticipantsPerTeam: participantsPerTeam,
+			CreatedAt:           now.Unix(),
+			CreatedBy:           userIDStr,
+		})
+		if err != nil {
+			return fmt.Errorf("error adding bracket season: %w", err)
+		}
+
+		err = q.ConfirmSeason(ctx, seasonID)
+		if err != nil {
+			return fmt.Errorf("error confirming bracket season: %w", err)
+		}
+
+		slotNames := make([]string, 0, len(slots))
+		for _, s := range slots {
+			slotNames = append(slotNames, s.String())
+		}
+
+		moderatorIDs := make([]string, 0, len(moderators))
+		for _, uid := range moderators {
+			moderatorIDs = append(moderatorIDs, uid.String())
+		}
+
+		var channelIDStr string
+		if okChannel {
+			channelIDStr = announcementChannelID.String()
+		}
+
+		bracketID, err := q.AddBracket(ctx, sqlc.AddBracketParams{
+			GuildID:      guildIDStr,
+			SeasonID:     seasonID,
+			Name:         name,
+			Kind:         string(kind),
+			Slots:        strings.Join(slotNames, ", "),
+			Location:     loc.String(),
+			ModeratorIds: strings.Join(moderatorIDs, " "),
+			ChannelID:    channelIDStr,
+			CreatedAt:    now.Unix(),
+			CreatedBy:    userIDStr,
+		})
+		if err != nil {
+			return fmt.Errorf("error adding bracket: %w", err)
+		}
+
+		br, err := q.GetBracketByName(ctx, sqlc.GetBracketByNameParams{
+			GuildID: guildIDStr,
+			Name:    name,
+		})
+		if err != nil {
+			return fmt.Errorf("error getting bracket: %w", err)
+		}
+
+		fixtureIDs := make([]int64, len(bk.Slots))
+		scheduled, err := b.scheduleBracketMatches(ctx, q, br, bk, fixtureIDs, bk.Ready(), now)
+		if err != nil {
+			return err
+		}
+
+		err = saveBracket(ctx, q, bracketID, bk, fixtureIDs)
+		if err != nil {
+			return err
+		}
+
+		err = b.createFixtureMatches(ctx, q, seasonID, now)
+		if err != nil {
+			return err
+		}
+
+		err = b.refreshJobSchedules(ctx, q)
+		if err != nil {
+			return err
+		}
+
+		log.Printf("user %s created %s bracket %q with %d teams in guild %s", userIDStr, kind, name, len(seeds), guildIDStr)
+		resp = &api.InteractionResponseData{
+			Content:         option.NewNullableString(formatBracket(i18n.FromContext(ctx), br, bk, scheduled)),
+			Flags:           discord.EphemeralMessage,
+			AllowedMentions: &api.AllowedMentions{ /* none */ },
+		}
+		return nil
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return resp
+}
+
+func (b *Bot) commandBracketShow(ctx context.Context, data cmdroute.CommandData) (resp *api.InteractionResponseData) {
+	var content string
+	err := b.TxQueries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		err := b.checkAccess(ctx, q, data.Event, READ)
+		if err != nil {
+			return err
+		}
+
+		br, err := getBracketByName(ctx, q, data.Event.GuildID, data.Options.Find("bracket_name").String())
+		if err != nil {
+			return err
+		}
+
+		bk, fixtureIDs, err := loadBracket(ctx, q, br)
+		if err != nil {
+			return err
+		}
+
+		scheduled, err := bracketMatchTimes(ctx, q, br, fixtureIDs)
+		if err != nil {
+			return err
+		}
+
+		content = formatBracket(i18n.FromContext(ctx), br, bk, scheduled)
+		return nil
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return &api.InteractionResponseData{
+		Content:         option.NewNullableString(content),
+		Flags:           discord.EphemeralMessage,
+		AllowedMentions: &api.AllowedMentions{ /* none */ },
+	}
+}
+
+func (b *Bot) commandBracketDelete(ctx context.Context, data cmdroute.CommandData) (resp *api.InteractionResponseData) {
+	var content string
+	err := b.TxQueries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		err := b.checkAccess(ctx, q, data.Event, WRITE)
+		if err != nil {
+			return err
+		}
+
+		br, err := getBracketByName(ctx, q, data.Event.GuildID, data.Options.Find("bracket_name").String())
+		if err != nil {
+			return err
+		}
+
+		// deleting the season removes the bracket and its fixtures, matches whose channel was not created yet are deleted beforehand
+		err = b.deleteSeasonMatchesWithoutChannel(ctx, q, data.Event.GuildID, br.SeasonID)
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteSeason(ctx, br.SeasonID)
+		if err != nil {
+			return fmt.Errorf("error deleting bracket: %w", err)
+		}
+
+		err = b.refreshJobSchedules(ctx, q)
+		if err != nil {
+			return err
+		}
+
+		log.Printf("user %s deleted bracket %q in guild %s", data.Event.SenderID(), br.Name, br.GuildID)
+		content = i18n.T(i18n.FromContext(ctx), "bracket.deleted", format.MarkdownInlineCodeBlock(br.Name))
+		return nil
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return &api.InteractionResponseData{
+		Content: option.NewNullableString(content),
+		Flags:   discord.EphemeralMessage,
+	}
+}
+
+// bracketSeeds returns the bracket teams sorted by their seed.
+// Without explicitly given teams, the best teams of the current standings are seeded.
+func (b *Bot) bracketSeeds(ctx context.Context, q *sqlc.Queries, guildID discord.GuildID, opts discord.CommandInteractionOptions) ([]discord.RoleID, error) {
+	size, okSize, err := options.OptionalInteger("size", opts)
+	if err != nil {
+		return nil, err
+	}
+
+	seedByStandings, okSeed, err := options.BoolOption("seed_by_standings", opts)
+	if err != nil {
+		return nil, err
+	}
+
+	teamsInput := opts.Find("teams").String()
+	if teamsInput != "" && okSize {
+		return nil, i18n.Errorf("error.bracket_size_with_teams")
+	}
+
+	table, err := guildStandings(ctx, q, guildID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var seeds []discord.RoleID
+	if teamsInput == "" {
+		if okSeed && !seedByStandings {
+			return nil, i18n.Errorf("error.bracket_seed_without_teams")
+		}
+
+		for _, row := range table {
+			rid, err := parse.RoleID(row.TeamID)
+			if err != nil {
+				return nil, err
+			}
+			seeds = append(seeds, rid)
+		}
+		if okSize && int(size) < len(seeds) {
+			seeds = seeds[:size]
+		}
+	} else {
+		seeds, err = parse.RoleMentions(teamsInput)
+		if err != nil {
+			return nil, fmt.Errorf("invalid parameter 'teams': %w", err)
+		}
+
+		if seedByStandings {
+			rank := make(map[discord.RoleID]int, len(table))
+			for idx, row := range table {
+				rid, err := parse.RoleID(row.TeamID)
+				if err != nil {
+					return nil, err
+				}
+				rank[rid] = idx
+			}
+
+			// teams without any final results are seeded last in the given order
+			slices.SortStableFunc(seeds, func(a, b discord.RoleID) int {
+				ra, okA := rank[a]
+				rb, okB := rank[b]
+				switch {
+				case okA && okB:
+					return ra - rb
+				case okA:
+					return -1
+				case okB:
+					return 1
+				default:
+					return 0
+				}
+			})
+		}
+	}
+
+	if len(seeds) < 2 || len(seeds) > MaxBracketTeams {
+		return nil, i18n.Errorf("error.bracket_teams_count", MaxBracketTeams, len(seeds))
+	}
+	return seeds, nil
+}
+
+// advanceBracket moves the winner of a finalized bracket match on and schedules all matches whose teams became known.
+// Matches that are not part of a bracket are ignored. The channel is invalid in case the match has no channel anymore.
+func (b *Bot) advanceBracket(ctx context.Context, q *sqlc.Queries, matchID int64, channelID discord.ChannelID) error {
+	fixture, err := q.GetFixtureByMatch(ctx, matchID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return fmt.Errorf("error getting fixture: %w", err)
+	}
+
+	br, err := q.GetBracketOfFixture(ctx, fixture.FixtureID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return fmt.Errorf("error getting bracket: %w", err)
+	}
+
+	bk, fixtureIDs, err := loadBracket(ctx, q, br)
+	if err != nil {
+		return err
+	}
+
+	lang, err := guildLanguage(ctx, q, br.GuildID)
+	if err != nil {
+		return err
+	}
+
+	index := slices.Index(fixtureIDs, fixture.FixtureID)
+	if index < 0 {
+		return nil
+	}
+
+	if bk.Slots[index].Done {
+		log.Printf("bracket match %d of bracket %q was already decided, ignoring changed result of match %d", index, br.Name, matchID)
+		return nil
+	}
+
+	results, err := q.ListTeamResults(ctx, matchID)
+	if err != nil {
+		return fmt.Errorf("error listing team results: %w", err)
+	}
+
+	winner, ok := bracketWinner(results)
+	if !ok {
+		if !channelID.IsValid() {
+			log.Printf("bracket match %d of bracket %q ended in a draw", index, br.Name)
+			return nil
+		}
+
+		_, err = b.state.SendMessageComplex(channelID, api.SendMessageData{
+			Content:         i18n.T(lang, "bracket.draw", format.MarkdownInlineCodeBlock(br.Name)),
+			AllowedMentions: &api.AllowedMentions{ /* none */ },
+		})
+		if err != nil && !discordutils.IsStatus4XX(err) {
+			return fmt.Errorf("error sending bracket draw notice: %w", err)
+		}
+		return nil
+	}
+
+	ready, err := bk.Advance(index, winner)
+	if err != nil {
+		return err
+	}
+
+	scheduled, err := b.scheduleBracketMatches(ctx, q, br, bk, fixtureIDs, ready, time.Now())
+	if err != nil {
+		return err
+	}
+
+	err = saveBracket(ctx, q, br.BracketID, bk, fixtureIDs)
+	if err != nil {
+		return err
+	}
+
+	err = b.createFixtureMatches(ctx, q, br.SeasonID, time.Now())
+	if err != nil {
+		return err
+	}
+
+	err = b.refreshJobSchedules(ctx, q)
+	if err != nil {
+		return err
+	}
+
+	winnerID, err := parse.RoleID(winner)
+	if err != nil {
+		return err
+	}
+
+	var sb strings.Builder
+	sb.WriteString(i18n.T(lang, "bracket.advances", winnerID.Mention(), format.MarkdownInlineCodeBlock(br.Name)))
+	if champion, ok := bk.Champion(); ok {
+		championID, err := parse.RoleID(champion)
+		if err != nil {
+			return err
+		}
+		sb.WriteString(i18n.T(lang, "bracket.won", championID.Mention(), format.MarkdownInlineCodeBlock(br.Name)))
+	}
+	for _, idx := range ready {
+		s := bk.Slots[idx]
+		sb.WriteString(i18n.T(
+			lang,
+			"bracket.next_match",
+			bracketTeamMention(lang, s, 0),
+			bracketTeamMention(lang, s, 1),
+			format.DiscordLongDateTime(scheduled[idx]),
+		))
+	}
+
+	log.Printf("team %s advanced in bracket %q of guild %s", winner, br.Name, br.GuildID)
+	if br.ChannelID == "" {
+		return nil
+	}
+
+	announcementChannelID, err := parse.ChannelID(br.ChannelID)
+	if err != nil {
+		return err
+	}
+
+	_, err = b.state.SendMessageComplex(announcementChannelID, api.SendMessageData{
+		Content:         sb.String(),
+		AllowedMentions: &api.AllowedMentions{ /* none */ },
+	})
+	if err != nil && !discordutils.IsStatus4XX(err) {
+		return fmt.Errorf("error sending bracket announcement: %w", err)
+	}
+	return nil
+}
+
+// revertBracket undoes the advancement of a bracket match whose final result was reopened.
+// Follow-up matches that were scheduled because of that result are deleted again.
+// Matches that are not part of a bracket or were not decided yet are ignored.
+func (b *Bot) revertBracket(ctx context.Context, q *sqlc.Queries, matchID int64) error {
+	fixture, err := q.GetFixtureByMatch(ctx, matchID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return fmt.Errorf("error getting fixture: %w", err)
+	}
+
+	br, err := q.GetBracketOfFixture(ctx, fixture.FixtureID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return fmt.Errorf("error getting bracket: %w", err)
+	}
+
+	bk, fixtureIDs, err := loadBracket(ctx, q, br)
+	if err != nil {
+		return err
+	}
+
+	index := slices.Index(fixtureIDs, fixture.FixtureID)
+	if index < 0 || !bk.Slots[index].Done {
+		return nil
+	}
+
+	unready, err := bk.Revert(index)
+	if err != nil {
+		log.Printf("failed to revert bracket match %d of bracket %q: %v", index, br.Name, err)
+		return i18n.Errorf("error.bracket_revert", format.MarkdownInlineCodeBlock(br.Name))
+	}
+
+	for _, idx := range unready {
+		if fixtureIDs[idx] == 0 {
+			continue
+		}
+
+		err = b.deleteFixtureMatch(ctx, q, fixtureIDs[idx])
+		if err != nil {
+			return err
+		}
+		fixtureIDs[idx] = 0
+	}
+
+	err = saveBracket(ctx, q, br.BracketID, bk, fixtureIDs)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("reverted bracket match %d of bracket %q in guild %s", index, br.Name, br.GuildID)
+	return b.refreshJobSchedules(ctx, q)
+}
+
+// deleteFixtureMatch deletes a fixture together with its match and the match channel.
+func (b *Bot) deleteFixtureMatch(ctx context.Context, q *sqlc.Queries, fixtureID int64) error {
+	f, err := q.GetFixture(ctx, fixtureID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return fmt.Errorf("error getting fixture: %w", err)
+	}
+
+	if f.MatchID != 0 {
+		m, err := q.GetMatch(ctx, f.MatchID)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("error getting match of fixture %d: %w", fixtureID, err)
+		}
+		if err == nil {
+			err = b.deleteMatchAndChannel(ctx, q, m, "bracket match was unscheduled because a previous result was reopened")
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	err = q.DeleteFixture(ctx, fixtureID)
+	if err != nil {
+		return fmt.Errorf("error deleting fixture: %w", err)
+	}
+	return nil
+}
+
+// deleteMatchAndChannel deletes a match together with its scheduled event and its channel.
+// The match is deleted first, so that the channel delete handler does not find it anymore.
+func (b *Bot) deleteMatchAndChannel(ctx context.Context, q *sqlc.Queries, m sqlc.Match, reason string) error {
+	err := q.DeleteMatch(ctx, m.MatchID)
+	if err != nil {
+		return fmt.Errorf("error deleting match %d: %w", m.Number, err)
+	}
+
+	guildID, err := parse.GuildID(m.GuildID)
+	if err != nil {
+		return err
+	}
+
+	if m.EventID != "" {
+		err = b.cancelGuildEvent(guildID, m.EventID, reason)
+		if err != nil {
+			return err
+		}
+	}
+
+	if m.ChannelID != "" {
+		channelID, err := parse.ChannelID(m.ChannelID)
+		if err != nil {
+			return err
+		}
+
+		err = b.state.DeleteChannel(channelID, api.AuditLogReason(reason))
+		if err != nil && !discordutils.IsStatus4XX(err) {
+			return fmt.Errorf("error deleting match channel: %w", err)
+		}
+	}
+	return b.refreshScheduleBoard(ctx, q, guildID)
+}
+
+// scheduleBracketMatches adds a fixture for every given slot at the next weekly slot of the bracket.
+// Moderators are assigned in turns.
+func (b *Bot) scheduleBracketMatches(
+	ctx context.Context,
+	q *sqlc.Queries,
+	br sqlc.Bracket,
+	bk *bracket.Bracket,
+	fixtureIDs []int64,
+	ready []int,
+	now time.Time,
+) (map[int]time.Time, error) {
+	scheduled := make(map[int]time.Time, len(ready))
+	if len(ready) == 0 {
+		return scheduled, nil
+	}
+
+	scheduledAt, err := nextFixtureTime(ctx, q, br.GuildID, br.Slots, br.Location, now)
+	if err != nil {
+		return nil, err
+	}
+
+	moderatorIDs := strings.Fields(br.ModeratorIds)
+	if len(moderatorIDs) == 0 {
+		return nil, fmt.Errorf("bracket %q has no moderators", br.Name)
+	}
+
+	turn := br.ModeratorTurn
+	for _, idx := range ready {
+		s := bk.Slots[idx]
+
+		fixtureID, err := addFixture(ctx, q, br.SeasonID, int64(s.Round), scheduledAt, moderatorIDs[turn%int64(len(moderatorIDs))], s.Teams[:])
+		if err != nil {
+			return nil, err
+		}
+		turn++
+
+		fixtureIDs[idx] = fixtureID
+		scheduled[idx] = scheduledAt
+	}
+
+	err = q.UpdateBracketModeratorTurn(ctx, sqlc.UpdateBracketModeratorTurnParams{
+		BracketID:     br.BracketID,
+		ModeratorTurn: turn,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error updating bracket moderator turn: %w", err)
+	}
+	return scheduled, nil
+}
+
+func getBracketByName(ctx context.Context, q *sqlc.Queries, guildID discord.GuildID, name string) (sqlc.Bracket, error) {
+	br, err := q.GetBracketByName(ctx, sqlc.GetBracketByNameParams{
+		GuildID: guildID.String(),
+		Name:    strings.TrimSpace(name),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return sqlc.Bracket{}, i18n.Errorf("error.bracket_not_found", format.MarkdownInlineCodeBlock(name))
+		}
+		return sqlc.Bracket{}, fmt.Errorf("error getting bracket: %w", err)
+	}
+	return br, nil
+}
+
+// loadBracket returns the bracket and the fixture IDs of its slots.
+func loadBracket(ctx context.Context, q *sqlc.Queries, br sqlc.Bracket) (*bracket.Bracket, []int64, error) {
+	rows, err := q.ListBracketSlots(ctx, br.BracketID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error listing bracket slots: %w", err)
+	}
+
+	bk := &bracket.Bracket{
+		Kind:  bracket.Kind(br.Kind),
+		Slots: make([]bracket.Slot, 0, len(rows)),
+	}
+	fixtureIDs := make([]int64, 0, len(rows))
+	for _, r := range rows {
+		bk.Slots = append(bk.Slots, bracket.Slot{
+			Index:      int(r.SlotIndex),
+			Section:    bracket.Section(r.Section),
+			Round:      int(r.Round),
+			Position:   int(r.Position),
+			Teams:      [2]string{r.Team1, r.Team2},
+			Byes:       [2]bool{r.Bye1 != 0, r.Bye2 != 0},
+			WinnerTo:   int(r.WinnerTo),
+			WinnerSide: int(r.WinnerSide),
+			LoserTo:    int(r.LoserTo),
+			LoserSide:  int(r.LoserSide),
+			Done:       r.Done != 0,
+			Winner:     r.Winner,
+		})
+		fixtureIDs = append(fixtureIDs, r.FixtureID)
+	}
+	return bk, fixtureIDs, nil
+}
+
+func saveBracket(ctx context.Context, q *sqlc.Queries, bracketID int64, bk *bracket.Bracket, fixtureIDs []int64) error {
+	for idx, s := range bk.Slots {
+		err := q.AddBracketSlot(ctx, sqlc.AddBracketSlotParams{
+			BracketID:  bracketID,
+			SlotIndex:  int64(s.Index),
+			Section:    string(s.Section),
+			Round:      int64(s.Round),
+			Position:   int64(s.Position),
+			Team1:      s.Teams[0],
+			Team2:      s.Teams[1],
+			Bye1:       boolToInt64(s.Byes[0]),
+			Bye2:       boolToInt64(s.Byes[1]),
+			WinnerTo:   int64(s.WinnerTo),
+			WinnerSide: int64(s.WinnerSide),
+			LoserTo:    int64(s.LoserTo),
+			LoserSide:  int64(s.LoserSide),
+			Done:       boolToInt64(s.Done),
+			Winner:     s.Winner,
+			FixtureID:  fixtureIDs[idx],
+		})
+		if err != nil {
+			return fmt.Errorf("error saving bracket slot: %w", err)
+		}
+	}
+	return nil
+}
+
+// bracketMatchTimes returns the scheduled times of all scheduled bracket matches by their slot index.
+func bracketMatchTimes(ctx context.Context, q *sqlc.Queries, br sqlc.Bracket, fixtureIDs []int64) (map[int]time.Time, error) {
+	fixtures, err := q.ListSeasonFixtures(ctx, br.SeasonID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing bracket fixtures: %w", err)
+	}
+
+	byFixture := make(map[int64]time.Time, len(fixtures))
+	for _, f := range fixtures {
+		byFixture[f.FixtureID] = time.Unix(f.ScheduledAt, 0)
+	}
+
+	times := make(map[int]time.Time, len(fixtures))
+	for idx, fid := range fixtureIDs {
+		if at, ok := byFixture[fid]; ok {
+			times[idx] = at
+		}
+	}
+	return times, nil
+}
+
+// bracketWinner returns the team with the highest score, in case there is exactly one.
+func bracketWinner(results []sqlc.ListTeamResultsRow) (string, bool) {
+	var (
+		winner string
+		best   int64
+		unique bool
+	)
+	for idx, r := range results {
+		switch {
+		case idx == 0 || r.Score > best:
+			winner, best, unique = r.RoleID, r.Score, true
+		case r.Score == best:
+			unique = false
+		}
+	}
+	return winner, unique
+}
+
+func formatBracket(lang i18n.Language, br sqlc.Bracket, bk *bracket.Bracket, scheduled map[int]time.Time) string {
+	var sb strings.Builder
+	kind := i18n.T(lang, "bracket.single_elimination")
+	if bk.Kind == bracket.DoubleElimination {
+		kind = i18n.T(lang, "bracket.double_elimination")
+	}
+	sb.WriteString(fmt.Sprintf("%s (%s)\n", format.MarkdownFat(br.Name), kind))
+
+	if champion, ok := bk.Champion(); ok {
+		if rid, err := parse.RoleID(champion); err == nil {
+			sb.WriteString(i18n.T(lang, "bracket.winner", rid.Mention()))
+		}
+	}
+
+	sections := []struct {
+		section bracket.Section
+		name    string
+	}{
+		{bracket.Winners, i18n.T(lang, "bracket.winners")},
+		{bracket.Losers, i18n.T(lang, "bracket.losers")},
+		{bracket.Final, i18n.T(lang, "bracket.final")},
+	}
+	if bk.Kind == bracket.SingleElimination {
+		sections = sections[:1]
+		sections[0].name = i18n.T(lang, "bracket.bracket")
+	}
+
+	for _, sec := range sections {
+		sb.WriteString(fmt.Sprintf("\n%s\n", format.MarkdownFat(sec.name)))
+		round := 0
+		for _, s := range bk.Slots {
+			if s.Section != sec.section || (s.Byes[0] && s.Byes[1]) {
+				continue
+			}
+			if s.Round != round {
+				round = s.Round
+				if sec.section != bracket.Final {
+					sb.WriteString(i18n.T(lang, "bracket.round", round))
+				}
+			}
+
+			line := i18n.T(lang, "bracket.pairing", bracketTeamMention(lang, s, 0), bracketTeamMention(lang, s, 1))
+			switch {
+			case s.Done && s.Winner != "":
+				if rid, err := parse.RoleID(s.Winner); err == nil {
+					line += i18n.T(lang, "bracket.pairing_advances", rid.Mention())
+				}
+			case s.Ready():
+				if at, ok := scheduled[s.Index]; ok {
+					line += fmt.Sprintf(", %s", format.DiscordLongDateTime(at))
+				}
+			}
+			line += "\n"
+
+			// discord messages are limited to 2000 characters
+			if sb.Len()+len(line) > 1900 {
+				sb.WriteString("...\n")
+				return sb.String()
+			}
+			sb.WriteString(line)
+		}
+	}
+	return sb.String()
+}
+
+func bracketTeamMention(lang i18n.Language, s bracket.Slot, side int) string {
+	if s.Byes[side] {
+		return i18n.T(lang, "bracket.bye")
+	}
+	if s.Teams[side] == "" {
+		return i18n.T(lang, "bracket.tbd")
+	}
+	rid, err := parse.RoleID(s.Teams[side])
+	if err != nil {
+		return i18n.T(lang, "bracket.tbd")
+	}
+	return rid.Mention()
+}
@@ -0,0 +1,378 @@
+package bot
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/diamondburned/arikawa/v3/api"
+	"github.com/diamondburned/arikawa/v3/api/cmdroute"
+	"github.com/diamondburned/arikawa/v3/discord"
+	"github.com/diamondburned/arikawa/v3/utils/json/option"
+	"github.com/jxs13/league-discord-bot/internal/discordutils"
+	"github.com/jxs13/league-discord-bot/internal/format"
+	"github.com/jxs13/league-discord-bot/internal/i18n"
+	"github.com/jxs13/league-discord-bot/internal/model"
+	"github.com/jxs13/league-discord-bot/internal/msgtemplate"
+	"github.com/jxs13/league-discord-bot/internal/options"
+	"github.com/jxs13/league-discord-bot/internal/parse"
+	"github.com/jxs13/league-discord-bot/sqlc"
+)
+
+var (
+	PermissionArchivedAccess = discord.PermissionViewChannel |
+		discord.PermissionReadMessageHistory
+
+	PermissionArchivedDeny = discord.PermissionSendMessages |
+		discord.PermissionSendTTSMessages |
+		discord.PermissionAddReactions |
+		discord.PermissionAttachFiles
+)
+
+func (b *Bot) commandCancelMatch(ctx context.Context, data cmdroute.CommandData) (resp *api.InteractionResponseData) {
+	var (
+		guildID    = data.Event.GuildID
+		guildIDStr = guildID.String()
+		now        = time.Now()
+		nowUnix    = now.Unix()
+		userIDStr  = data.Event.SenderID().String()
+	)
+
+	err := b.TxQueries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		err := b.checkAccess(ctx, q, data.Event, WRITE)
+		if err != nil {
+			return err
+		}
+
+		reason := strings.TrimSpace(data.Options.Find("reason").String())
+		if reason == "" {
+			return i18n.Errorf("error.reason_empty")
+		}
+
+		deleteChannel, _, err := options.BoolOption("delete_channel", data.Options)
+		if err != nil {
+			return err
+		}
+
+		match, err := b.matchOption(ctx, q, data.Event, data.Options)
+		if err != nil {
+			return err
+		}
+
+		mention, err := matchMention(match)
+		if err != nil {
+			return err
+		}
+
+		if match.CancelledAt != 0 {
+			return i18n.Errorf("error.match_already_cancelled", mention, format.DiscordLongDateTime(time.Unix(match.CancelledAt, 0)))
+		}
+		if match.ChannelDeleted != 0 {
+			return i18n.Errorf("error.match_archived", mention)
+		}
+
+		// final results already count towards standings, ratings and brackets
+		r, err := q.GetResult(ctx, match.MatchID)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("error getting result: %w", err)
+		}
+		if err == nil && r.Status == ResultStatusConfirmed {
+			return i18n.Errorf("error.match_cancel_result_final", mention)
+		}
+
+		cfg, err := divisionConfig(ctx, q, guildIDStr, match.Division)
+		if err != nil {
+			return err
+		}
+
+		teamRoleIDs, err := b.listMatchTeamRoleIDs(ctx, q, match.MatchID)
+		if err != nil {
+			return err
+		}
+
+		modUserIDs, err := b.listMatchModeratorUserIDs(ctx, q, match.MatchID)
+		if err != nil {
+			return err
+		}
+
+		streamers, err := b.listMatchStreamerUserIDs(ctx, q, match.MatchID)
+		if err != nil {
+			return err
+		}
+
+		if match.EventID != "" {
+			err = b.cancelGuildEvent(guildID, match.EventID, reason)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = q.DeleteMatchNotifications(ctx, match.MatchID)
+		if err != nil {
+			return fmt.Errorf("error deleting match notifications: %w", err)
+		}
+
+		err = q.CloseParticipationEntry(ctx, match.MatchID)
+		if err != nil {
+			return fmt.Errorf("error closing participation entry: %w", err)
+		}
+
+		// matches whose channel is not created yet are known by their name
+		var (
+			c           *discord.Channel
+			channelName = matchName(match.Number)
+		)
+		if match.ChannelID != "" {
+			channelID, err := parse.ChannelID(match.ChannelID)
+			if err != nil {
+				return err
+			}
+
+			c, err = b.state.Channel(channelID)
+			if err != nil {
+				return fmt.Errorf("error getting channel: %w", err)
+			}
+			channelName = format.MarkdownInlineCodeBlock(c.Name)
+		}
+
+		scheduledAt := time.Unix(match.ScheduledAt, 0)
+		msg, err := formatNotification(
+			ctx,
+			q,
+			guildIDStr,
+			msgtemplate.KindMatchCancelled,
+			msgtemplate.Data{
+				Channel:     mention,
+				ChannelName: channelName,
+				ScheduledAt: format.DiscordLongDateTime(scheduledAt),
+				Reason:      reason,
+			},
+			teamRoleIDs,
+			modUserIDs,
+			streamers,
+			nil,
+			nil,
+		)
+		if err != nil {
+			return err
+		}
+
+		// the archived channel is kept for the usual amount of time after the cancellation
+		deleteAt := min(match.ChannelDeleteAt, now.Add(time.Duration(cfg.ChannelDeleteOffset)*time.Second).Unix())
+		err = q.CancelMatch(ctx, sqlc.CancelMatchParams{
+			MatchID:         match.MatchID,
+			CancelledAt:     nowUnix,
+			CancelReason:    reason,
+			ChannelDeleteAt: max(nowUnix, deleteAt),
+			UpdatedAt:       nowUnix,
+			UpdatedBy:       userIDStr,
+		})
+		if err != nil {
+			return fmt.Errorf("error cancelling match: %w", err)
+		}
+
+		var result string
+		switch {
+		case c != nil && deleteChannel:
+			// the match is kept for the record, only its channel is deleted
+			err = b.state.DeleteChannel(c.ID, api.AuditLogReason(reason))
+			if err != nil && !discordutils.IsStatus4XX(err) {
+				return fmt.Errorf("error deleting match channel: %w", err)
+			}
+
+			err = b.archiveOrphanedMatches(ctx, q, match.MatchID)
+			if err != nil {
+				return err
+			}
+
+			targetChannelID, err := b.cancellationNoticeChannel(ctx, q, match, data.Event.ChannelID)
+			if err != nil {
+				return err
+			}
+
+			_, err = b.state.SendMessageComplex(targetChannelID, msg)
+			if err != nil {
+				return fmt.Errorf("error sending cancellation notice: %w", err)
+			}
+
+			result = i18n.T(i18n.FromContext(ctx), "cancel.channel_deleted", channelName)
+		case c != nil:
+			err = b.archiveMatchChannel(ctx, q, match.MatchID, c, teamRoleIDs, modUserIDs, streamers)
+			if err != nil {
+				return err
+			}
+
+			_, err = b.state.SendMessageComplex(c.ID, msg)
+			if err != nil {
+				return fmt.Errorf("error sending cancellation notice: %w", err)
+			}
+
+			result = i18n.T(
+				i18n.FromContext(ctx),
+				"cancel.archived",
+				mention,
+				format.DiscordLongDateTime(time.Unix(deleteAt, 0)),
+			)
+		default:
+			// prevents the channel access routine from creating the channel of the cancelled match
+			err = q.UpdateMatchChannelAccessibility(ctx, sqlc.UpdateMatchChannelAccessibilityParams{
+				MatchID:           match.MatchID,
+				ChannelAccessible: 1,
+			})
+			if err != nil {
+				return fmt.Errorf("error updating match channel accessibility: %w", err)
+			}
+
+			targetChannelID, err := b.cancellationNoticeChannel(ctx, q, match, data.Event.ChannelID)
+			if err != nil {
+				return err
+			}
+
+			_, err = b.state.SendMessageComplex(targetChannelID, msg)
+			if err != nil {
+				return fmt.Errorf("error sending cancellation notice: %w", err)
+			}
+
+			result = i18n.T(i18n.FromContext(ctx), "cancel.cancelled", mention)
+		}
+
+		err = b.refreshJobSchedules(ctx, q)
+		if err != nil {
+			return err
+		}
+
+		err = b.refreshScheduleBoard(ctx, q, guildID)
+		if err != nil {
+			return err
+		}
+
+		log.Printf("cancelled match %d in guild %s (channel deleted: %t): %s", match.Number, guildID, deleteChannel, reason)
+
+		resp = &api.InteractionResponseData{
+			Content: option.NewNullableString(result),
+			Flags:   discord.EphemeralMessage,
+		}
+		return nil
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return resp
+}
+
+// cancelGuildEvent cancels the scheduled event of a match.
+// Events that do not exist anymore or cannot be cancelled anymore are ignored.
+func (b *Bot) cancelGuildEvent(guildID discord.GuildID, eventIDStr string, reason string) error {
+	eventID, err := parse.EventID(eventIDStr)
+	if err != nil {
+		return fmt.Errorf("failed to parse event id: %w", err)
+	}
+
+	_, err = b.state.EditScheduledEvent(guildID, eventID, api.AuditLogReason(reason), api.EditScheduledEventData{
+		Status: discord.CancelledEvent,
+	})
+	if err != nil {
+		if discordutils.IsStatus4XX(err) {
+			log.Printf("failed to cancel scheduled event %s in guild %s, ignoring: %v", eventID, guildID, err)
+			return nil
+		}
+		return fmt.Errorf("error cancelling scheduled event %s in guild %s: %w", eventID, guildID, err)
+	}
+	log.Printf("cancelled scheduled event %s in guild %s, reason: %s", eventID, guildID, reason)
+	return nil
+}
+
+// cancellationNoticeChannel returns the channel in which the cancellation of a match is announced
+// when the match has no channel. This is the announcement channel of the match's division or,
+// if there is none, the channel in which the match was cancelled.
+func (b *Bot) cancellationNoticeChannel(ctx context.Context, q *sqlc.Queries, match sqlc.Match, fallback discord.ChannelID) (discord.ChannelID, error) {
+	if match.Division == "" {
+		return fallback, nil
+	}
+
+	announcements, err := q.ListGuildAnnouncements(ctx, match.GuildID)
+	if err != nil {
+		return 0, fmt.Errorf("error listing announcement configurations: %w", err)
+	}
+	for _, a := range announcements {
+		if a.Division == match.Division {
+			return parse.ChannelID(a.ChannelID)
+		}
+	}
+	return fallback, nil
+}
+
+// archiveMatchChannel makes the match channel read-only for all participants.
+func (b *Bot) archiveMatchChannel(
+	ctx context.Context,
+	q *sqlc.Queries,
+	matchID int64,
+	c *discord.Channel,
+	teamRoleIDs []discord.RoleID,
+	modUserIDs []discord.UserID,
+	streamers []model.Streamer,
+) error {
+	ids := make([]discord.Snowflake, 0, len(teamRoleIDs)+len(modUserIDs)+len(streamers))
+	overwrites := make([]discord.Overwrite, 0, len(c.Overwrites)+cap(ids))
+
+	for _, rid := range teamRoleIDs {
+		ids = append(ids, discord.Snowflake(rid))
+		overwrites = append(overwrites, discord.Overwrite{
+			ID:    discord.Snowflake(rid),
+			Type:  discord.OverwriteRole,
+			Allow: PermissionArchivedAccess,
+			Deny:  PermissionArchivedDeny,
+		})
+	}
+
+	for _, uid := range modUserIDs {
+		ids = append(ids, discord.Snowflake(uid))
+		overwrites = append(overwrites, discord.Overwrite{
+			ID:    discord.Snowflake(uid),
+			Type:  discord.OverwriteMember,
+			Allow: PermissionArchivedAccess,
+			Deny:  PermissionArchivedDeny,
+		})
+	}
+
+	for _, s := range streamers {
+		ids = append(ids, discord.Snowflake(s.UserID))
+		overwrites = append(overwrites, discord.Overwrite{
+			ID:    discord.Snowflake(s.UserID),
+			Type:  discord.OverwriteMember,
+			Allow: PermissionArchivedAccess,
+			Deny:  PermissionArchivedDeny,
+		})
+	}
+
+	// keep everything else, e.g. the @everyone and the bot overwrites
+	for _, o := range c.Overwrites {
+		if slices.Contains(ids, o.ID) {
+			continue
+		}
+		overwrites = append(overwrites, o)
+	}
+
+	err := b.state.ModifyChannel(c.ID, api.ModifyChannelData{
+		Overwrites: &overwrites,
+	})
+	if err != nil {
+		return fmt.Errorf("error archiving channel %s: %w", c.ID, err)
+	}
+
+	// prevents the channel access routine from granting write access later on
+	err = q.UpdateMatchChannelAccessibility(ctx, sqlc.UpdateMatchChannelAccessibilityParams{
+		MatchID:           matchID,
+		ChannelAccessible: 1,
+	})
+	if err != nil {
+		return fmt.Errorf("error updating match channel accessibility: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,284 @@
+package bot
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/diamondburned/arikawa/v3/api"
+	"github.com/diamondburned/arikawa/v3/discord"
+	"github.com/jxs13/league-discord-bot/internal/discordutils"
+	"github.com/jxs13/league-discord-bot/internal/parse"
+	"github.com/jxs13/league-discord-bot/sqlc"
+)
+
+// MaxChannelsPerCategory is the Discord limitation of channels within a single category.
+// Matches which do not fit into the category of their guild or division are moved to overflow categories.
+const MaxChannelsPerCategory = 50
+
+// MaxGuildChannels is the Discord limitation of channels within a guild, including categories.
+const MaxGuildChannels = 500
+
+// matchCategoryName returns the name of the n-th match category of a guild or division, e.g. "matches", "matches-2".
+func matchCategoryName(division string, number int64) string {
+	name := defaultMatchCategoryName
+	if division != "" {
+		name = division
+	}
+	if number <= 1 {
+		return name
+	}
+	return fmt.Sprintf("%s-%d", name, number)
+}
+
+// matchChannelAvailable reports whether another match channel can be created in the guild without exceeding
+// the maximum number of concurrent matches or the channel limit of Discord. One additional slot is kept free
+// for an overflow category, which might be needed for the new channel.
+func (b *Bot) matchChannelAvailable(ctx context.Context, q *sqlc.Queries, guildID discord.GuildID) (bool, error) {
+	n, err := q.CountMatches(ctx, guildID.String())
+	if err != nil {
+		return false, fmt.Errorf("error counting matches: %w", err)
+	}
+	if n >= MaxConcurrentMatches {
+		return false, nil
+	}
+
+	channels, err := b.state.Channels(guildID)
+	if err != nil {
+		return false, fmt.Errorf("failed to list channels: %w", err)
+	}
+	return len(channels)+2 <= MaxGuildChannels, nil
+}
+
+// matchCategory returns the first match category of the guild or division which has a free channel slot.
+// The primary category is followed by the overflow categories in the order of their number.
+// In case all categories are full, a new overflow category is created, which is reported by created
+// so that the caller can delete it again when the transaction is rolled back.
+func (b *Bot) matchCategory(
+	ctx context.Context,
+	q *sqlc.Queries,
+	guildID discord.GuildID,
+	division string,
+	primaryID discord.ChannelID,
+) (categoryID discord.ChannelID, created bool, err error) {
+	channels, err := b.state.Channels(guildID)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to list channels: %w", err)
+	}
+
+	exists := make(map[discord.ChannelID]bool)
+	counts := make(map[discord.ChannelID]int)
+	for _, c := range channels {
+		if c.Type == discord.GuildCategory {
+			exists[c.ID] = true
+		} else if c.ParentID.IsValid() {
+			counts[c.ParentID]++
+		}
+	}
+
+	// a missing primary category is recreated when the channel is created
+	if !exists[primaryID] || counts[primaryID] < MaxChannelsPerCategory {
+		return primaryID, false, nil
+	}
+
+	overflows, err := q.ListOverflowCategories(ctx, sqlc.ListOverflowCategoriesParams{
+		GuildID:  guildID.String(),
+		Division: division,
+	})
+	if err != nil {
+		return 0, false, fmt.Errorf("error listing overflow categories: %w", err)
+	}
+
+	used := make(map[int64]bool, len(overflows))
+	for _, o := range overflows {
+		id, err := parse.ChannelID(o.CategoryID)
+		if err != nil {
+			return 0, false, err
+		}
+
+		if !exists[id] {
+			// category was deleted while the bot was turned off
+			err = q.DeleteOverflowCategory(ctx, o.CategoryID)
+			if err != nil {
+				return 0, false, fmt.Errorf("error deleting overflow category: %w", err)
+			}
+			continue
+		}
+
+		if counts[id] < MaxChannelsPerCategory {
+			return id, false, nil
+		}
+		used[o.Number] = true
+	}
+
+	// the primary category is the first one, so overflow categories start with the second
+	number := int64(2)
+	for used[number] {
+		number++
+	}
+
+	category, err := b.createMatchCategory(guildID, matchCategoryName(division, number), discordutils.LastChannelPosition(channels))
+	if err != nil {
+		return 0, false, fmt.Errorf("error creating overflow category: %w", err)
+	}
+
+	err = q.AddOverflowCategory(ctx, sqlc.AddOverflowCategoryParams{
+		CategoryID: category.ID.String(),
+		GuildID:    guildID.String(),
+		Division:   division,
+		Number:     number,
+	})
+	if err != nil {
+		if err := b.state.DeleteChannel(category.ID, api.AuditLogReason(err.Error())); err != nil {
+			log.Printf("error deleting category %s: %v", category.ID, err)
+		}
+		return 0, false, fmt.Errorf("error adding overflow category: %w", err)
+	}
+	return category.ID, true, nil
+}
+
+// removeEmptyOverflowCategory deletes an overflow category which does not contain any channels anymore
+// besides the channel which was just deleted. Primary categories of guilds and divisions are kept.
+func (b *Bot) removeEmptyOverflowCategory(
+	ctx context.Context,
+	q *sqlc.Queries,
+	guildID discord.GuildID,
+	categoryID discord.ChannelID,
+	deletedChannelID discord.ChannelID,
+) error {
+	if !categoryID.IsValid() {
+		return nil
+	}
+
+	_, err := q.GetOverflowCategory(ctx, categoryID.String())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return fmt.Errorf("error getting overflow category: %w", err)
+	}
+
+	channels, err := b.state.Channels(guildID)
+	if err != nil {
+		return fmt.Errorf("failed to list channels: %w", err)
+	}
+
+	for _, c := range channels {
+		if c.ParentID == categoryID && c.ID != deletedChannelID {
+			return nil
+		}
+	}
+
+	err = q.DeleteOverflowCategory(ctx, categoryID.String())
+	if err != nil {
+		return fmt.Errorf("error deleting overflow category: %w", err)
+	}
+
+	err = b.state.DeleteChannel(categoryID, api.AuditLogReason("overflow category is empty"))
+	if err != nil && !discordutils.IsStatus4XX(err) {
+		return fmt.Errorf("error deleting overflow category %s: %w", categoryID, err)
+	}
+	return nil
+}
+
+// recreateOverflowCategory recreates a deleted overflow category in case it still contained match channels
+// and moves them back into it. Empty overflow categories are forgotten.
+func (b *Bot) recreateOverflowCategory(ctx context.Context, q *sqlc.Queries, guildID discord.GuildID, o sqlc.OverflowCategory) error {
+	deletedID, err := parse.ChannelID(o.CategoryID)
+	if err != nil {
+		return err
+	}
+
+	err = q.DeleteOverflowCategory(ctx, o.CategoryID)
+	if err != nil {
+		return fmt.Errorf("error deleting overflow category: %w", err)
+	}
+
+	channelIDs, err := b.orphanedMatchChannels(ctx, q, guildID, o.Division, deletedID)
+	if err != nil {
+		return err
+	}
+	if len(channelIDs) == 0 {
+		return nil
+	}
+
+	channels, err := b.state.Channels(guildID)
+	if err != nil {
+		return fmt.Errorf("failed to list channels: %w", err)
+	}
+
+	category, err := b.createMatchCategory(guildID, matchCategoryName(o.Division, o.Number), discordutils.LastChannelPosition(channels))
+	if err != nil {
+		return fmt.Errorf("error creating overflow category: %w", err)
+	}
+
+	err = q.AddOverflowCategory(ctx, sqlc.AddOverflowCategoryParams{
+		CategoryID: category.ID.String(),
+		GuildID:    o.GuildID,
+		Division:   o.Division,
+		Number:     o.Number,
+	})
+	if err != nil {
+		return fmt.Errorf("error adding overflow category: %w", err)
+	}
+
+	return b.moveMatchChannels(channelIDs, category.ID)
+}
+
+// orphanedMatchChannels returns the match channels of a guild or division which were located in the deleted category.
+// Channels of other categories, e.g. overflow categories, are not affected by the deletion.
+func (b *Bot) orphanedMatchChannels(
+	ctx context.Context,
+	q *sqlc.Queries,
+	guildID discord.GuildID,
+	division string,
+	deletedCategoryID discord.ChannelID,
+) ([]discord.ChannelID, error) {
+	matches, err := q.ListGuildMatches(ctx, guildID.String())
+	if err != nil {
+		return nil, fmt.Errorf("error getting matches for guild %s: %w", guildID, err)
+	}
+
+	channelIDs := make([]discord.ChannelID, 0, len(matches))
+	for _, m := range matches {
+		if m.Division != division || m.ChannelID == "" {
+			// channels of divisions are located in the categories of their division
+			continue
+		}
+
+		id, err := parse.ChannelID(m.ChannelID)
+		if err != nil {
+			return nil, err
+		}
+
+		c, err := b.state.Channel(id)
+		if err != nil {
+			if discordutils.IsStatus4XX(err) {
+				// channel was deleted as well
+				continue
+			}
+			return nil, fmt.Errorf("error getting channel %s: %w", id, err)
+		}
+
+		if c.ParentID.IsValid() && c.ParentID != deletedCategoryID {
+			continue
+		}
+		channelIDs = append(channelIDs, id)
+	}
+	return channelIDs, nil
+}
+
+// moveMatchChannels moves the given channels into the category.
+func (b *Bot) moveMatchChannels(channelIDs []discord.ChannelID, categoryID discord.ChannelID) error {
+	for _, id := range channelIDs {
+		err := b.state.Client.ModifyChannel(id, api.ModifyChannelData{
+			CategoryID: categoryID,
+		})
+		if err != nil {
+			return fmt.Errorf("error modifying channel %s: %v", id, err)
+		}
+	}
+	return nil
+}
@@ -6,8 +6,8 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"time"
 
-	"github.com/diamondburned/arikawa/v3/api"
 	"github.com/diamondburned/arikawa/v3/discord"
 	"github.com/diamondburned/arikawa/v3/gateway"
 	"github.com/jxs13/league-discord-bot/internal/discordutils"
@@ -29,7 +29,7 @@ func (b *Bot) handleChannelDelete(e *gateway.ChannelDeleteEvent) {
 
 	err := b.TxQueries(b.ctx, func(ctx context.Context, q *sqlc.Queries) error {
 		if e.Type == discord.GuildText {
-			m, err := q.GetMatch(ctx, channelIDStr)
+			m, err := q.GetMatchByChannel(ctx, channelIDStr)
 			if err != nil {
 				if errors.Is(err, sql.ErrNoRows) {
 					// no match found, ignore
@@ -50,22 +50,41 @@ func (b *Bot) handleChannelDelete(e *gateway.ChannelDeleteEvent) {
 				}
 			}
 
-			// just delete match channel if it matches the channel id
-			err = q.DeleteMatch(ctx, channelIDStr)
+			if m.CancelledAt != 0 || !time.Now().Before(time.Unix(m.ChannelDeleteAt, 0)) {
+				// the match is over, it is kept without its channel
+				err = b.archiveOrphanedMatches(ctx, q, m.MatchID)
+				if err != nil {
+					return err
+				}
+			} else {
+				// the channel is replaced by the channel access routine, which also creates a new event
+				err = q.ResetMatchChannel(ctx, m.MatchID)
+				if err != nil {
+					return fmt.Errorf("error resetting channel of match %d: %w", m.Number, err)
+				}
+				log.Printf("channel %s of match %d in guild %s was deleted, it is going to be replaced", channelID, m.Number, guildID)
+			}
+
+			err = b.removeEmptyOverflowCategory(ctx, q, guildID, e.ParentID, channelID)
 			if err != nil {
-				return fmt.Errorf("error deleting match for channel %s: %w", channelID, err)
+				return err
+			}
+
+			err = b.refreshScheduleBoard(ctx, q, guildID)
+			if err != nil {
+				return err
 			}
 
 			return b.refreshJobSchedules(ctx, q)
 		}
 
-		// category channel -> guild config was modified
+		// category channel -> guild config, division or overflow category was modified
 
 		r, err := q.GetGuildConfigByCategory(ctx, channelIDStr)
 		if err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
-				// no config found, ignore
-				return nil
+				// no config found, the category might belong to a division or be an overflow category
+				return b.recreateDivisionCategory(ctx, q, guildID, channelIDStr)
 			}
 			return fmt.Errorf("error getting guild config for category %s: %w", channelIDStr, err)
 		}
@@ -81,21 +100,13 @@ func (b *Bot) handleChannelDelete(e *gateway.ChannelDeleteEvent) {
 		}
 		lastPos := discordutils.LastChannelPosition(channels)
 
-		matches, err := q.ListGuildMatches(ctx, guildIDStr)
+		// channels of overflow categories and divisions are not affected
+		channelIDs, err := b.orphanedMatchChannels(ctx, q, guildID, "", channelID)
 		if err != nil {
-			return fmt.Errorf("error getting matches for guild %s: %w", guildIDStr, err)
-		}
-
-		channelIDs := make([]discord.ChannelID, 0, len(matches))
-		for _, m := range matches {
-			id, err := parse.ChannelID(m.ChannelID)
-			if err != nil {
-				return err
-			}
-			channelIDs = append(channelIDs, id)
+			return err
 		}
 
-		category, err := b.createMatchCategory(e.GuildID, lastPos)
+		category, err := b.createMatchCategory(e.GuildID, defaultMatchCategoryName, lastPos)
 		if err != nil {
 			err = fmt.Errorf("error creating category for guild %s: %v", e.GuildID.String(), err)
 
@@ -114,14 +125,10 @@ func (b *Bot) handleChannelDelete(e *gateway.ChannelDeleteEvent) {
 			return fmt.Errorf("error updating category id for guild %s: %w", guildIDStr, err)
 		}
 
-		// recreated category. look for all channels and move them back into the new category
-		for _, id := range channelIDs {
-			err = b.state.Client.ModifyChannel(id, api.ModifyChannelData{
-				CategoryID: category.ID,
-			})
-			if err != nil {
-				return fmt.Errorf("error modifying channel %s: %v", id, err)
-			}
+		// recreated category. move the channels back into the new category
+		err = b.moveMatchChannels(channelIDs, category.ID)
+		if err != nil {
+			return err
 		}
 
 		return nil
@@ -0,0 +1,620 @@
+package bot
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/diamondburned/arikawa/v3/api"
+	"github.com/diamondburned/arikawa/v3/api/cmdroute"
+	"github.com/diamondburned/arikawa/v3/discord"
+	"github.com/diamondburned/arikawa/v3/utils/json/option"
+	"github.com/jxs13/league-discord-bot/config"
+	"github.com/jxs13/league-discord-bot/internal/discordutils"
+	"github.com/jxs13/league-discord-bot/internal/format"
+	"github.com/jxs13/league-discord-bot/internal/i18n"
+	"github.com/jxs13/league-discord-bot/internal/options"
+	"github.com/jxs13/league-discord-bot/internal/parse"
+	"github.com/jxs13/league-discord-bot/sqlc"
+)
+
+const (
+	MaxDivisionsPerGuild   = 10
+	MaxDivisionNameLength  = 32
+	divisionNameOptionName = "division_name"
+)
+
+// divisionConfig returns the guild configuration with the category and the offsets of the division applied.
+// Matches without a division use the configuration of the guild.
+func divisionConfig(ctx context.Context, q *sqlc.Queries, guildID, division string) (sqlc.GetGuildConfigRow, error) {
+	cfg, err := q.GetGuildConfig(ctx, guildID)
+	if err != nil {
+		return cfg, fmt.Errorf("error getting guild config: %w", err)
+	}
+	if division == "" {
+		return cfg, nil
+	}
+
+	d, err := q.GetDivision(ctx, sqlc.GetDivisionParams{
+		GuildID: guildID,
+		Name:    division,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return cfg, i18n.Errorf("error.division_not_found", division)
+		}
+		return cfg, fmt.Errorf("error getting division: %w", err)
+	}
+
+	cfg.CategoryID = d.CategoryID
+	cfg.ChannelAccessOffset = d.ChannelAccessOffset
+	cfg.RequirementsOffset = d.RequirementsOffset
+	cfg.ChannelDeleteOffset = d.ChannelDeleteOffset
+	cfg.NotificationOffsets = d.NotificationOffsets
+	return cfg, nil
+}
+
+// divisionOption returns the division which is given by the option with the passed name.
+// An empty string is returned in case the option is not set.
+func divisionOption(ctx context.Context, q *sqlc.Queries, guildID, name string, opts discord.CommandInteractionOptions) (string, error) {
+	o := opts.Find(name)
+	if o.Type == 0 {
+		return "", nil
+	}
+
+	division := strings.TrimSpace(o.String())
+	_, err := q.GetDivision(ctx, sqlc.GetDivisionParams{
+		GuildID: guildID,
+		Name:    division,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", i18n.Errorf("error.division_not_found", division)
+		}
+		return "", fmt.Errorf("error getting division: %w", err)
+	}
+	return division, nil
+}
+
+// matchDivision returns the division of a new match.
+// Without an explicitly given division, the division of the teams is used in case all of them are members of the same one.
+// An explicitly given division requires that none of the teams is a member of a different division.
+func matchDivision(ctx context.Context, q *sqlc.Queries, guildID, division string, teamRoleIDs []discord.RoleID) (string, error) {
+	teamDivisions := make([]string, 0, len(teamRoleIDs))
+	for _, rid := range teamRoleIDs {
+		d, err := q.GetTeamDivision(ctx, sqlc.GetTeamDivisionParams{
+			GuildID: guildID,
+			RoleID:  rid.String(),
+		})
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return "", fmt.Errorf("error getting division of team %s: %w", rid, err)
+		}
+
+		if division != "" && d != "" && d != division {
+			return "", i18n.Errorf("error.division_team_mismatch", rid.Mention(), d, division)
+		}
+		teamDivisions = append(teamDivisions, d)
+	}
+
+	if division != "" || len(teamDivisions) == 0 {
+		return division, nil
+	}
+
+	for _, d := range teamDivisions[1:] {
+		if d != teamDivisions[0] {
+			return "", nil
+		}
+	}
+	return teamDivisions[0], nil
+}
+
+func (b *Bot) commandDivisionSet(ctx context.Context, data cmdroute.CommandData) (resp *api.InteractionResponseData) {
+	var (
+		guildID    = data.Event.GuildID
+		guildIDStr = guildID.String()
+	)
+
+	var content string
+	err := b.TxQueries(ctx, func(ctx context.Context, q *sqlc.Queries) (err error) {
+		err = b.checkAccess(ctx, q, data.Event, ADMIN)
+		if err != nil {
+			return err
+		}
+
+		name := strings.TrimSpace(data.Options.Find(divisionNameOptionName).String())
+		if name == "" || utf8.RuneCountInString(name) > MaxDivisionNameLength {
+			return i18n.Errorf("error.division_name_length", MaxDivisionNameLength)
+		}
+
+		// new divisions start with the configuration of the guild
+		cfg, err := q.GetGuildConfig(ctx, guildIDStr)
+		if err != nil {
+			return fmt.Errorf("error getting guild config: %w", err)
+		}
+
+		division := sqlc.Division{
+			GuildID:             guildIDStr,
+			Name:                name,
+			ChannelAccessOffset: cfg.ChannelAccessOffset,
+			RequirementsOffset:  cfg.RequirementsOffset,
+			ChannelDeleteOffset: cfg.ChannelDeleteOffset,
+			NotificationOffsets: cfg.NotificationOffsets,
+		}
+
+		existing, err := q.GetDivision(ctx, sqlc.GetDivisionParams{
+			GuildID: guildIDStr,
+			Name:    name,
+		})
+		exists := err == nil
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("error getting division: %w", err)
+		}
+
+		if exists {
+			division = existing
+		} else {
+			cnt, err := q.CountGuildDivisions(ctx, guildIDStr)
+			if err != nil {
+				return fmt.Errorf("error counting divisions: %w", err)
+			}
+			if cnt >= MaxDivisionsPerGuild {
+				return i18n.Errorf("error.division_limit", MaxDivisionsPerGuild)
+			}
+		}
+
+		accessOffset, ok, err := options.DurationOption("channel_access_offset", 0, 720*time.Hour, data.Options)
+		if err != nil {
+			return err
+		} else if ok {
+			division.ChannelAccessOffset = int64(accessOffset / time.Second)
+		}
+
+		deleteOffset, ok, err := options.DurationOption("channel_delete_offset", 0, 8760*time.Hour, data.Options)
+		if err != nil {
+			return err
+		} else if ok {
+			division.ChannelDeleteOffset = int64(deleteOffset / time.Second)
+		}
+
+		requirementsOffset, ok, err := options.DurationOption("requirements_offset", 0, 720*time.Hour, data.Options)
+		if err != nil {
+			return err
+		} else if ok {
+			division.RequirementsOffset = int64(requirementsOffset / time.Second)
+		}
+
+		intervals, ok, err := options.ReminderIntervalsOption("notification_offsets", data.Options)
+		if err != nil {
+			return err
+		} else if ok {
+			division.NotificationOffsets = format.ReminderIntervals(intervals)
+		}
+
+		// reuse validation logic from config
+		err = config.ValidatableGuildConfig(
+			time.Duration(division.ChannelAccessOffset)*time.Second,
+			time.Duration(division.RequirementsOffset)*time.Second,
+			time.Duration(division.ChannelDeleteOffset)*time.Second,
+		)
+		if err != nil {
+			return err
+		}
+
+		if !exists {
+			var channels []discord.Channel
+			channels, err = b.state.Channels(guildID)
+			if err != nil {
+				return fmt.Errorf("failed to list channels: %w", err)
+			}
+
+			var category *discord.Channel
+			category, err = b.createMatchCategory(guildID, name, discordutils.LastChannelPosition(channels))
+			if err != nil {
+				return err
+			}
+			division.CategoryID = category.ID.String()
+			defer func() {
+				if err != nil {
+					// the transaction is rolled back, so the category must not be kept
+					if err := b.state.DeleteChannel(category.ID, api.AuditLogReason(err.Error())); err != nil {
+						log.Printf("error deleting category %s: %v", category.ID, err)
+					}
+				}
+			}()
+		}
+
+		err = q.AddDivision(ctx, sqlc.AddDivisionParams{
+			GuildID:             division.GuildID,
+			Name:                division.Name,
+			CategoryID:          division.CategoryID,
+			ChannelAccessOffset: division.ChannelAccessOffset,
+			RequirementsOffset:  division.RequirementsOffset,
+			ChannelDeleteOffset: division.ChannelDeleteOffset,
+			NotificationOffsets: division.NotificationOffsets,
+		})
+		if err != nil {
+			return fmt.Errorf("error adding division: %w", err)
+		}
+
+		key := "division.created"
+		if exists {
+			key = "division.updated"
+		}
+
+		text, err := formatDivision(division)
+		if err != nil {
+			return err
+		}
+		content = i18n.T(i18n.FromContext(ctx), key, format.MarkdownInlineCodeBlock(name), text)
+		return nil
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return &api.InteractionResponseData{
+		Content: option.NewNullableString(content),
+		Flags:   discord.EphemeralMessage,
+	}
+}
+
+func (b *Bot) commandDivisionDelete(ctx context.Context, data cmdroute.CommandData) (resp *api.InteractionResponseData) {
+	guildIDStr := data.Event.GuildID.String()
+
+	var (
+		content     string
+		categoryIDs []discord.ChannelID
+	)
+	err := b.TxQueries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		err := b.checkAccess(ctx, q, data.Event, ADMIN)
+		if err != nil {
+			return err
+		}
+
+		name := data.Options.Find(divisionNameOptionName).String()
+		division, err := q.GetDivision(ctx, sqlc.GetDivisionParams{
+			GuildID: guildIDStr,
+			Name:    name,
+		})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return i18n.Errorf("error.division_not_found", name)
+			}
+			return fmt.Errorf("error getting division: %w", err)
+		}
+
+		n, err := q.CountDivisionMatches(ctx, sqlc.CountDivisionMatchesParams{
+			GuildID:  guildIDStr,
+			Division: name,
+		})
+		if err != nil {
+			return fmt.Errorf("error counting division matches: %w", err)
+		}
+		if n > 0 {
+			return i18n.Errorf("error.division_has_matches", name, n)
+		}
+
+		// the team memberships are deleted as well
+		err = q.DeleteDivision(ctx, sqlc.DeleteDivisionParams{
+			GuildID: guildIDStr,
+			Name:    name,
+		})
+		if err != nil {
+			return fmt.Errorf("error deleting division: %w", err)
+		}
+
+		overflows, err := q.ListOverflowCategories(ctx, sqlc.ListOverflowCategoriesParams{
+			GuildID:  guildIDStr,
+			Division: name,
+		})
+		if err != nil {
+			return fmt.Errorf("error listing overflow categories: %w", err)
+		}
+
+		err = q.DeleteDivisionOverflowCategories(ctx, sqlc.DeleteDivisionOverflowCategoriesParams{
+			GuildID:  guildIDStr,
+			Division: name,
+		})
+		if err != nil {
+			return fmt.Errorf("error deleting overflow categories: %w", err)
+		}
+
+		categoryID, err := parse.ChannelID(division.CategoryID)
+		if err != nil {
+			return err
+		}
+		categoryIDs = append(categoryIDs, categoryID)
+
+		for _, o := range overflows {
+			categoryID, err := parse.ChannelID(o.CategoryID)
+			if err != nil {
+				return err
+			}
+			categoryIDs = append(categoryIDs, categoryID)
+		}
+
+		content = i18n.T(i18n.FromContext(ctx), "division.deleted", format.MarkdownInlineCodeBlock(name))
+		return nil
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	// the categories are deleted after the commit, otherwise the channel delete event
+	// might still find the division and recreate its category
+	for _, categoryID := range categoryIDs {
+		err = b.state.DeleteChannel(categoryID, api.AuditLogReason("division was deleted"))
+		if err != nil && !discordutils.IsStatus4XX(err) {
+			log.Printf("error deleting category %s of deleted division: %v", categoryID, err)
+		}
+	}
+
+	return &api.InteractionResponseData{
+		Content: option.NewNullableString(content),
+		Flags:   discord.EphemeralMessage,
+	}
+}
+
+func (b *Bot) commandDivisionList(ctx context.Context, data cmdroute.CommandData) (resp *api.InteractionResponseData) {
+	guildIDStr := data.Event.GuildID.String()
+
+	var content string
+	err := b.TxQueries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		err := b.checkAccess(ctx, q, data.Event, READ)
+		if err != nil {
+			return err
+		}
+
+		divisions, err := q.ListGuildDivisions(ctx, guildIDStr)
+		if err != nil {
+			return fmt.Errorf("error listing divisions: %w", err)
+		}
+
+		if len(divisions) == 0 {
+			content = i18n.T(i18n.FromContext(ctx), "division.none")
+			return nil
+		}
+
+		var sb strings.Builder
+		sb.WriteString(i18n.T(i18n.FromContext(ctx), "division.list"))
+		for _, d := range divisions {
+			text, err := formatDivision(d)
+			if err != nil {
+				return err
+			}
+
+			roleIDs, err := q.ListDivisionTeamRoleIDs(ctx, sqlc.ListDivisionTeamRoleIDsParams{
+				GuildID:  guildIDStr,
+				Division: d.Name,
+			})
+			if err != nil {
+				return fmt.Errorf("error listing division teams: %w", err)
+			}
+
+			sb.WriteString("\n")
+			sb.WriteString(text)
+			sb.WriteString("teams: ")
+			if len(roleIDs) == 0 {
+				sb.WriteString("-")
+			}
+			for idx, rid := range roleIDs {
+				if idx > 0 {
+					sb.WriteString(", ")
+				}
+				sb.WriteString("<@&")
+				sb.WriteString(rid)
+				sb.WriteString(">")
+			}
+			sb.WriteString("\n")
+		}
+
+		content = sb.String()
+		if len(content) > 2000 {
+			content = content[:2000-3] + "..."
+		}
+		return nil
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return &api.InteractionResponseData{
+		Content:         option.NewNullableString(content),
+		Flags:           discord.EphemeralMessage,
+		AllowedMentions: &api.AllowedMentions{ /* none */ },
+	}
+}
+
+func formatDivision(d sqlc.Division) (string, error) {
+	categoryID, err := parse.ChannelID(d.CategoryID)
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		accessOffset       = time.Duration(d.ChannelAccessOffset) * time.Second
+		requirementsOffset = time.Duration(d.RequirementsOffset) * time.Second
+		deleteOffset       = time.Duration(d.ChannelDeleteOffset) * time.Second
+	)
+
+	var sb strings.Builder
+	sb.WriteString("division_name: ")
+	sb.WriteString(format.MarkdownInlineCodeBlock(d.Name))
+	sb.WriteString("\n")
+	sb.WriteString("category: ")
+	sb.WriteString(categoryID.Mention())
+	sb.WriteString("\n")
+	sb.WriteString("channel_access_offset: ")
+	sb.WriteString(format.MarkdownInlineCodeBlock(accessOffset.String()))
+	sb.WriteString("\n")
+	sb.WriteString("notification_offsets: ")
+	sb.WriteString(format.MarkdownInlineCodeBlock(d.NotificationOffsets))
+	sb.WriteString("\n")
+	sb.WriteString("requirements_offset: ")
+	sb.WriteString(format.MarkdownInlineCodeBlock(requirementsOffset.String()))
+	sb.WriteString("\n")
+	sb.WriteString("channel_delete_offset: ")
+	sb.WriteString(format.MarkdownInlineCodeBlock(deleteOffset.String()))
+	sb.WriteString("\n")
+	return sb.String(), nil
+}
+
+func (b *Bot) commandDivisionTeamAdd(ctx context.Context, data cmdroute.CommandData) (resp *api.InteractionResponseData) {
+	var (
+		guildID    = data.Event.GuildID
+		guildIDStr = guildID.String()
+	)
+
+	var content string
+	err := b.TxQueries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		err := b.checkAccess(ctx, q, data.Event, WRITE)
+		if err != nil {
+			return err
+		}
+
+		division, err := divisionOption(ctx, q, guildIDStr, divisionNameOptionName, data.Options)
+		if err != nil {
+			return err
+		}
+
+		rid, err := b.resolveTeamRoleID(ctx, q, guildID, data.Options.Find("team").String())
+		if err != nil {
+			return err
+		}
+
+		err = b.checkRoleIDs(guildID, rid)
+		if err != nil {
+			return err
+		}
+
+		// a team can only be a member of a single division
+		err = q.AddDivisionTeam(ctx, sqlc.AddDivisionTeamParams{
+			GuildID:  guildIDStr,
+			RoleID:   rid.String(),
+			Division: division,
+		})
+		if err != nil {
+			return fmt.Errorf("error adding division team: %w", err)
+		}
+
+		content = i18n.T(i18n.FromContext(ctx), "division.team_added", rid.Mention(), format.MarkdownInlineCodeBlock(division))
+		return nil
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return &api.InteractionResponseData{
+		Content:         option.NewNullableString(content),
+		Flags:           discord.EphemeralMessage,
+		AllowedMentions: &api.AllowedMentions{ /* none */ },
+	}
+}
+
+func (b *Bot) commandDivisionTeamRemove(ctx context.Context, data cmdroute.CommandData) (resp *api.InteractionResponseData) {
+	var (
+		guildID    = data.Event.GuildID
+		guildIDStr = guildID.String()
+	)
+
+	var content string
+	err := b.TxQueries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		err := b.checkAccess(ctx, q, data.Event, WRITE)
+		if err != nil {
+			return err
+		}
+
+		rid, err := b.resolveTeamRoleID(ctx, q, guildID, data.Options.Find("team").String())
+		if err != nil {
+			return err
+		}
+
+		division, err := q.GetTeamDivision(ctx, sqlc.GetTeamDivisionParams{
+			GuildID: guildIDStr,
+			RoleID:  rid.String(),
+		})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return i18n.Errorf("error.division_team_not_found", rid.Mention())
+			}
+			return fmt.Errorf("error getting division of team: %w", err)
+		}
+
+		err = q.DeleteDivisionTeam(ctx, sqlc.DeleteDivisionTeamParams{
+			GuildID: guildIDStr,
+			RoleID:  rid.String(),
+		})
+		if err != nil {
+			return fmt.Errorf("error deleting division team: %w", err)
+		}
+
+		content = i18n.T(i18n.FromContext(ctx), "division.team_removed", rid.Mention(), format.MarkdownInlineCodeBlock(division))
+		return nil
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return &api.InteractionResponseData{
+		Content:         option.NewNullableString(content),
+		Flags:           discord.EphemeralMessage,
+		AllowedMentions: &api.AllowedMentions{ /* none */ },
+	}
+}
+
+// recreateDivisionCategory recreates the deleted category of a division and moves the channels of the division's matches back into it.
+// Deleted overflow categories are recreated as well, other categories are ignored.
+func (b *Bot) recreateDivisionCategory(ctx context.Context, q *sqlc.Queries, guildID discord.GuildID, categoryIDStr string) error {
+	d, err := q.GetDivisionByCategory(ctx, categoryIDStr)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("error getting division for category %s: %w", categoryIDStr, err)
+		}
+
+		o, err := q.GetOverflowCategory(ctx, categoryIDStr)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil
+			}
+			return fmt.Errorf("error getting overflow category %s: %w", categoryIDStr, err)
+		}
+		return b.recreateOverflowCategory(ctx, q, guildID, o)
+	}
+
+	categoryID, err := parse.ChannelID(categoryIDStr)
+	if err != nil {
+		return err
+	}
+
+	// channels of overflow categories are not affected
+	channelIDs, err := b.orphanedMatchChannels(ctx, q, guildID, d.Name, categoryID)
+	if err != nil {
+		return err
+	}
+
+	channels, err := b.state.Channels(guildID)
+	if err != nil {
+		return fmt.Errorf("error getting channels of guild %s: %w", guildID, err)
+	}
+
+	category, err := b.createMatchCategory(guildID, d.Name, discordutils.LastChannelPosition(channels))
+	if err != nil {
+		return fmt.Errorf("error creating category for division %s in guild %s: %w", d.Name, guildID, err)
+	}
+
+	err = q.UpdateDivisionCategoryId(ctx, sqlc.UpdateDivisionCategoryIdParams{
+		CategoryID: category.ID.String(),
+		GuildID:    d.GuildID,
+		Name:       d.Name,
+	})
+	if err != nil {
+		return fmt.Errorf("error updating category id of division %s: %w", d.Name, err)
+	}
+
+	return b.moveMatchChannels(channelIDs, category.ID)
+}
@@ -0,0 +1,338 @@
+package bot
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/diamondburned/arikawa/v3/discord"
+	"github.com/jxs13/league-discord-bot/internal/format"
+	"github.com/jxs13/league-discord-bot/internal/i18n"
+	"github.com/jxs13/league-discord-bot/internal/model"
+	"github.com/jxs13/league-discord-bot/internal/parse"
+	"github.com/jxs13/league-discord-bot/sqlc"
+)
+
+// outputMode defines whether match, reminder and announcement messages are sent as plain text or as embeds.
+type outputMode string
+
+const (
+	outputModeText  outputMode = "text"
+	outputModeEmbed outputMode = "embed"
+)
+
+var outputModes = []outputMode{outputModeText, outputModeEmbed}
+
+const (
+	embedColor discord.Color = 0x5865F2
+
+	// https://discord.com/developers/docs/resources/message#embed-object-embed-limits
+	embedTitleLimit       = 256
+	embedDescriptionLimit = 4096
+	embedFieldNameLimit   = 256
+	embedFieldValueLimit  = 1024
+	embedFieldsLimit      = 25
+	embedsPerMessageLimit = 10
+	embedsTotalLimit      = 6000
+
+	progressBarWidth = 10
+)
+
+func parseOutputMode(s string) (outputMode, error) {
+	mode := outputMode(s)
+	if !slices.Contains(outputModes, mode) {
+		return "", i18n.Errorf("error.output_mode_invalid", s)
+	}
+	return mode, nil
+}
+
+func outputModeChoices() []discord.StringChoice {
+	choices := make([]discord.StringChoice, 0, len(outputModes))
+	for _, mode := range outputModes {
+		choices = append(choices, discord.StringChoice{Name: string(mode), Value: string(mode)})
+	}
+	return choices
+}
+
+// guildOutputMode returns the configured output mode of the guild or the plain text mode for unknown guilds.
+func guildOutputMode(ctx context.Context, q *sqlc.Queries, guildID string) (outputMode, error) {
+	s, err := q.GetGuildOutputMode(ctx, guildID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return outputModeText, nil
+		}
+		return outputModeText, fmt.Errorf("error getting guild output mode: %w", err)
+	}
+
+	mode, err := parseOutputMode(s)
+	if err != nil {
+		return outputModeText, nil
+	}
+	return mode, nil
+}
+
+// matchCard contains everything that is shown on the embed of a match.
+// Teams, team names, lineups and substitutes are expected to be in the same order.
+type matchCard struct {
+	ChannelID           discord.ChannelID
+	Teams               []string
+	TeamNames           []string
+	Moderators          []discord.UserID
+	Streamers           []model.Streamer
+	ScheduledAt         time.Time
+	AccessibleAt        time.Time
+	DeleteAt            time.Time
+	ParticipantsPerTeam int64
+	EntryOpen           bool
+	Lineups             [][]discord.UserID
+	Substitutes         [][]discord.UserID
+}
+
+// loadMatchCard collects the current state of the match.
+func (b *Bot) loadMatchCard(ctx context.Context, q *sqlc.Queries, matchID int64) (card matchCard, err error) {
+	match, err := q.GetMatch(ctx, matchID)
+	if err != nil {
+		return card, fmt.Errorf("error getting match %d: %w", matchID, err)
+	}
+
+	guildID, err := parse.GuildID(match.GuildID)
+	if err != nil {
+		return card, err
+	}
+
+	teamRoleIDs, err := b.listMatchTeamRoleIDs(ctx, q, matchID)
+	if err != nil {
+		return card, err
+	}
+
+	card = matchCard{
+		ScheduledAt:  time.Unix(match.ScheduledAt, 0),
+		AccessibleAt: time.Unix(match.ChannelAccessibleAt, 0),
+		DeleteAt:     time.Unix(match.ChannelDeleteAt, 0),
+	}
+
+	// the channel of a match is created when its access window opens
+	if match.ChannelID != "" {
+		card.ChannelID, err = parse.ChannelID(match.ChannelID)
+		if err != nil {
+			return card, err
+		}
+	}
+
+	card.Teams, err = teamMentions(ctx, q, guildID, teamRoleIDs)
+	if err != nil {
+		return card, err
+	}
+
+	card.TeamNames, err = b.orderedTeamNames(ctx, q, guildID, teamRoleIDs)
+	if err != nil {
+		return card, err
+	}
+
+	card.Moderators, err = b.listMatchModeratorUserIDs(ctx, q, matchID)
+	if err != nil {
+		return card, err
+	}
+
+	card.Streamers, err = b.listMatchStreamerUserIDs(ctx, q, matchID)
+	if err != nil {
+		return card, err
+	}
+
+	req, err := q.GetParticipationRequirements(ctx, matchID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return card, fmt.Errorf("error getting participation requirements: %w", err)
+	} else if err == nil {
+		card.ParticipantsPerTeam = req.ParticipantsPerTeam
+		card.EntryOpen = !int64ToBool(req.EntryClosed)
+	}
+
+	starterMap, substituteMap, err := listLineups(ctx, q, matchID)
+	if err != nil {
+		return card, err
+	}
+
+	card.Lineups = make([][]discord.UserID, 0, len(teamRoleIDs))
+	card.Substitutes = make([][]discord.UserID, 0, len(teamRoleIDs))
+	for _, rid := range teamRoleIDs {
+		card.Lineups = append(card.Lineups, starterMap[rid])
+		card.Substitutes = append(card.Substitutes, substituteMap[rid])
+	}
+	return card, nil
+}
+
+// orderedTeamNames returns the display names of the teams in the order of the given roles.
+func (b *Bot) orderedTeamNames(ctx context.Context, q *sqlc.Queries, guildID discord.GuildID, roleIDs []discord.RoleID) ([]string, error) {
+	nameMap, err := b.teamNames(ctx, q, guildID, roleIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(roleIDs))
+	for _, rid := range roleIDs {
+		names = append(names, nameMap[rid])
+	}
+	return names, nil
+}
+
+// matchEmbed renders the match as a structured card.
+func matchEmbed(lang i18n.Language, card matchCard) discord.Embed {
+	title := strings.Join(card.TeamNames, i18n.T(lang, "embed.teams_separator"))
+	if card.ParticipantsPerTeam > 0 {
+		title += fmt.Sprintf(" (%don%d)", card.ParticipantsPerTeam, card.ParticipantsPerTeam)
+	}
+
+	description := strings.Join(card.Teams, i18n.T(lang, "embed.teams_separator"))
+	if card.EntryOpen {
+		description += "\n\n" + strings.TrimSpace(i18n.T(lang, "match.confirmation"))
+	}
+
+	fields := make([]discord.EmbedField, 0, 5+len(card.Teams))
+	fields = append(fields, discord.EmbedField{
+		Name:   i18n.T(lang, "embed.start"),
+		Value:  format.DiscordLongDateTime(card.ScheduledAt) + "\n" + format.DiscordRelativeTime(card.ScheduledAt),
+		Inline: true,
+	})
+
+	// matches do not have a channel before their access window opens
+	if card.ChannelID.IsValid() {
+		fields = append(fields, discord.EmbedField{
+			Name:   i18n.T(lang, "embed.channel"),
+			Value:  card.ChannelID.Mention(),
+			Inline: true,
+		})
+	}
+
+	if !card.AccessibleAt.IsZero() && !card.DeleteAt.IsZero() {
+		fields = append(fields, discord.EmbedField{
+			Name:   i18n.T(lang, "embed.access"),
+			Value:  format.DiscordLongDateTime(card.AccessibleAt) + " - " + format.DiscordLongDateTime(card.DeleteAt),
+			Inline: false,
+		})
+	}
+
+	if len(card.Moderators) > 0 {
+		key := "embed.moderator"
+		if len(card.Moderators) > 1 {
+			key = "embed.moderators"
+		}
+		mods := make([]string, 0, len(card.Moderators))
+		for _, uid := range card.Moderators {
+			mods = append(mods, uid.Mention())
+		}
+		fields = append(fields, discord.EmbedField{
+			Name:   i18n.T(lang, key),
+			Value:  format.Truncate(strings.Join(mods, ", "), embedFieldValueLimit),
+			Inline: true,
+		})
+	}
+
+	if len(card.Streamers) > 0 {
+		key := "embed.streamer"
+		if len(card.Streamers) > 1 {
+			key = "embed.streamers"
+		}
+		streams := make([]string, 0, len(card.Streamers))
+		for _, s := range card.Streamers {
+			if s.Info.Url == "" {
+				streams = append(streams, s.UserID.Mention())
+				continue
+			}
+			streams = append(streams, i18n.T(lang, "embed.stream", s.UserID.Mention(), s.Info.Url))
+		}
+		fields = append(fields, discord.EmbedField{
+			Name:   i18n.T(lang, key),
+			Value:  format.Truncate(strings.Join(streams, "\n"), embedFieldValueLimit),
+			Inline: true,
+		})
+	}
+
+	if card.ParticipantsPerTeam > 0 {
+		for idx, name := range card.TeamNames {
+			var lineup, substitutes []discord.UserID
+			if idx < len(card.Lineups) {
+				lineup = card.Lineups[idx]
+			}
+			if idx < len(card.Substitutes) {
+				substitutes = card.Substitutes[idx]
+			}
+
+			var sb strings.Builder
+			sb.WriteString(format.ProgressBar(int64(len(lineup)), card.ParticipantsPerTeam, progressBarWidth))
+			sb.WriteString(fmt.Sprintf(" %d/%d\n", len(lineup), card.ParticipantsPerTeam))
+			sb.WriteString(joinUserMentions(lineup))
+			if len(substitutes) > 0 {
+				sb.WriteString("\n")
+				sb.WriteString(i18n.T(lang, "embed.substitutes", joinUserMentions(substitutes)))
+			}
+
+			fields = append(fields, discord.EmbedField{
+				Name:   format.Truncate(i18n.T(lang, "embed.lineup", name), embedFieldNameLimit),
+				Value:  format.Truncate(sb.String(), embedFieldValueLimit),
+				Inline: true,
+			})
+		}
+	}
+
+	if len(fields) > embedFieldsLimit {
+		fields = fields[:embedFieldsLimit]
+	}
+
+	return discord.Embed{
+		Title:       format.Truncate(title, embedTitleLimit),
+		Description: format.Truncate(description, embedDescriptionLimit),
+		Color:       embedColor,
+		Fields:      fields,
+		Timestamp:   discord.NewTimestamp(card.ScheduledAt),
+	}
+}
+
+func joinUserMentions(userIDs []discord.UserID) string {
+	if len(userIDs) == 0 {
+		return "-"
+	}
+
+	mentions := make([]string, 0, len(userIDs))
+	for _, uid := range userIDs {
+		mentions = append(mentions, uid.Mention())
+	}
+	return strings.Join(mentions, ", ")
+}
+
+// embedLength returns the number of characters of the embed which count towards the limit of all embeds of a message.
+func embedLength(e discord.Embed) int {
+	n := len([]rune(e.Title)) + len([]rune(e.Description))
+	for _, f := range e.Fields {
+		n += len([]rune(f.Name)) + len([]rune(f.Value))
+	}
+	if e.Footer != nil {
+		n += len([]rune(e.Footer.Text))
+	}
+	return n
+}
+
+// splitEmbeds distributes the embeds over as few messages as possible without exceeding Discord's limits.
+func splitEmbeds(embeds []discord.Embed) [][]discord.Embed {
+	result := make([][]discord.Embed, 0, 1)
+	var (
+		current []discord.Embed
+		length  int
+	)
+	for _, e := range embeds {
+		n := embedLength(e)
+		if len(current) > 0 && (len(current) == embedsPerMessageLimit || length+n > embedsTotalLimit) {
+			result = append(result, current)
+			current, length = nil, 0
+		}
+		current = append(current, e)
+		length += n
+	}
+	if len(current) > 0 {
+		result = append(result, current)
+	}
+	return result
+}
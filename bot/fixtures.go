@@ -0,0 +1,127 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/diamondburned/arikawa/v3/discord"
+	"github.com/jxs13/league-discord-bot/internal/parse"
+	"github.com/jxs13/league-discord-bot/sqlc"
+)
+
+// createFixtureMatches adds a match without a channel for every fixture of the confirmed season which has none yet.
+// The channels are created by asyncGrantChannelAccess once their access window opens, which keeps the number of
+// concurrent match channels low.
+// The caller is expected to refresh the job schedules afterwards.
+func (b *Bot) createFixtureMatches(ctx context.Context, q *sqlc.Queries, seasonID int64, now time.Time) error {
+	fixtures, err := q.ListSeasonFixturesWithoutMatch(ctx, seasonID)
+	if err != nil {
+		return fmt.Errorf("error listing fixtures without match: %w", err)
+	}
+	if len(fixtures) == 0 {
+		return nil
+	}
+
+	guildID, err := parse.GuildID(fixtures[0].GuildID)
+	if err != nil {
+		return err
+	}
+
+	createdBy, err := parse.UserID(fixtures[0].CreatedBy)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fixtures {
+		teams, err := q.ListFixtureTeams(ctx, f.FixtureID)
+		if err != nil {
+			return fmt.Errorf("error listing fixture teams: %w", err)
+		}
+
+		teamRoleIDs := make([]discord.RoleID, 0, len(teams))
+		for _, t := range teams {
+			rid, err := parse.RoleID(t.RoleID)
+			if err != nil {
+				return err
+			}
+			teamRoleIDs = append(teamRoleIDs, rid)
+		}
+
+		moderatorID, err := parse.UserID(f.ModeratorID)
+		if err != nil {
+			return err
+		}
+
+		// fixtures of teams of the same division are played in the category of their division
+		division, err := matchDivision(ctx, q, f.GuildID, "", teamRoleIDs)
+		if err != nil {
+			return err
+		}
+
+		match, err := b.addMatch(ctx, q, newMatch{
+			GuildID:             guildID,
+			ScheduledAt:         time.Unix(f.ScheduledAt, 0),
+			TeamRoleIDs:         teamRoleIDs,
+			ModeratorIDs:        []discord.UserID{moderatorID},
+			ParticipantsPerTeam: f.ParticipantsPerTeam,
+			CreatedBy:           createdBy,
+			Division:            division,
+		}, now)
+		if err != nil {
+			return fmt.Errorf("error adding match of fixture %d: %w", f.FixtureID, err)
+		}
+
+		err = q.UpdateFixtureMatch(ctx, sqlc.UpdateFixtureMatchParams{
+			MatchID:   match.MatchID,
+			FixtureID: f.FixtureID,
+		})
+		if err != nil {
+			return fmt.Errorf("error updating fixture match: %w", err)
+		}
+	}
+
+	log.Printf("added %d matches for the fixtures of season %d in guild %s", len(fixtures), seasonID, guildID)
+	return b.refreshScheduleBoard(ctx, q, guildID)
+}
+
+// createPendingFixtureMatches adds the matches of confirmed seasons whose fixtures have no match yet,
+// e.g. because they were confirmed before matches could exist without a channel.
+func (b *Bot) createPendingFixtureMatches(ctx context.Context, q *sqlc.Queries) error {
+	seasonIDs, err := q.ListConfirmedSeasonsWithoutMatches(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing confirmed seasons without matches: %w", err)
+	}
+
+	now := time.Now()
+	for _, seasonID := range seasonIDs {
+		err = b.createFixtureMatches(ctx, q, seasonID, now)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteSeasonMatchesWithoutChannel deletes the matches of the season whose channel was not created yet,
+// so that deleting a tournament only keeps the matches that already started.
+func (b *Bot) deleteSeasonMatchesWithoutChannel(ctx context.Context, q *sqlc.Queries, guildID discord.GuildID, seasonID int64) error {
+	matchIDs, err := q.ListSeasonMatchIDsWithoutChannel(ctx, seasonID)
+	if err != nil {
+		return fmt.Errorf("error listing season matches without channel: %w", err)
+	}
+	if len(matchIDs) == 0 {
+		return nil
+	}
+
+	for _, matchID := range matchIDs {
+		err = q.DeleteMatch(ctx, matchID)
+		if err != nil {
+			return fmt.Errorf("error deleting match %d: %w", matchID, err)
+		}
+	}
+
+	log.Printf("deleted %d matches without channel of season %d in guild %s", len(matchIDs), seasonID, guildID)
+	return b.refreshScheduleBoard(ctx, q, guildID)
+}
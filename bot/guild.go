@@ -18,6 +18,7 @@ import (
 	"github.com/jxs13/league-discord-bot/config"
 	"github.com/jxs13/league-discord-bot/internal/discordutils"
 	"github.com/jxs13/league-discord-bot/internal/format"
+	"github.com/jxs13/league-discord-bot/internal/i18n"
 	"github.com/jxs13/league-discord-bot/internal/options"
 	"github.com/jxs13/league-discord-bot/sqlc"
 )
@@ -46,25 +47,63 @@ func (b *Bot) commandGuildConfiguration(ctx context.Context, data cmdroute.Comma
 			deleteOffset        = time.Duration(cfg.ChannelDeleteOffset) * time.Second
 		)
 
-		sb.WriteString("Guild configuration:\n")
+		lang := i18n.FromContext(ctx)
+		sb.WriteString(i18n.T(lang, "guild.config"))
 		sb.WriteString("enabled: ")
 		sb.WriteString(format.MarkdownInlineCodeBlock(strconv.FormatBool(int64ToBool(cfg.Enabled))))
-		sb.WriteString(" whether the bot is enabled in this server or not\n\n")
+		sb.WriteString(" ")
+		sb.WriteString(i18n.T(lang, "guild.config_enabled"))
+		sb.WriteString("\n\n")
 		sb.WriteString("channel_access_offset: ")
 		sb.WriteString(format.MarkdownInlineCodeBlock(accessOffset.String()))
-		sb.WriteString(" point in time before the match at which participants gain access to the channel\n\n")
+		sb.WriteString(" ")
+		sb.WriteString(i18n.T(lang, "guild.config_channel_access_offset"))
+		sb.WriteString("\n\n")
 		sb.WriteString("event_creation_enabled: ")
 		sb.WriteString(format.MarkdownInlineCodeBlock(strconv.FormatBool(int64ToBool(cfg.EventCreationEnabled))))
-		sb.WriteString(" whether to create events in case there is a streamer with a stream_url available\n\n")
+		sb.WriteString(" ")
+		sb.WriteString(i18n.T(lang, "guild.config_event_creation_enabled"))
+		sb.WriteString("\n\n")
 		sb.WriteString("notification_offsets: ")
 		sb.WriteString(format.MarkdownInlineCodeBlock(notificationOffsets))
-		sb.WriteString(" list of points in time before the match, at which automatic notifications are created for the participants\n\n")
+		sb.WriteString(" ")
+		sb.WriteString(i18n.T(lang, "guild.config_notification_offsets"))
+		sb.WriteString("\n\n")
 		sb.WriteString("requirements_offset: ")
 		sb.WriteString(format.MarkdownInlineCodeBlock(requirementsOffset.String()))
-		sb.WriteString(" point in time before the match at which the participation requirements need to be met.\n\n")
+		sb.WriteString(" ")
+		sb.WriteString(i18n.T(lang, "guild.config_requirements_offset"))
+		sb.WriteString("\n\n")
 		sb.WriteString("channel_delete_offset: ")
 		sb.WriteString(format.MarkdownInlineCodeBlock(deleteOffset.String()))
-		sb.WriteString(" point in time after the match, at which the match channel is deleted and the Discord event ends.\n\n")
+		sb.WriteString(" ")
+		sb.WriteString(i18n.T(lang, "guild.config_channel_delete_offset"))
+		sb.WriteString("\n\n")
+		sb.WriteString("points_win: ")
+		sb.WriteString(format.MarkdownInlineCodeBlock(strconv.FormatInt(cfg.PointsWin, 10)))
+		sb.WriteString(" ")
+		sb.WriteString(i18n.T(lang, "guild.config_points_win"))
+		sb.WriteString("\n\n")
+		sb.WriteString("points_draw: ")
+		sb.WriteString(format.MarkdownInlineCodeBlock(strconv.FormatInt(cfg.PointsDraw, 10)))
+		sb.WriteString(" ")
+		sb.WriteString(i18n.T(lang, "guild.config_points_draw"))
+		sb.WriteString("\n\n")
+		sb.WriteString("points_loss: ")
+		sb.WriteString(format.MarkdownInlineCodeBlock(strconv.FormatInt(cfg.PointsLoss, 10)))
+		sb.WriteString(" ")
+		sb.WriteString(i18n.T(lang, "guild.config_points_loss"))
+		sb.WriteString("\n\n")
+		sb.WriteString("language: ")
+		sb.WriteString(format.MarkdownInlineCodeBlock(cfg.Language))
+		sb.WriteString(" ")
+		sb.WriteString(i18n.T(lang, "guild.config_language"))
+		sb.WriteString("\n\n")
+		sb.WriteString("output_mode: ")
+		sb.WriteString(format.MarkdownInlineCodeBlock(cfg.OutputMode))
+		sb.WriteString(" ")
+		sb.WriteString(i18n.T(lang, "guild.config_output_mode"))
+		sb.WriteString("\n\n")
 
 		text = sb.String()
 		if len(text) > 2000 {
@@ -73,7 +112,7 @@ func (b *Bot) commandGuildConfiguration(ctx context.Context, data cmdroute.Comma
 		return nil
 	})
 	if err != nil {
-		return errorResponse(err)
+		return errorResponse(ctx, err)
 	}
 
 	return &api.InteractionResponseData{
@@ -158,8 +197,46 @@ func (b *Bot) commandGuildConfigure(ctx context.Context, data cmdroute.CommandDa
 			cfg.EventCreationEnabled = eventCreationEnabled
 		}
 
+		pointsChanged := false
+		for _, p := range []struct {
+			name  string
+			value *int64
+		}{
+			{"points_win", &cfg.PointsWin},
+			{"points_draw", &cfg.PointsDraw},
+			{"points_loss", &cfg.PointsLoss},
+		} {
+			points, ok, err := options.OptionalInteger(p.name, data.Options)
+			if err != nil {
+				return err
+			}
+			if ok {
+				*p.value = points
+				pointsChanged = true
+			}
+		}
+		atLeastOneOption = pointsChanged || atLeastOneOption
+
+		if o := data.Options.Find("language"); o.Type != 0 {
+			lang, err := i18n.ParseLanguage(o.String())
+			if err != nil {
+				return err
+			}
+			cfg.Language = string(lang)
+			atLeastOneOption = true
+		}
+
+		if o := data.Options.Find("output_mode"); o.Type != 0 {
+			mode, err := parseOutputMode(o.String())
+			if err != nil {
+				return err
+			}
+			cfg.OutputMode = string(mode)
+			atLeastOneOption = true
+		}
+
 		if !atLeastOneOption {
-			return errors.New("no options were provided, please provide at least one option to update")
+			return i18n.Errorf("error.no_options")
 		}
 
 		// reuse validation logic from config
@@ -180,6 +257,11 @@ func (b *Bot) commandGuildConfigure(ctx context.Context, data cmdroute.CommandDa
 			NotificationOffsets:  cfg.NotificationOffsets,
 			RequirementsOffset:   cfg.RequirementsOffset,
 			ChannelDeleteOffset:  cfg.ChannelDeleteOffset,
+			PointsWin:            cfg.PointsWin,
+			PointsDraw:           cfg.PointsDraw,
+			PointsLoss:           cfg.PointsLoss,
+			Language:             cfg.Language,
+			OutputMode:           cfg.OutputMode,
 		})
 		if err != nil {
 			err = fmt.Errorf("error adding guild config: %w", err)
@@ -187,15 +269,22 @@ func (b *Bot) commandGuildConfigure(ctx context.Context, data cmdroute.CommandDa
 			return fmt.Errorf("%w, please contact the owner of the bot", err)
 		}
 
+		if pointsChanged {
+			err = b.refreshStandingsMessage(ctx, q, data.Event.GuildID)
+			if err != nil {
+				return err
+			}
+		}
+
 		resp = &api.InteractionResponseData{
-			Content:         option.NewNullableString("Guild configuration was updated. New match schedules will be created accordingly."),
+			Content:         option.NewNullableString(i18n.T(i18n.FromContext(ctx), "guild.updated")),
 			Flags:           discord.EphemeralMessage,
 			AllowedMentions: &api.AllowedMentions{ /* none */ },
 		}
 		return nil
 	})
 	if err != nil {
-		return errorResponse(err)
+		return errorResponse(ctx, err)
 	}
 
 	return resp
@@ -217,7 +306,7 @@ func (b *Bot) handleAddGuild(e *gateway.GuildCreateEvent) {
 		// guild is unknown, so we need to add and initialize is
 		lastPos := discordutils.LastChannelPosition(e.Channels)
 
-		category, err := b.createMatchCategory(e.Guild.ID, lastPos)
+		category, err := b.createMatchCategory(e.Guild.ID, defaultMatchCategoryName, lastPos)
 		var (
 			created    = err == nil
 			categoryID = discord.NullChannelID
@@ -251,7 +340,8 @@ func (b *Bot) handleAddGuild(e *gateway.GuildCreateEvent) {
 	}
 }
 
-func (b *Bot) createMatchCategory(guildID discord.GuildID, pos int) (*discord.Channel, error) {
+// createMatchCategory creates a category which is only accessible by the bot, e.g. "matches" or the name of a division.
+func (b *Bot) createMatchCategory(guildID discord.GuildID, name string, pos int) (*discord.Channel, error) {
 	everyone, err := b.everyone(guildID)
 	if err != nil {
 		return nil, err
@@ -259,7 +349,7 @@ func (b *Bot) createMatchCategory(guildID discord.GuildID, pos int) (*discord.Ch
 	category, err := b.state.CreateChannel(
 		guildID,
 		api.CreateChannelData{
-			Name:     "matches",
+			Name:     name,
 			Type:     discord.GuildCategory,
 			Position: option.NewInt(pos),
 			Overwrites: []discord.Overwrite{
@@ -0,0 +1,115 @@
+package bot
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/diamondburned/arikawa/v3/api"
+	"github.com/diamondburned/arikawa/v3/api/cmdroute"
+	"github.com/diamondburned/arikawa/v3/discord"
+	"github.com/jxs13/league-discord-bot/internal/i18n"
+	"github.com/jxs13/league-discord-bot/sqlc"
+)
+
+// withGuildLanguage is a middleware which passes the configured language of the guild to the handlers.
+func (b *Bot) withGuildLanguage(next cmdroute.InteractionHandler) cmdroute.InteractionHandler {
+	return cmdroute.InteractionHandlerFunc(func(ctx context.Context, e *discord.InteractionEvent) *api.InteractionResponse {
+		lang := i18n.English
+		if e.GuildID.IsValid() {
+			err := b.Queries(ctx, func(ctx context.Context, q *sqlc.Queries) (err error) {
+				lang, err = guildLanguage(ctx, q, e.GuildID.String())
+				return err
+			})
+			if err != nil {
+				log.Printf("failed to get language of guild %s, falling back to %s: %v", e.GuildID, i18n.English, err)
+			}
+		}
+		return next.HandleInteraction(i18n.WithLanguage(ctx, lang), e)
+	})
+}
+
+// guildLanguage returns the configured language of the guild or English for unknown guilds.
+func guildLanguage(ctx context.Context, q *sqlc.Queries, guildID string) (i18n.Language, error) {
+	s, err := q.GetGuildLanguage(ctx, guildID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return i18n.English, nil
+		}
+		return i18n.English, fmt.Errorf("error getting guild language: %w", err)
+	}
+
+	lang, err := i18n.ParseLanguage(s)
+	if err != nil {
+		// the catalog of the language was removed
+		return i18n.English, nil
+	}
+	return lang, nil
+}
+
+func languageChoices() []discord.StringChoice {
+	langs := i18n.Languages()
+	choices := make([]discord.StringChoice, 0, len(langs))
+	for _, lang := range langs {
+		choices = append(choices, discord.StringChoice{Name: string(lang), Value: string(lang)})
+	}
+	return choices
+}
+
+// localizeCommands adds the name and description localizations of all catalogs to the commands and their options.
+func localizeCommands(cmds []api.CreateCommandData) {
+	for idx := range cmds {
+		cmd := &cmds[idx]
+		cmd.NameLocalizations = commandLocalizations("commands." + cmd.Name + ".name")
+		cmd.DescriptionLocalizations = commandLocalizations("commands." + cmd.Name + ".description")
+
+		for _, o := range cmd.Options {
+			localizations := commandLocalizations("commands." + cmd.Name + ".options." + o.Name() + ".description")
+			if localizations == nil {
+				continue
+			}
+
+			switch o := o.(type) {
+			case *discord.StringOption:
+				o.DescriptionLocalizations = localizations
+			case *discord.IntegerOption:
+				o.DescriptionLocalizations = localizations
+			case *discord.BooleanOption:
+				o.DescriptionLocalizations = localizations
+			case *discord.UserOption:
+				o.DescriptionLocalizations = localizations
+			case *discord.ChannelOption:
+				o.DescriptionLocalizations = localizations
+			case *discord.RoleOption:
+				o.DescriptionLocalizations = localizations
+			case *discord.AttachmentOption:
+				o.DescriptionLocalizations = localizations
+			default:
+				log.Printf("cannot localize option %s of command %s: unsupported option type %T", o.Name(), cmd.Name, o)
+			}
+		}
+	}
+}
+
+func commandLocalizations(key string) discord.StringLocales {
+	var result discord.StringLocales
+	for _, lang := range i18n.Languages() {
+		if lang == i18n.English {
+			// the commands are defined in english
+			continue
+		}
+
+		msg, ok := i18n.Lookup(lang, key)
+		if !ok {
+			continue
+		}
+
+		if result == nil {
+			result = make(discord.StringLocales)
+		}
+		result[lang.Locale()] = msg
+	}
+	return result
+}
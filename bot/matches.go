@@ -2,9 +2,13 @@ package bot
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/diamondburned/arikawa/v3/api"
@@ -13,6 +17,7 @@ import (
 	"github.com/diamondburned/arikawa/v3/utils/json/option"
 	"github.com/jxs13/league-discord-bot/internal/discordutils"
 	"github.com/jxs13/league-discord-bot/internal/format"
+	"github.com/jxs13/league-discord-bot/internal/i18n"
 	"github.com/jxs13/league-discord-bot/internal/options"
 	"github.com/jxs13/league-discord-bot/internal/parse"
 	"github.com/jxs13/league-discord-bot/sqlc"
@@ -22,7 +27,15 @@ const (
 	ReactionEmoji = "🎮"
 	// ReactionEmoji = "📆"
 
-	MaxConcurrentMatches = 50 // Category limitation which only allows for up to 50 channels
+	// MaxConcurrentMatches is the maximum number of open match channels per guild, which are spread over
+	// several categories. Discord allows up to 500 channels per guild, including categories and other channels.
+	MaxConcurrentMatches = 400
+
+	// defaultMatchCategoryName is the name of the category of matches without a division
+	defaultMatchCategoryName = "matches"
+
+	MinTeamsPerMatch = 2
+	MaxTeamsPerMatch = 8
 )
 
 func (b *Bot) commandScheduleMatch(ctx context.Context, data cmdroute.CommandData) (resp *api.InteractionResponseData) {
@@ -31,9 +44,7 @@ func (b *Bot) commandScheduleMatch(ctx context.Context, data cmdroute.CommandDat
 		guildID    = data.Event.GuildID
 		guildIDStr = guildID.String()
 		now        = time.Now()
-		nowUnix    = now.Unix()
 		userID     = data.Event.SenderID()
-		userIDStr  = userID.String()
 	)
 
 	// validation still happends synchronously
@@ -58,12 +69,7 @@ func (b *Bot) commandScheduleMatch(ctx context.Context, data cmdroute.CommandDat
 			return err
 		}
 
-		team1, err := options.RoleID("team_1_role", data.Options)
-		if err != nil {
-			return err
-		}
-
-		team2, err := options.RoleID("team_2_role", data.Options)
+		teamRoleIDs, err := b.matchTeamRoleIDs(ctx, q, guildID, data.Options)
 		if err != nil {
 			return err
 		}
@@ -73,11 +79,6 @@ func (b *Bot) commandScheduleMatch(ctx context.Context, data cmdroute.CommandDat
 			return err
 		}
 
-		if team1 == team2 {
-			err = fmt.Errorf("invalid parameter 'team_1_role' and 'team_2_role': must be different")
-			return err
-		}
-
 		streamUrl, _, err := options.OptionalUrl("stream_url", data.Options)
 		if err != nil {
 			return err
@@ -88,7 +89,7 @@ func (b *Bot) commandScheduleMatch(ctx context.Context, data cmdroute.CommandDat
 			return err
 		}
 
-		err = b.checkRoleIDs(guildID, team1, team2)
+		err = b.checkRoleIDs(guildID, teamRoleIDs...)
 		if err != nil {
 			return err
 		}
@@ -105,94 +106,230 @@ func (b *Bot) commandScheduleMatch(ctx context.Context, data cmdroute.CommandDat
 			}
 		}
 
+		division, err := divisionOption(ctx, q, guildIDStr, divisionNameOptionName, data.Options)
+		if err != nil {
+			return err
+		}
+
+		division, err = matchDivision(ctx, q, guildIDStr, division, teamRoleIDs)
+		if err != nil {
+			return err
+		}
+
+		// full categories are continued in overflow categories, so only the number of channels of the guild is limited
 		n, err := q.CountMatches(ctx, guildIDStr)
 		if err != nil {
 			return fmt.Errorf("error counting matches: %w", err)
 		}
 
 		if n >= MaxConcurrentMatches {
-			return fmt.Errorf("error: maximum number of concurrent matches reached: %d", MaxConcurrentMatches)
+			return i18n.Errorf("error.match_limit", MaxConcurrentMatches)
 		}
 
 		// validation is finished at this point and the actual creation of the channel begins
 
-		cfg, err := q.GetGuildConfig(ctx, guildIDStr)
+		var streamerIDs []discord.UserID
+		if okStreamer {
+			streamerIDs = append(streamerIDs, streamerID)
+		}
+
+		match, c, err := b.createMatch(ctx, q, newMatch{
+			GuildID:             guildID,
+			ScheduledAt:         scheduledAt,
+			TeamRoleIDs:         teamRoleIDs,
+			ModeratorIDs:        []discord.UserID{moderatorID},
+			StreamerIDs:         streamerIDs,
+			StreamUrl:           streamUrl,
+			ParticipantsPerTeam: participantsPerTeam,
+			CreatedBy:           userID,
+			Division:            division,
+		}, now)
 		if err != nil {
-			return fmt.Errorf("error getting guild config: %w", err)
+			return err
+		}
+		if c != nil {
+			defer func() {
+				if err != nil {
+					// the transaction is rolled back, so the channel must not be kept
+					if err := b.state.DeleteChannel(c.ID, api.AuditLogReason(err.Error())); err != nil {
+						log.Printf("error deleting channel %s: %v", c.ID, err)
+					}
+				}
+			}()
 		}
 
-		intervals, err := parse.ReminderIntervals(cfg.NotificationOffsets)
+		err = b.refreshJobSchedules(ctx, q)
 		if err != nil {
 			return err
 		}
 
-		categoryID, err := parse.ChannelID(cfg.CategoryID)
+		// the channel is created once the channel access window opens
+		content := i18n.T(
+			i18n.FromContext(ctx),
+			"match.scheduled",
+			match.Number,
+			format.DiscordRelativeTime(time.Unix(match.ChannelAccessibleAt, 0)),
+		)
+		if c != nil {
+			content = i18n.T(i18n.FromContext(ctx), "match.created", c.ID.Mention())
+		}
+
+		resp = &api.InteractionResponseData{
+			Content: option.NewNullableString(content),
+			Flags:   discord.EphemeralMessage,
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	// do not overwrite this response
+	// because it is set in the transaction
+	return resp
+
+}
+
+// matchTeamRoleIDs returns the roles of all teams of a match, the first two teams are required.
+// Each team is given either by its role (team_N_role) or by its registered name (team_N).
+func (b *Bot) matchTeamRoleIDs(ctx context.Context, q *sqlc.Queries, guildID discord.GuildID, opts discord.CommandInteractionOptions) ([]discord.RoleID, error) {
+	teamRoleIDs := make([]discord.RoleID, 0, MaxTeamsPerMatch)
+	for i := 1; i <= MaxTeamsPerMatch; i++ {
+		var (
+			roleName = fmt.Sprintf("team_%d_role", i)
+			teamName = fmt.Sprintf("team_%d", i)
+		)
+
+		rid, okRole, err := options.OptionalRoleID(roleName, opts)
 		if err != nil {
-			return err
+			return nil, err
+		}
+
+		o := opts.Find(teamName)
+		okTeam := o.Type != 0
+		switch {
+		case okRole && okTeam:
+			return nil, i18n.Errorf("error.team_option_conflict", roleName, teamName)
+		case okTeam:
+			team, err := getRegisteredTeam(ctx, q, guildID.String(), o.String())
+			if err != nil {
+				return nil, err
+			}
+
+			rid, err = parse.RoleID(team.RoleID)
+			if err != nil {
+				return nil, err
+			}
+		case !okRole:
+			if i <= MinTeamsPerMatch {
+				return nil, i18n.Errorf("error.parameter_missing", roleName)
+			}
+			continue
 		}
 
-		cnt, err := q.NextMatchCounter(ctx, guildID.String())
+		if slices.Contains(teamRoleIDs, rid) {
+			name := roleName
+			if okTeam {
+				name = teamName
+			}
+			return nil, i18n.Errorf("error.team_already_added", name, rid.Mention())
+		}
+		teamRoleIDs = append(teamRoleIDs, rid)
+	}
+	return teamRoleIDs, nil
+}
+
+// matchOption returns the match which is given either by its channel or by its number.
+// Matches whose channel is not created yet can only be selected by their number.
+func (b *Bot) matchOption(ctx context.Context, q *sqlc.Queries, e *discord.InteractionEvent, opts discord.CommandInteractionOptions) (sqlc.Match, error) {
+	channelID, ok, err := options.OptionalChannelID("match_channel", opts)
+	if err != nil {
+		return sqlc.Match{}, err
+	}
+	if ok {
+		err = b.checkIsGuildChannel(e, channelID)
 		if err != nil {
-			return fmt.Errorf("error getting next match counter: %w", err)
+			return sqlc.Match{}, err
 		}
 
-		everyone, err := b.everyone(guildID)
+		match, err := q.GetMatchByChannel(ctx, channelID.String())
 		if err != nil {
-			return err
+			if errors.Is(err, sql.ErrNoRows) {
+				return sqlc.Match{}, i18n.Errorf("error.match_not_found", channelID.Mention())
+			}
+			return sqlc.Match{}, fmt.Errorf("failed to get match for %s: %w", channelID.Mention(), err)
 		}
+		return match, nil
+	}
 
-		createData := api.CreateChannelData{
-			Name:       fmt.Sprintf("match-%d", cnt),
-			Type:       discord.GuildText,
-			CategoryID: categoryID,
-			Overwrites: []discord.Overwrite{
-				{
-					ID:   discord.Snowflake(everyone.ID), // everyone can't access channel
-					Type: discord.OverwriteRole,
-					Deny: discord.PermissionAllText,
-				},
-				{
-					ID:    discord.Snowflake(b.userID), // bot can access channel
-					Type:  discord.OverwriteMember,
-					Allow: discord.PermissionAllText,
-				},
-			},
+	number, ok, err := options.OptionalInteger("match_number", opts)
+	if err != nil {
+		return sqlc.Match{}, err
+	}
+	if !ok {
+		return sqlc.Match{}, i18n.Errorf("error.match_missing")
+	}
+
+	match, err := q.GetMatchByNumber(ctx, sqlc.GetMatchByNumberParams{
+		GuildID: e.GuildID.String(),
+		Number:  number,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return sqlc.Match{}, i18n.Errorf("error.match_not_found", matchName(number))
 		}
+		return sqlc.Match{}, fmt.Errorf("failed to get match %d: %w", number, err)
+	}
+	return match, nil
+}
 
-		c, err := b.state.CreateChannel(guildID, createData)
-		if err != nil {
-			// category was deleted while hte bot was turned off
-			if discordutils.IsStatus(err, http.StatusBadRequest) {
-				channels, err := b.state.Channels(guildID)
-				if err != nil {
-					return fmt.Errorf("failed to list channels: %w", err)
-				}
-				category, err := b.createMatchCategory(
-					guildID,
-					discordutils.LastChannelPosition(channels),
-				)
-				if err != nil {
-					return fmt.Errorf("error creating match category: %w", err)
-				}
-				categoryID = category.ID
+// matchName returns the name of a match, which is also the name of its channel, e.g. `match-12`.
+func matchName(number int64) string {
+	return format.MarkdownInlineCodeBlock(fmt.Sprintf("match-%d", number))
+}
 
-				err = q.UpdateCategoryId(ctx, sqlc.UpdateCategoryIdParams{
-					CategoryID: categoryID.String(),
-					GuildID:    guildIDStr,
-				})
-				if err != nil {
-					return fmt.Errorf("error updating category id: %w", err)
-				}
+// matchMention returns the mention of the match channel or the name of the match, in case its channel is not created yet.
+func matchMention(match sqlc.Match) (string, error) {
+	if match.ChannelID == "" {
+		return matchName(match.Number), nil
+	}
 
-				createData.CategoryID = categoryID
-				// category is recreated, now try to create the channel again
-				c, err = b.state.CreateChannel(guildID, createData)
-				if err != nil {
-					return fmt.Errorf("error creating channel: %w", err)
-				}
-			} else {
-				return fmt.Errorf("error creating channel: %w", err)
-			}
+	channelID, err := parse.ChannelID(match.ChannelID)
+	if err != nil {
+		return "", err
+	}
+	return channelID.Mention(), nil
+}
+
+// newMatch contains everything that is needed in order to create a match and its channel.
+type newMatch struct {
+	GuildID             discord.GuildID
+	ScheduledAt         time.Time
+	TeamRoleIDs         []discord.RoleID
+	ModeratorIDs        []discord.UserID
+	StreamerIDs         []discord.UserID
+	StreamUrl           string
+	ParticipantsPerTeam int64
+	CreatedBy           discord.UserID
+	// Division is empty for matches which use the configuration of the guild
+	Division string
+}
+
+// createMatch stores the match and all of its database entries. In case the channel access window is already open,
+// the match channel and the match message are created right away, otherwise c is nil and the channel is created
+// by asyncGrantChannelAccess once the window opens.
+// The caller is expected to validate the parameters and to refresh the job schedules afterwards.
+func (b *Bot) createMatch(ctx context.Context, q *sqlc.Queries, m newMatch, now time.Time) (match sqlc.Match, c *discord.Channel, err error) {
+	match, err = b.addMatch(ctx, q, m, now)
+	if err != nil {
+		return match, nil, err
+	}
+
+	if match.ChannelAccessibleAt <= now.Unix() {
+		c, err = b.openMatchChannel(ctx, q, match)
+		if err != nil {
+			return match, nil, err
 		}
 		defer func() {
 			if err != nil {
@@ -203,173 +340,471 @@ func (b *Bot) commandScheduleMatch(ctx context.Context, data cmdroute.CommandDat
 			}
 		}()
 
-		var (
-			vs                  = ""
-			confirmation        = ""
-			channelAccessibleAt = scheduledAt.Add(-1 * time.Second * time.Duration(cfg.ChannelAccessOffset))
-			channelDeleteAt     = scheduledAt.Add(time.Second * time.Duration(cfg.ChannelDeleteOffset))
-		)
-		if channelAccessibleAt.Before(now) {
-			// if the channel accessible time is in the past, set it to now
-			channelAccessibleAt = now
-		}
-
-		if participantsPerTeam > 0 {
-			vs = fmt.Sprintf("(%don%d)", participantsPerTeam, participantsPerTeam)
-			confirmation = fmt.Sprintf("\n\nPlease react with %s to confirm your participation.", ReactionEmoji)
-		}
-
-		msg, err := b.state.SendMessage(
-			c.ID,
-			fmt.Sprintf(
-				"Match between %s and %s %s scheduled at %s\n\nThis channel is accessible from %s until %s%s",
-				team1.Mention(),
-				team2.Mention(),
-				vs,
-				format.DiscordLongDateTime(scheduledAt),
-				format.DiscordLongDateTime(channelAccessibleAt),
-				format.DiscordLongDateTime(channelDeleteAt),
-				confirmation,
-			),
-		)
-		if err != nil {
-			return fmt.Errorf("error sending message: %w", err)
-		}
-		defer func() {
-			if err != nil {
-				// delete the message if there was an error
-				if err := b.state.DeleteMessage(c.ID, msg.ID, api.AuditLogReason(err.Error())); err != nil {
-					log.Printf("error deleting message %s: %v", msg.ID, err)
-				}
-			}
-		}()
+		match.ChannelID = c.ID.String()
+	}
 
-		if participantsPerTeam > 0 {
-			// only react when there are required participants for the teams
-			err = b.state.React(c.ID, msg.ID, ReactionEmoji)
-			if err != nil {
-				return fmt.Errorf("error reacting to message: %w", err)
-			}
-		}
+	err = b.refreshScheduleBoard(ctx, q, m.GuildID)
+	if err != nil {
+		return match, nil, err
+	}
 
-		var (
-			channelID    = c.ID
-			channelIDStr = channelID.String()
-			// epoch seconds
-			channelAccessibleAtUnix = channelAccessibleAt.Unix()
-			channelDeleteAtUnix     = channelDeleteAt.Unix()
-			participatonReqDeadline = scheduledAt.Add(-1 * time.Second * time.Duration(cfg.RequirementsOffset)).Unix()
-		)
+	return match, c, nil
+}
 
-		err = q.AddMatch(ctx, sqlc.AddMatchParams{
-			GuildID:             guildID.String(),
-			ChannelID:           channelIDStr,
-			ChannelAccessibleAt: channelAccessibleAtUnix,
-			ChannelDeleteAt:     max(nowUnix, channelDeleteAtUnix),
-			MessageID:           msg.ID.String(),
-			ScheduledAt:         scheduledAt.Unix(),
-			CreatedAt:           nowUnix,
-			CreatedBy:           userIDStr,
-			UpdatedAt:           nowUnix,
-			UpdatedBy:           userIDStr,
+// addMatch stores the match and all of its database entries without creating its channel,
+// which is created by asyncGrantChannelAccess once the channel access window opens.
+// The caller is expected to refresh the schedule board and the job schedules afterwards.
+func (b *Bot) addMatch(ctx context.Context, q *sqlc.Queries, m newMatch, now time.Time) (match sqlc.Match, err error) {
+	var (
+		guildIDStr = m.GuildID.String()
+		nowUnix    = now.Unix()
+		userIDStr  = m.CreatedBy.String()
+	)
+
+	cfg, err := divisionConfig(ctx, q, guildIDStr, m.Division)
+	if err != nil {
+		return match, err
+	}
+
+	intervals, err := parse.ReminderIntervals(cfg.NotificationOffsets)
+	if err != nil {
+		return match, err
+	}
+
+	cnt, err := q.NextMatchCounter(ctx, guildIDStr)
+	if err != nil {
+		return match, fmt.Errorf("error getting next match counter: %w", err)
+	}
+
+	channelAccessibleAt, channelDeleteAt, participatonReqDeadlineAt := matchLifecycle(cfg, m.ScheduledAt, now)
+
+	var (
+		// epoch seconds
+		channelAccessibleAtUnix = channelAccessibleAt.Unix()
+		channelDeleteAtUnix     = channelDeleteAt.Unix()
+		participatonReqDeadline = participatonReqDeadlineAt.Unix()
+	)
+
+	matchID, err := q.AddMatch(ctx, sqlc.AddMatchParams{
+		GuildID:             guildIDStr,
+		Number:              cnt,
+		ChannelID:           "", // created when the channel access window opens
+		ChannelAccessibleAt: channelAccessibleAtUnix,
+		ChannelDeleteAt:     max(nowUnix, channelDeleteAtUnix),
+		MessageID:           "",
+		ScheduledAt:         m.ScheduledAt.Unix(),
+		CreatedAt:           nowUnix,
+		CreatedBy:           userIDStr,
+		UpdatedAt:           nowUnix,
+		UpdatedBy:           userIDStr,
+		Division:            m.Division,
+	})
+	if err != nil {
+		return match, fmt.Errorf("error adding match: %w", err)
+	}
+
+	if m.ParticipantsPerTeam > 0 {
+		err = q.AddParticipationRequirements(ctx, sqlc.AddParticipationRequirementsParams{
+			MatchID:             matchID,
+			ParticipantsPerTeam: m.ParticipantsPerTeam,
+			DeadlineAt:          max(nowUnix, participatonReqDeadline),
+			EntryClosed:         0,
 		})
 		if err != nil {
-			return fmt.Errorf("error adding match: %w", err)
-		}
-
-		if participantsPerTeam > 0 {
-			err = q.AddParticipationRequirements(ctx, sqlc.AddParticipationRequirementsParams{
-				ChannelID:           channelIDStr,
-				ParticipantsPerTeam: participantsPerTeam,
-				DeadlineAt:          max(nowUnix, participatonReqDeadline),
-				EntryClosed:         0,
-			})
-			if err != nil {
-				return fmt.Errorf("error adding participation requirements: %w", err)
-			}
+			return match, fmt.Errorf("error adding participation requirements: %w", err)
 		}
+	}
 
-		// team1
+	for idx, rid := range m.TeamRoleIDs {
 		err = q.AddMatchTeam(ctx, sqlc.AddMatchTeamParams{
-			ChannelID: channelIDStr,
-			RoleID:    team1.String(),
+			MatchID: matchID,
+			RoleID:  rid.String(),
 		})
 		if err != nil {
-			return fmt.Errorf("error adding match team 1: %w", err)
+			return match, fmt.Errorf("error adding match team %d: %w", idx+1, err)
 		}
-		// team 2
-		err = q.AddMatchTeam(ctx, sqlc.AddMatchTeamParams{
-			ChannelID: channelIDStr,
-			RoleID:    team2.String(),
+	}
+
+	for _, uid := range m.ModeratorIDs {
+		err = q.AddMatchModerator(ctx, sqlc.AddMatchModeratorParams{
+			MatchID: matchID,
+			UserID:  uid.String(),
 		})
 		if err != nil {
-			return fmt.Errorf("error adding match team 2: %w", err)
+			return match, fmt.Errorf("error adding match moderator: %w", err)
 		}
+	}
 
-		err = q.AddMatchModerator(ctx, sqlc.AddMatchModeratorParams{
-			ChannelID: channelIDStr,
-			UserID:    moderatorID.String(),
+	for _, uid := range m.StreamerIDs {
+		err = q.AddMatchStreamer(ctx, sqlc.AddMatchStreamerParams{
+			MatchID: matchID,
+			UserID:  uid.String(),
+			Url:     m.StreamUrl,
 		})
 		if err != nil {
-			return fmt.Errorf("error adding match moderator: %w", err)
+			return match, fmt.Errorf("error adding match streamer: %w", err)
 		}
+	}
 
-		if okStreamer {
-			err = q.AddMatchStreamer(ctx, sqlc.AddMatchStreamerParams{
-				ChannelID: channelIDStr,
-				UserID:    streamerID.String(),
-				Url:       streamUrl,
-			})
+	// create notifications, can be disabled, in case there are not intervals defined in the guild config
+	err = addGeneratedNotifications(ctx, q, matchID, m.ScheduledAt, intervals, now, userIDStr)
+	if err != nil {
+		return match, err
+	}
+
+	match, err = q.GetMatch(ctx, matchID)
+	if err != nil {
+		return match, fmt.Errorf("error getting match %d: %w", matchID, err)
+	}
+	return match, nil
+}
+
+// openMatchChannel creates the channel and the message of a match whose channel access window opened.
+// The channel is only accessible by the bot, the participants are granted access by asyncGrantChannelAccess.
+// The caller is expected to delete the channel in case the transaction is rolled back.
+func (b *Bot) openMatchChannel(ctx context.Context, q *sqlc.Queries, match sqlc.Match) (c *discord.Channel, err error) {
+	guildID, err := parse.GuildID(match.GuildID)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := divisionConfig(ctx, q, match.GuildID, match.Division)
+	if err != nil {
+		return nil, err
+	}
+
+	primaryCategoryID, err := parse.ChannelID(cfg.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	// full categories are continued in overflow categories
+	categoryID, created, err := b.matchCategory(ctx, q, guildID, match.Division, primaryCategoryID)
+	if err != nil {
+		return nil, err
+	}
+	if created {
+		defer func() {
 			if err != nil {
-				return fmt.Errorf("error adding match streamer: %w", err)
+				// the transaction is rolled back, so the overflow category must not be kept
+				if err := b.state.DeleteChannel(categoryID, api.AuditLogReason(err.Error())); err != nil {
+					log.Printf("error deleting category %s: %v", categoryID, err)
+				}
 			}
-		}
+		}()
+	}
+
+	everyone, err := b.everyone(guildID)
+	if err != nil {
+		return nil, err
+	}
 
-		// create notifications, can be disabled, in case there are not intervals defined in the guild config
-		for _, d := range intervals {
-			notifyAt := scheduledAt.Add(-1 * d)
-			if now.Sub(notifyAt) >= 0 {
-				// if the notification time is in the past, skip it
-				continue
+	createData := api.CreateChannelData{
+		Name:       fmt.Sprintf("match-%d", match.Number),
+		Type:       discord.GuildText,
+		CategoryID: categoryID,
+		Overwrites: []discord.Overwrite{
+			{
+				ID:   discord.Snowflake(everyone.ID), // everyone can't access channel
+				Type: discord.OverwriteRole,
+				Deny: discord.PermissionAllText,
+			},
+			{
+				ID:    discord.Snowflake(b.userID), // bot can access channel
+				Type:  discord.OverwriteMember,
+				Allow: discord.PermissionAllText,
+			},
+		},
+	}
+
+	c, err = b.state.CreateChannel(guildID, createData)
+	if err != nil {
+		// category was deleted while hte bot was turned off
+		if categoryID == primaryCategoryID && discordutils.IsStatus(err, http.StatusBadRequest) {
+			channels, err := b.state.Channels(guildID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list channels: %w", err)
+			}
+			category, err := b.createMatchCategory(
+				guildID,
+				matchCategoryName(match.Division, 1),
+				discordutils.LastChannelPosition(channels),
+			)
+			if err != nil {
+				return nil, fmt.Errorf("error creating match category: %w", err)
 			}
+			categoryID = category.ID
 
-			err = q.AddNotification(ctx, sqlc.AddNotificationParams{
-				ChannelID:  channelIDStr,
-				NotifyAt:   notifyAt.Unix(),
-				CustomText: "", // will be automatically generate in case that it is not provided, which is not the case for default notifications
-				CreatedBy:  userIDStr,
-				CreatedAt:  nowUnix,
-				UpdatedBy:  userIDStr,
-				UpdatedAt:  nowUnix,
-			})
+			if match.Division != "" {
+				err = q.UpdateDivisionCategoryId(ctx, sqlc.UpdateDivisionCategoryIdParams{
+					CategoryID: categoryID.String(),
+					GuildID:    match.GuildID,
+					Name:       match.Division,
+				})
+			} else {
+				err = q.UpdateCategoryId(ctx, sqlc.UpdateCategoryIdParams{
+					CategoryID: categoryID.String(),
+					GuildID:    match.GuildID,
+				})
+			}
 			if err != nil {
-				return fmt.Errorf("error adding notification: %w", err)
+				return nil, fmt.Errorf("error updating category id: %w", err)
 			}
-		}
 
-		err = b.refreshJobSchedules(ctx, q)
+			createData.CategoryID = categoryID
+			// category is recreated, now try to create the channel again
+			c, err = b.state.CreateChannel(guildID, createData)
+			if err != nil {
+				return nil, fmt.Errorf("error creating channel: %w", err)
+			}
+		} else {
+			return nil, fmt.Errorf("error creating channel: %w", err)
+		}
+	}
+	defer func() {
 		if err != nil {
-			return err
+			// delete the channel if there was an error
+			if err := b.state.DeleteChannel(c.ID, api.AuditLogReason(err.Error())); err != nil {
+				log.Printf("error deleting channel %s: %v", c.ID, err)
+			}
 		}
+	}()
 
-		resp = &api.InteractionResponseData{
-			Content: option.NewNullableString(
-				fmt.Sprintf(
-					"Created a new match channel: %s",
-					c.ID.Mention(),
-				),
-			),
-			Flags: discord.EphemeralMessage,
+	lang, err := guildLanguage(ctx, q, match.GuildID)
+	if err != nil {
+		return nil, err
+	}
+
+	mode, err := guildOutputMode(ctx, q, match.GuildID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the match might have been changed between its creation and the opening of its channel
+	card, err := b.loadMatchCard(ctx, q, match.MatchID)
+	if err != nil {
+		return nil, err
+	}
+	card.ChannelID = c.ID
+
+	content, embeds := matchMessage(lang, mode, card)
+	msgData := api.SendMessageData{
+		Content: content,
+		Embeds:  embeds,
+	}
+	if card.EntryOpen {
+		// only ask for participants when there are required participants for the teams
+		msgData.Components = participationComponents(lang)
+	}
+
+	msg, err := b.state.SendMessageComplex(c.ID, msgData)
+	if err != nil {
+		return nil, fmt.Errorf("error sending message: %w", err)
+	}
+
+	err = q.UpdateMatchChannel(ctx, sqlc.UpdateMatchChannelParams{
+		ChannelID: c.ID.String(),
+		MessageID: msg.ID.String(),
+		MatchID:   match.MatchID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error updating match channel: %w", err)
+	}
+
+	log.Printf("created channel %s of match %d in guild %s", c.ID, match.Number, guildID)
+	return c, nil
+}
+
+// matchLifecycle calculates the points in time at which the match channel becomes accessible,
+// at which it is deleted and until which the participation requirements need to be met.
+func matchLifecycle(cfg sqlc.GetGuildConfigRow, scheduledAt, now time.Time) (accessibleAt, deleteAt, deadlineAt time.Time) {
+	accessibleAt = scheduledAt.Add(-1 * time.Second * time.Duration(cfg.ChannelAccessOffset))
+	deleteAt = scheduledAt.Add(time.Second * time.Duration(cfg.ChannelDeleteOffset))
+	deadlineAt = scheduledAt.Add(-1 * time.Second * time.Duration(cfg.RequirementsOffset))
+
+	if accessibleAt.Before(now) {
+		// if the channel accessible time is in the past, set it to now
+		accessibleAt = now
+	}
+	return accessibleAt, deleteAt, deadlineAt
+}
+
+// formatMatchMessage formats the match message, teams are expected to contain the team mentions
+// and lineups as well as substitutes the participants of each team in the same order.
+func formatMatchMessage(
+	lang i18n.Language,
+	teams []string,
+	lineups [][]discord.UserID,
+	substitutes [][]discord.UserID,
+	participantsPerTeam int64,
+	scheduledAt time.Time,
+	accessibleAt time.Time,
+	deleteAt time.Time,
+) string {
+	var (
+		vs           = ""
+		lineup       = ""
+		confirmation = ""
+	)
+
+	if participantsPerTeam > 0 {
+		vs = fmt.Sprintf("(%don%d)", participantsPerTeam, participantsPerTeam)
+		confirmation = i18n.T(lang, "match.confirmation")
+
+		var sb strings.Builder
+		sb.WriteString(i18n.T(lang, "match.lineups"))
+		for idx, team := range teams {
+			var members []discord.UserID
+			if idx < len(lineups) {
+				members = lineups[idx]
+			}
+
+			mentions := make([]string, 0, len(members))
+			for _, uid := range members {
+				mentions = append(mentions, uid.Mention())
+			}
+			if len(mentions) == 0 {
+				mentions = append(mentions, "-")
+			}
+
+			sb.WriteString(fmt.Sprintf("\n%s (%d/%d): %s", team, len(members), participantsPerTeam, strings.Join(mentions, ", ")))
+
+			if idx < len(substitutes) && len(substitutes[idx]) > 0 {
+				subs := make([]string, 0, len(substitutes[idx]))
+				for _, uid := range substitutes[idx] {
+					subs = append(subs, uid.Mention())
+				}
+				sb.WriteString(i18n.T(lang, "match.substitutes"))
+				sb.WriteString(strings.Join(subs, ", "))
+			}
 		}
+		lineup = sb.String()
+	}
 
-		return nil
+	return i18n.T(
+		lang,
+		"match.message",
+		strings.Join(teams, i18n.T(lang, "match.teams_separator")),
+		vs,
+		format.DiscordLongDateTime(scheduledAt),
+		format.DiscordLongDateTime(accessibleAt),
+		format.DiscordLongDateTime(deleteAt),
+		lineup,
+		confirmation,
+	)
+}
+
+// editMatchMessage updates the match message with the current state of the match.
+// Matches whose channel was not created yet only update the schedule board.
+func (b *Bot) editMatchMessage(ctx context.Context, q *sqlc.Queries, matchID int64) error {
+	match, err := q.GetMatch(ctx, matchID)
+	if err != nil {
+		return fmt.Errorf("error getting match %d: %w", matchID, err)
+	}
+
+	guildID, err := parse.GuildID(match.GuildID)
+	if err != nil {
+		return err
+	}
+
+	if match.MessageID == "" {
+		return b.refreshScheduleBoard(ctx, q, guildID)
+	}
+
+	channelID, err := parse.ChannelID(match.ChannelID)
+	if err != nil {
+		return err
+	}
+
+	msgID, err := parse.MessageID(match.MessageID)
+	if err != nil {
+		return err
+	}
+
+	lang, err := guildLanguage(ctx, q, match.GuildID)
+	if err != nil {
+		return err
+	}
+
+	mode, err := guildOutputMode(ctx, q, match.GuildID)
+	if err != nil {
+		return err
+	}
+
+	card, err := b.loadMatchCard(ctx, q, matchID)
+	if err != nil {
+		return err
+	}
+
+	components := discord.ContainerComponents{}
+	if card.EntryOpen {
+		// match messages which still use the participation reaction are migrated to the buttons
+		components = participationComponents(lang)
+	}
+
+	content, embeds := matchMessage(lang, mode, card)
+	_, err = b.state.EditMessageComplex(channelID, msgID, api.EditMessageData{
+		Content:    option.NewNullableString(content),
+		Embeds:     &embeds,
+		Components: &components,
 	})
 	if err != nil {
-		return errorResponse(err)
+		return fmt.Errorf("error updating match message: %w", err)
 	}
+	return b.refreshScheduleBoard(ctx, q, guildID)
+}
 
-	// do not overwrite this response
-	// because it is set in the transaction
-	return resp
+// matchMessage returns the content and the embeds of the match message in the given output mode.
+// In the embed mode the content only consists of the team mentions.
+func matchMessage(lang i18n.Language, mode outputMode, card matchCard) (string, []discord.Embed) {
+	if mode == outputModeEmbed {
+		return strings.Join(card.Teams, " "), []discord.Embed{matchEmbed(lang, card)}
+	}
+
+	return formatMatchMessage(
+		lang,
+		card.Teams,
+		card.Lineups,
+		card.Substitutes,
+		card.ParticipantsPerTeam,
+		card.ScheduledAt,
+		card.AccessibleAt,
+		card.DeleteAt,
+	), []discord.Embed{}
+}
 
+// addGeneratedNotifications creates the default notifications of a match based on the guild's reminder intervals.
+// Notifications that would lie in the past are skipped.
+func addGeneratedNotifications(
+	ctx context.Context,
+	q *sqlc.Queries,
+	matchID int64,
+	scheduledAt time.Time,
+	intervals []time.Duration,
+	now time.Time,
+	userID string,
+) error {
+	nowUnix := now.Unix()
+	for _, d := range intervals {
+		notifyAt := scheduledAt.Add(-1 * d)
+		if now.Sub(notifyAt) >= 0 {
+			// if the notification time is in the past, skip it
+			continue
+		}
+
+		err := q.AddNotification(ctx, sqlc.AddNotificationParams{
+			MatchID:    matchID,
+			NotifyAt:   notifyAt.Unix(),
+			CustomText: "", // will be automatically generate in case that it is not provided, which is not the case for default notifications
+			Generated:  1,
+			CreatedBy:  userID,
+			CreatedAt:  nowUnix,
+			UpdatedBy:  userID,
+			UpdatedAt:  nowUnix,
+		})
+		if err != nil {
+			return fmt.Errorf("error adding notification: %w", err)
+		}
+	}
+	return nil
 }
@@ -0,0 +1,292 @@
+package bot
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/diamondburned/arikawa/v3/api"
+	"github.com/diamondburned/arikawa/v3/api/cmdroute"
+	"github.com/diamondburned/arikawa/v3/discord"
+	"github.com/diamondburned/arikawa/v3/utils/json/option"
+	"github.com/jxs13/league-discord-bot/internal/format"
+	"github.com/jxs13/league-discord-bot/internal/i18n"
+	"github.com/jxs13/league-discord-bot/internal/model"
+	"github.com/jxs13/league-discord-bot/internal/msgtemplate"
+	"github.com/jxs13/league-discord-bot/sqlc"
+)
+
+func (b *Bot) commandTemplatePreview(ctx context.Context, data cmdroute.CommandData) (resp *api.InteractionResponseData) {
+	var content string
+	err := b.Queries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		err := b.checkAccess(ctx, q, data.Event, ADMIN)
+		if err != nil {
+			return err
+		}
+
+		kind, err := msgtemplate.ParseKind(data.Options.Find("kind").String())
+		if err != nil {
+			return err
+		}
+
+		var (
+			text   string
+			source string
+		)
+		if o := data.Options.Find("template"); o.Type != 0 {
+			text = unescapeTemplate(o.String())
+			err = msgtemplate.Validate(text)
+			if err != nil {
+				return err
+			}
+			source = i18n.T(i18n.FromContext(ctx), "template.unsaved")
+		} else {
+			var custom bool
+			text, custom, err = messageTemplate(ctx, q, data.Event.GuildID.String(), kind)
+			if err != nil {
+				return err
+			}
+			source = i18n.T(i18n.FromContext(ctx), "template.default")
+			if custom {
+				source = i18n.T(i18n.FromContext(ctx), "template.custom")
+			}
+		}
+
+		rendered, err := msgtemplate.Execute(text, msgtemplate.Sample())
+		if err != nil {
+			return err
+		}
+
+		content = i18n.T(
+			i18n.FromContext(ctx),
+			"template.preview",
+			source,
+			format.MarkdownInlineCodeBlock(string(kind)),
+			format.MarkdownMultilineCodeBlock(text),
+			rendered,
+		)
+		return nil
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	if len(content) > 1900 {
+		content = content[:1900] + "..."
+	}
+
+	return &api.InteractionResponseData{
+		Content:         option.NewNullableString(content),
+		Flags:           discord.EphemeralMessage,
+		AllowedMentions: &api.AllowedMentions{ /* none */ },
+	}
+}
+
+func (b *Bot) commandTemplateSet(ctx context.Context, data cmdroute.CommandData) (resp *api.InteractionResponseData) {
+	var content string
+	err := b.TxQueries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		err := b.checkAccess(ctx, q, data.Event, ADMIN)
+		if err != nil {
+			return err
+		}
+
+		kind, err := msgtemplate.ParseKind(data.Options.Find("kind").String())
+		if err != nil {
+			return err
+		}
+
+		text := unescapeTemplate(data.Options.Find("template").String())
+		err = msgtemplate.Validate(text)
+		if err != nil {
+			return err
+		}
+
+		err = q.SetMessageTemplate(ctx, sqlc.SetMessageTemplateParams{
+			GuildID:   data.Event.GuildID.String(),
+			Kind:      string(kind),
+			Template:  text,
+			UpdatedAt: time.Now().Unix(),
+			UpdatedBy: data.Event.SenderID().String(),
+		})
+		if err != nil {
+			return fmt.Errorf("error setting message template: %w", err)
+		}
+
+		content = i18n.T(i18n.FromContext(ctx), "template.updated", format.MarkdownInlineCodeBlock(string(kind)))
+		return nil
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return &api.InteractionResponseData{
+		Content: option.NewNullableString(content),
+		Flags:   discord.EphemeralMessage,
+	}
+}
+
+func (b *Bot) commandTemplateReset(ctx context.Context, data cmdroute.CommandData) (resp *api.InteractionResponseData) {
+	var content string
+	err := b.TxQueries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		err := b.checkAccess(ctx, q, data.Event, ADMIN)
+		if err != nil {
+			return err
+		}
+
+		kind, err := msgtemplate.ParseKind(data.Options.Find("kind").String())
+		if err != nil {
+			return err
+		}
+
+		params := sqlc.GetMessageTemplateParams{
+			GuildID: data.Event.GuildID.String(),
+			Kind:    string(kind),
+		}
+		_, err = q.GetMessageTemplate(ctx, params)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				content = i18n.T(i18n.FromContext(ctx), "template.already_default", format.MarkdownInlineCodeBlock(string(kind)))
+				return nil
+			}
+			return fmt.Errorf("error getting message template: %w", err)
+		}
+
+		err = q.DeleteMessageTemplate(ctx, sqlc.DeleteMessageTemplateParams(params))
+		if err != nil {
+			return fmt.Errorf("error deleting message template: %w", err)
+		}
+
+		content = i18n.T(i18n.FromContext(ctx), "template.reset", format.MarkdownInlineCodeBlock(string(kind)))
+		return nil
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return &api.InteractionResponseData{
+		Content: option.NewNullableString(content),
+		Flags:   discord.EphemeralMessage,
+	}
+}
+
+func templateKindChoices() []discord.StringChoice {
+	choices := make([]discord.StringChoice, 0, len(msgtemplate.Kinds))
+	for _, k := range msgtemplate.Kinds {
+		choices = append(choices, discord.StringChoice{Name: string(k), Value: string(k)})
+	}
+	return choices
+}
+
+// unescapeTemplate allows to use line breaks in templates, which cannot be entered in command options.
+func unescapeTemplate(s string) string {
+	return strings.ReplaceAll(s, `\n`, "\n")
+}
+
+// messageTemplate returns the guild's template of the message kind or the default template in the guild's language.
+func messageTemplate(ctx context.Context, q *sqlc.Queries, guildID string, kind msgtemplate.Kind) (text string, custom bool, err error) {
+	t, err := q.GetMessageTemplate(ctx, sqlc.GetMessageTemplateParams{
+		GuildID: guildID,
+		Kind:    string(kind),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			lang, err := guildLanguage(ctx, q, guildID)
+			if err != nil {
+				return "", false, err
+			}
+			return msgtemplate.Default(lang, kind), false, nil
+		}
+		return "", false, fmt.Errorf("error getting message template: %w", err)
+	}
+	return t.Template, true, nil
+}
+
+// renderMessage renders the guild's template of the message kind.
+// In case that the custom template cannot be rendered or its message exceeds the maximum length, the default template is used.
+func renderMessage(ctx context.Context, q *sqlc.Queries, guildID string, kind msgtemplate.Kind, data msgtemplate.Data) (string, error) {
+	text, custom, err := messageTemplate(ctx, q, guildID, kind)
+	if err != nil {
+		return "", err
+	}
+
+	content, err := msgtemplate.Execute(text, data)
+	if err != nil && custom {
+		log.Printf("failed to render custom %s template of guild %s, falling back to default: %v", kind, guildID, err)
+		lang, err := guildLanguage(ctx, q, guildID)
+		if err != nil {
+			return "", err
+		}
+		content, err = msgtemplate.Execute(msgtemplate.Default(lang, kind), data)
+		if err != nil {
+			return "", err
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+	return content, nil
+}
+
+// formatNotification renders the guild's template of the message kind and allows to mention
+// the teams, moderators, streamers and participants.
+// In case that an embed is given, it is attached to the message and the roster is reduced to the mentions.
+func formatNotification(
+	ctx context.Context,
+	q *sqlc.Queries,
+	guildID string,
+	kind msgtemplate.Kind,
+	data msgtemplate.Data,
+	teamRoleIDs []discord.RoleID,
+	modUserIDs []discord.UserID,
+	streamers []model.Streamer,
+	participants map[discord.RoleID][]discord.UserID,
+	embed *discord.Embed,
+) (api.SendMessageData, error) {
+	teams := make([]string, 0, len(teamRoleIDs))
+	for _, rid := range teamRoleIDs {
+		teams = append(teams, rid.Mention())
+	}
+
+	mods := make([]string, 0, len(modUserIDs))
+	for _, uid := range modUserIDs {
+		mods = append(mods, uid.Mention())
+	}
+
+	streams := make([]string, 0, len(streamers))
+	for _, s := range streamers {
+		streams = append(streams, s.Mention())
+	}
+
+	data.Teams = strings.Join(teams, " ")
+	data.Moderators = strings.Join(mods, ", ")
+	data.Streamers = strings.Join(streams, ", ")
+	lang, err := guildLanguage(ctx, q, guildID)
+	if err != nil {
+		return api.SendMessageData{}, err
+	}
+	if embed != nil {
+		data.Roster = FormatMentions(teamRoleIDs, modUserIDs, streamers, participants)
+	} else {
+		data.Roster = FormatRoster(lang, teamRoleIDs, modUserIDs, streamers, participants)
+	}
+
+	content, err := renderMessage(ctx, q, guildID, kind, data)
+	if err != nil {
+		return api.SendMessageData{}, err
+	}
+
+	msg := api.SendMessageData{
+		Content:         content,
+		AllowedMentions: AllowedMentions(teamRoleIDs, modUserIDs, streamers, participants),
+		Flags:           discord.SuppressEmbeds,
+	}
+	if embed != nil {
+		msg.Embeds = []discord.Embed{*embed}
+		msg.Flags = 0
+	}
+	return msg, nil
+}
@@ -9,7 +9,7 @@ import (
 	"github.com/jxs13/league-discord-bot/sqlc"
 )
 
-func (b *Bot) listMatchModeratorUserIDs(ctx context.Context, q *sqlc.Queries, channelID discord.ChannelID) (_ []discord.UserID, err error) {
+func (b *Bot) listMatchModeratorUserIDs(ctx context.Context, q *sqlc.Queries, matchID int64) (_ []discord.UserID, err error) {
 	defer func() {
 		if err != nil {
 			err = fmt.Errorf("error listing match moderators: %w", err)
@@ -17,7 +17,7 @@ func (b *Bot) listMatchModeratorUserIDs(ctx context.Context, q *sqlc.Queries, ch
 	}()
 
 	// we also need to get access to moderators
-	mods, err := q.ListMatchModerators(ctx, channelID.String())
+	mods, err := q.ListMatchModerators(ctx, matchID)
 	if err != nil {
 		return nil, fmt.Errorf("error getting match moderators: %w", err)
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/diamondburned/arikawa/v3/discord"
 	"github.com/diamondburned/arikawa/v3/utils/json/option"
 	"github.com/jxs13/league-discord-bot/internal/format"
+	"github.com/jxs13/league-discord-bot/internal/i18n"
 	"github.com/jxs13/league-discord-bot/internal/options"
 	"github.com/jxs13/league-discord-bot/sqlc"
 )
@@ -33,35 +34,26 @@ func (b *Bot) commandNotificationsList(ctx context.Context, data cmdroute.Comman
 		if err != nil {
 			return err
 		}
-		channelIDStr := channelID.String()
 
-		err = b.checkIsGuildChannel(data.Event, channelID)
+		match, err := b.matchOption(ctx, q, data.Event, data.Options)
 		if err != nil {
 			return err
 		}
 
-		_, err = q.GetMatch(ctx, channelIDStr)
+		notifications, err := q.ListNotifications(ctx, match.MatchID)
 		if err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
-				return fmt.Errorf("no corresponding match found for %s", channelID.Mention())
-			}
-			return fmt.Errorf("failed to get match for %s: %w", channelID.Mention(), err)
-		}
-
-		notifications, err := q.ListNotifications(ctx, channelIDStr)
-		if err != nil {
-			if errors.Is(err, sql.ErrNoRows) {
-				sb.WriteString(fmt.Sprintf("No notifications found for %s.", channelID.Mention()))
+				sb.WriteString(i18n.T(i18n.FromContext(ctx), "notification.none", channelID.Mention()))
 				return nil
 			}
 			return err
 		}
 		if len(notifications) == 0 {
-			sb.WriteString(fmt.Sprintf("No notifications found for %s.", channelID.Mention()))
+			sb.WriteString(i18n.T(i18n.FromContext(ctx), "notification.none", channelID.Mention()))
 			return nil
 		}
 		sb.Grow((1 + len(notifications)) * 64)
-		sb.WriteString(fmt.Sprintf("Notifications for %s:\n", channelID.Mention()))
+		sb.WriteString(i18n.T(i18n.FromContext(ctx), "notification.list", channelID.Mention()))
 
 		// max allowed are 50
 		for i, n := range notifications {
@@ -76,7 +68,7 @@ func (b *Bot) commandNotificationsList(ctx context.Context, data cmdroute.Comman
 		return nil
 	})
 	if err != nil {
-		return errorResponse(err)
+		return errorResponse(ctx, err)
 	}
 
 	result := sb.String()
@@ -101,9 +93,8 @@ func (b *Bot) commandNotificationsDelete(ctx context.Context, data cmdroute.Comm
 		if err != nil {
 			return err
 		}
-		channelIDStr := channelID.String()
 
-		err = b.checkIsGuildChannel(data.Event, channelID)
+		match, err := b.matchOption(ctx, q, data.Event, data.Options)
 		if err != nil {
 			return err
 		}
@@ -113,30 +104,30 @@ func (b *Bot) commandNotificationsDelete(ctx context.Context, data cmdroute.Comm
 			return err
 		}
 
-		maxNumber, err := q.CountNotifications(ctx, channelIDStr)
+		maxNumber, err := q.CountNotifications(ctx, match.MatchID)
 		if err != nil {
 			return fmt.Errorf("error counting notifications for %s: %w", channelID.Mention(), err)
 		}
 
 		if n > maxNumber {
-			return fmt.Errorf("number %d is out of range (1-%d)", n, maxNumber)
+			return i18n.Errorf("error.notification_number_range", n, maxNumber)
 		}
 
 		notification, err := q.GetNotificationByOffset(ctx, sqlc.GetNotificationByOffsetParams{
-			ChannelID: channelID.String(),
-			Offset:    n - 1,
+			MatchID: match.MatchID,
+			Offset:  n - 1,
 		})
 		if err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
-				result = fmt.Sprintf("Notification not found for %s at position %d", channelID.Mention(), n)
+				result = i18n.T(i18n.FromContext(ctx), "notification.not_found", channelID.Mention(), n)
 				return nil
 			}
 			return err
 		}
 
 		err = q.DeleteNotification(ctx, sqlc.DeleteNotificationParams{
-			ChannelID: channelIDStr,
-			NotifyAt:  notification.NotifyAt,
+			MatchID:  match.MatchID,
+			NotifyAt: notification.NotifyAt,
 		})
 		if err != nil {
 			return fmt.Errorf("error deleting notification for %s at position %d: %w", channelID.Mention(), n, err)
@@ -147,8 +138,9 @@ func (b *Bot) commandNotificationsDelete(ctx context.Context, data cmdroute.Comm
 			return err
 		}
 
-		result = fmt.Sprintf(
-			"Notification %d (%s) deleted for %s",
+		result = i18n.T(
+			i18n.FromContext(ctx),
+			"notification.deleted",
 			n,
 			format.DiscordLongDateTime(time.Unix(notification.NotifyAt, 0)),
 			channelID.Mention(),
@@ -156,7 +148,7 @@ func (b *Bot) commandNotificationsDelete(ctx context.Context, data cmdroute.Comm
 		return nil
 	})
 	if err != nil {
-		return errorResponse(err)
+		return errorResponse(ctx, err)
 	}
 
 	return &api.InteractionResponseData{
@@ -177,21 +169,12 @@ func (b *Bot) commandNotificationsAdd(ctx context.Context, data cmdroute.Command
 		if err != nil {
 			return err
 		}
-		channelIDStr := channelID.String()
-
-		err = b.checkIsGuildChannel(data.Event, channelID)
-		if err != nil {
-			return err
-		}
 
 		customText := data.Options.Find("custom_text").String()
 
-		match, err := q.GetMatch(ctx, channelIDStr)
+		match, err := b.matchOption(ctx, q, data.Event, data.Options)
 		if err != nil {
-			if errors.Is(err, sql.ErrNoRows) {
-				return fmt.Errorf("no corresponding match found for %s", channelID.Mention())
-			}
-			return fmt.Errorf("error getting match for %s: %w", channelID.Mention(), err)
+			return err
 		}
 		now := time.Now()
 
@@ -206,17 +189,17 @@ func (b *Bot) commandNotificationsAdd(ctx context.Context, data cmdroute.Command
 			return err
 		}
 
-		n, err := q.CountNotifications(ctx, channelIDStr)
+		n, err := q.CountNotifications(ctx, match.MatchID)
 		if err != nil {
 			return fmt.Errorf("error counting notifications for %s: %w", channelID.Mention(), err)
 		}
 
 		if n >= MaxConcurrentNotifications {
-			return fmt.Errorf("maximum number of concurrent notifications (%d) reached for %s", MaxConcurrentNotifications, channelID.Mention())
+			return i18n.Errorf("error.notification_limit", MaxConcurrentNotifications, channelID.Mention())
 		}
 
 		err = q.AddNotification(ctx, sqlc.AddNotificationParams{
-			ChannelID:  channelID.String(),
+			MatchID:    match.MatchID,
 			NotifyAt:   notifyAt.Unix(),
 			CustomText: customText,
 		})
@@ -227,7 +210,7 @@ func (b *Bot) commandNotificationsAdd(ctx context.Context, data cmdroute.Command
 		return b.refreshJobSchedules(ctx, q)
 	})
 	if err != nil {
-		return errorResponse(err)
+		return errorResponse(ctx, err)
 	}
 
 	return &api.InteractionResponseData{
@@ -9,33 +9,26 @@ import (
 	"github.com/jxs13/league-discord-bot/sqlc"
 )
 
-func (b *Bot) deleteOphanedMatches(ctx context.Context, q *sqlc.Queries, channelIDs ...string) (err error) {
-	if len(channelIDs) == 0 {
+// archiveOrphanedMatches detaches the matches from their channels, which do not exist anymore.
+// The matches are kept together with their teams and results, but their channel is never created again.
+func (b *Bot) archiveOrphanedMatches(ctx context.Context, q *sqlc.Queries, matchIDs ...int64) (err error) {
+	if len(matchIDs) == 0 {
 		return nil
 	}
 
-	log.Printf("deleting %d orphaned matches", len(channelIDs))
+	log.Printf("archiving %d orphaned matches", len(matchIDs))
 	defer func() {
 		if err != nil {
-			err = fmt.Errorf("failed to delete %d orphaned matches: %w", len(channelIDs), err)
+			err = fmt.Errorf("failed to archive %d orphaned matches: %w", len(matchIDs), err)
 		} else {
-			log.Printf("deleted %d orphaned matches", len(channelIDs))
+			log.Printf("archived %d orphaned matches", len(matchIDs))
 		}
 	}()
 
-	if len(channelIDs) == 1 {
-		// delete single match
-		err = q.DeleteMatch(ctx, channelIDs[0])
-		if err != nil {
-			return err
-		}
-		return b.refreshJobSchedules(ctx, q)
-	}
-
-	slices.Sort(channelIDs)
-	channelIDs = slices.Compact(channelIDs)
+	slices.Sort(matchIDs)
+	matchIDs = slices.Compact(matchIDs)
 
-	err = q.DeleteMatchList(ctx, channelIDs)
+	err = q.ArchiveMatchList(ctx, matchIDs)
 	if err != nil {
 		return err
 	}
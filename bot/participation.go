@@ -6,26 +6,457 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/diamondburned/arikawa/v3/api"
+	"github.com/diamondburned/arikawa/v3/api/cmdroute"
 	"github.com/diamondburned/arikawa/v3/discord"
 	"github.com/diamondburned/arikawa/v3/gateway"
+	"github.com/diamondburned/arikawa/v3/utils/json/option"
+	"github.com/jxs13/league-discord-bot/internal/i18n"
 	"github.com/jxs13/league-discord-bot/internal/parse"
-	"github.com/jxs13/league-discord-bot/internal/sliceutils"
 	"github.com/jxs13/league-discord-bot/sqlc"
 )
 
+const (
+	ComponentParticipationJoin  = "participation-join"
+	ComponentParticipationLeave = "participation-leave"
+)
+
+func participationComponents(lang i18n.Language) discord.ContainerComponents {
+	return discord.ContainerComponents{
+		&discord.ActionRowComponent{
+			&discord.ButtonComponent{
+				Style:    discord.SuccessButtonStyle(),
+				CustomID: ComponentParticipationJoin,
+				Label:    i18n.T(lang, "participation.join"),
+			},
+			&discord.ButtonComponent{
+				Style:    discord.SecondaryButtonStyle(),
+				CustomID: ComponentParticipationLeave,
+				Label:    i18n.T(lang, "participation.leave"),
+			},
+		},
+	}
+}
+
+func (b *Bot) buttonJoinParticipation(ctx context.Context, data cmdroute.ComponentData) *api.InteractionResponse {
+	var (
+		channelID = data.Event.ChannelID
+		userID    = data.Event.SenderID()
+		resp      *api.InteractionResponseData
+	)
+
+	err := b.TxQueries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		req, err := b.openParticipationRequirements(ctx, q, data.Event)
+		if err != nil {
+			return err
+		}
+
+		p, err := q.GetParticipant(ctx, sqlc.GetParticipantParams{
+			MatchID: req.MatchID,
+			UserID:  userID.String(),
+		})
+		if err == nil {
+			return i18n.Errorf("error.participation_already_joined", p.RoleID)
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("error getting participant: %w", err)
+		}
+
+		team, err := participationTeamOfMember(ctx, q, req.MatchID, data.Event.Member)
+		if err != nil {
+			return err
+		}
+
+		roleID, err := parse.RoleID(team.RoleID)
+		if err != nil {
+			return err
+		}
+
+		substitute, err := b.joinLineup(ctx, q, req, team.RoleID, userID, false, time.Now())
+		if err != nil {
+			return err
+		}
+
+		err = b.editMatchMessage(ctx, q, req.MatchID)
+		if err != nil {
+			return err
+		}
+
+		var content string
+		if substitute {
+			cnt, err := q.CountTeamSubstitutes(ctx, sqlc.CountTeamSubstitutesParams{
+				MatchID: req.MatchID,
+				RoleID:  team.RoleID,
+			})
+			if err != nil {
+				return fmt.Errorf("error counting team substitutes: %w", err)
+			}
+
+			log.Printf("user %s joined the substitutes of team %s in match %s", userID, roleID, channelID)
+			content = i18n.T(
+				i18n.FromContext(ctx),
+				"participation.joined_substitutes",
+				roleID.Mention(),
+				cnt,
+			)
+		} else {
+			cnt, err := q.CountTeamStarters(ctx, sqlc.CountTeamStartersParams{
+				MatchID: req.MatchID,
+				RoleID:  team.RoleID,
+			})
+			if err != nil {
+				return fmt.Errorf("error counting team starters: %w", err)
+			}
+
+			log.Printf("user %s joined the lineup of team %s in match %s", userID, roleID, channelID)
+			content = i18n.T(
+				i18n.FromContext(ctx),
+				"participation.joined_lineup",
+				roleID.Mention(),
+				cnt,
+				req.ParticipantsPerTeam,
+			)
+		}
+
+		resp = &api.InteractionResponseData{
+			Content:         option.NewNullableString(content),
+			Flags:           discord.EphemeralMessage,
+			AllowedMentions: &api.AllowedMentions{ /* none */ },
+		}
+		return nil
+	})
+	if err != nil {
+		resp = errorResponse(ctx, err)
+	}
+
+	return &api.InteractionResponse{
+		Type: api.MessageInteractionWithSource,
+		Data: resp,
+	}
+}
+
+func (b *Bot) buttonLeaveParticipation(ctx context.Context, data cmdroute.ComponentData) *api.InteractionResponse {
+	var (
+		channelID = data.Event.ChannelID
+		userID    = data.Event.SenderID()
+		resp      *api.InteractionResponseData
+	)
+
+	var promotion *substitutePromotion
+	err := b.TxQueries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		req, err := b.openParticipationRequirements(ctx, q, data.Event)
+		if err != nil {
+			return err
+		}
+
+		p, err := q.GetParticipant(ctx, sqlc.GetParticipantParams{
+			MatchID: req.MatchID,
+			UserID:  userID.String(),
+		})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return i18n.Errorf("error.participation_not_joined")
+			}
+			return fmt.Errorf("error getting participant: %w", err)
+		}
+
+		promotion, err = b.leaveLineup(ctx, q, p)
+		if err != nil {
+			return err
+		}
+
+		err = b.editMatchMessage(ctx, q, req.MatchID)
+		if err != nil {
+			return err
+		}
+
+		list, key := "lineup", "participation.left_lineup"
+		if int64ToBool(p.Substitute) {
+			list, key = "substitutes", "participation.left_substitutes"
+		}
+
+		log.Printf("user %s left the %s of team %s in match %s", userID, list, p.RoleID, channelID)
+		resp = &api.InteractionResponseData{
+			Content:         option.NewNullableString(i18n.T(i18n.FromContext(ctx), key, p.RoleID)),
+			Flags:           discord.EphemeralMessage,
+			AllowedMentions: &api.AllowedMentions{ /* none */ },
+		}
+		return nil
+	})
+	if err != nil {
+		resp = errorResponse(ctx, err)
+	} else {
+		b.announcePromotion(promotion)
+	}
+
+	return &api.InteractionResponse{
+		Type: api.MessageInteractionWithSource,
+		Data: resp,
+	}
+}
+
+// openParticipationRequirements returns the participation requirements of the match of the interaction's channel
+// in case that the participation entry is still open.
+func (b *Bot) openParticipationRequirements(ctx context.Context, q *sqlc.Queries, e *discord.InteractionEvent) (sqlc.ParticipationRequirement, error) {
+	err := b.checkGuildEnabled(ctx, q, e.GuildID)
+	if err != nil {
+		return sqlc.ParticipationRequirement{}, err
+	}
+
+	req, err := q.GetParticipationRequirementsByChannel(ctx, e.ChannelID.String())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return sqlc.ParticipationRequirement{}, i18n.Errorf("error.participation_not_required")
+		}
+		return sqlc.ParticipationRequirement{}, fmt.Errorf("error getting participation requirements: %w", err)
+	}
+
+	if int64ToBool(req.EntryClosed) {
+		return sqlc.ParticipationRequirement{}, i18n.Errorf("error.participation_closed")
+	}
+	return req, nil
+}
+
+// participationTeamOfMember returns the match team of the given member.
+func participationTeamOfMember(ctx context.Context, q *sqlc.Queries, matchID int64, member *discord.Member) (sqlc.Team, error) {
+	if member == nil {
+		return sqlc.Team{}, ErrAccessForbidden
+	}
+
+	rids := make([]string, 0, len(member.RoleIDs))
+	for _, rid := range member.RoleIDs {
+		rids = append(rids, rid.String())
+	}
+
+	teams, err := q.GetMatchTeamByRoles(ctx, sqlc.GetMatchTeamByRolesParams{
+		MatchID: matchID,
+		RoleIds: rids,
+	})
+	if err != nil {
+		return sqlc.Team{}, fmt.Errorf("error getting match teams: %w", err)
+	}
+
+	switch len(teams) {
+	case 0:
+		return sqlc.Team{}, i18n.Errorf("error.participation_not_team_member")
+	case 1:
+		return teams[0], nil
+	default:
+		return sqlc.Team{}, i18n.Errorf("error.participation_multiple_teams")
+	}
+}
+
+// joinLineup adds the user to the lineup of the team. In case that the lineup is already full,
+// the user is added to the end of the team's substitutes instead.
+// reaction marks participants which joined via the legacy participation reaction.
+func (b *Bot) joinLineup(ctx context.Context, q *sqlc.Queries, req sqlc.ParticipationRequirement, roleIDStr string, userID discord.UserID, reaction bool, now time.Time) (substitute bool, err error) {
+	cnt, err := q.CountTeamStarters(ctx, sqlc.CountTeamStartersParams{
+		MatchID: req.MatchID,
+		RoleID:  roleIDStr,
+	})
+	if err != nil {
+		return false, fmt.Errorf("error counting team starters: %w", err)
+	}
+	substitute = cnt >= req.ParticipantsPerTeam
+
+	err = q.AddParticipant(ctx, sqlc.AddParticipantParams{
+		MatchID:    req.MatchID,
+		RoleID:     roleIDStr,
+		UserID:     userID.String(),
+		JoinedAt:   now.Unix(),
+		Reaction:   boolToInt64(reaction),
+		Substitute: boolToInt64(substitute),
+	})
+	if err != nil {
+		return false, fmt.Errorf("error adding participant: %w", err)
+	}
+
+	if substitute {
+		// substitutes do not count towards the confirmed participants
+		return true, nil
+	}
+
+	err = q.IncreaseMatchTeamConfirmedParticipants(ctx, sqlc.IncreaseMatchTeamConfirmedParticipantsParams{
+		MatchID: req.MatchID,
+		RoleID:  roleIDStr,
+	})
+	if err != nil {
+		return false, fmt.Errorf("error increasing match team confirmed participants for match %d: %w", req.MatchID, err)
+	}
+	return false, nil
+}
+
+// substitutePromotion is a substitute who was moved into the lineup of their team.
+// It is announced in the match channel after the promoting transaction was committed.
+type substitutePromotion struct {
+	ChannelID discord.ChannelID
+	UserID    discord.UserID
+	RoleID    discord.RoleID
+	Language  i18n.Language
+}
+
+// leaveLineup removes the participant from the lineup or the substitutes of their team.
+// In case that a starter leaves, the first substitute of the team is promoted to the lineup.
+// The returned promotion must be announced with announcePromotion after the transaction was committed.
+func (b *Bot) leaveLineup(ctx context.Context, q *sqlc.Queries, p sqlc.Participant) (*substitutePromotion, error) {
+	err := q.RemoveParticipant(ctx, sqlc.RemoveParticipantParams{
+		MatchID: p.MatchID,
+		UserID:  p.UserID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error removing participant: %w", err)
+	}
+
+	if int64ToBool(p.Substitute) {
+		// substitutes do not count towards the confirmed participants
+		return nil, nil
+	}
+
+	err = q.DecreaseMatchTeamConfirmedParticipants(ctx, sqlc.DecreaseMatchTeamConfirmedParticipantsParams{
+		MatchID: p.MatchID,
+		RoleID:  p.RoleID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error decreasing match team confirmed participants for match %d: %w", p.MatchID, err)
+	}
+
+	return promoteSubstitute(ctx, q, p.MatchID, p.RoleID)
+}
+
+// promoteSubstitute moves the first substitute of the team into the lineup.
+// It returns nil in case that there is no substitute or the match channel was not created yet.
+func promoteSubstitute(ctx context.Context, q *sqlc.Queries, matchID int64, roleIDStr string) (*substitutePromotion, error) {
+	sub, err := q.GetFirstTeamSubstitute(ctx, sqlc.GetFirstTeamSubstituteParams{
+		MatchID: matchID,
+		RoleID:  roleIDStr,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// no substitutes available
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting first team substitute: %w", err)
+	}
+
+	err = q.PromoteSubstitute(ctx, sqlc.PromoteSubstituteParams{
+		MatchID: sub.MatchID,
+		UserID:  sub.UserID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error promoting substitute: %w", err)
+	}
+
+	err = q.IncreaseMatchTeamConfirmedParticipants(ctx, sqlc.IncreaseMatchTeamConfirmedParticipantsParams{
+		MatchID: sub.MatchID,
+		RoleID:  sub.RoleID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error increasing match team confirmed participants for match %d: %w", sub.MatchID, err)
+	}
+
+	userID, err := parse.UserID(sub.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	roleID, err := parse.RoleID(sub.RoleID)
+	if err != nil {
+		return nil, err
+	}
+
+	match, err := q.GetMatch(ctx, sub.MatchID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting match: %w", err)
+	}
+
+	log.Printf("promoted substitute %s to the lineup of team %s in match %d", userID, roleID, match.Number)
+	if match.ChannelID == "" {
+		// the substitute is notified by the match message once the channel is created
+		return nil, nil
+	}
+
+	channelID, err := parse.ChannelID(match.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	lang, err := guildLanguage(ctx, q, match.GuildID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &substitutePromotion{
+		ChannelID: channelID,
+		UserID:    userID,
+		RoleID:    roleID,
+		Language:  lang,
+	}, nil
+}
+
+// announcePromotion notifies a promoted substitute in the match channel.
+// It is called after the promotion was committed, so that nobody is pinged for a rolled back promotion.
+func (b *Bot) announcePromotion(p *substitutePromotion) {
+	if p == nil {
+		return
+	}
+
+	_, err := b.state.SendMessageComplex(p.ChannelID, api.SendMessageData{
+		Content: i18n.T(
+			p.Language,
+			"participation.promoted",
+			p.UserID.Mention(),
+			p.RoleID.Mention(),
+		),
+		AllowedMentions: &api.AllowedMentions{
+			Users: []discord.UserID{p.UserID},
+		},
+	})
+	if err != nil {
+		log.Printf("error sending substitute promotion message to %s in %s: %v", p.UserID, p.ChannelID, err)
+	}
+}
+
+// listLineups returns the starters and the substitutes of all match teams in the order in which they joined.
+func listLineups(ctx context.Context, q *sqlc.Queries, matchID int64) (starters, substitutes map[discord.RoleID][]discord.UserID, err error) {
+	participants, err := q.ListParticipants(ctx, matchID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error listing participants: %w", err)
+	}
+
+	starters = make(map[discord.RoleID][]discord.UserID)
+	substitutes = make(map[discord.RoleID][]discord.UserID)
+	for _, p := range participants {
+		rid, err := parse.RoleID(p.RoleID)
+		if err != nil {
+			return nil, nil, err
+		}
+		uid, err := parse.UserID(p.UserID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if int64ToBool(p.Substitute) {
+			substitutes[rid] = append(substitutes[rid], uid)
+		} else {
+			starters[rid] = append(starters[rid], uid)
+		}
+	}
+	return starters, substitutes, nil
+}
+
+// handleAddParticipationReaction handles the participation reactions of match messages
+// which were created before the participation buttons were introduced.
 func (b *Bot) handleAddParticipationReaction(e *gateway.MessageReactionAddEvent) {
 	if b.isMe(e.UserID) || e.Emoji.Name != ReactionEmoji || e.Member == nil {
 		return
 	}
 
 	var (
-		channelID   = e.ChannelID.String()
-		userRoleIDs = e.Member.RoleIDs
+		channelID = e.ChannelID
 	)
 
 	err := b.TxQueries(b.ctx, func(ctx context.Context, q *sqlc.Queries) error {
-		req, err := q.GetParticipationRequirements(ctx, channelID)
+		req, err := q.GetParticipationRequirementsByChannel(ctx, channelID.String())
 		if err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
 				// no match found, ignore
@@ -43,25 +474,20 @@ func (b *Bot) handleAddParticipationReaction(e *gateway.MessageReactionAddEvent)
 			return nil
 		}
 
-		rids := make([]string, 0, len(userRoleIDs))
-		for _, rid := range userRoleIDs {
-			rids = append(rids, rid.String())
+		_, err = q.GetParticipant(ctx, sqlc.GetParticipantParams{
+			MatchID: req.MatchID,
+			UserID:  e.UserID.String(),
+		})
+		if err == nil {
+			// already part of a lineup
+			return nil
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("error getting participant: %w", err)
 		}
 
-		teams, err := q.GetMatchTeamByRoles(ctx, sqlc.GetMatchTeamByRolesParams{
-			ChannelID: channelID,
-			RoleIds:   rids,
-		})
+		team, err := participationTeamOfMember(ctx, q, req.MatchID, e.Member)
 		if err != nil {
-			if errors.Is(err, sql.ErrNoRows) {
-				// no match found, ignore
-				return nil
-			}
-			return fmt.Errorf("error getting match for channel %s: %w", channelID, err)
-		} else if len(teams) == 0 {
-			return nil
-		} else if len(teams) > 1 {
-			// removing emoji reacion, because the user has both teams as roles
+			// removing emoji reacion, because the user is in none or in multiple teams of the match
 			err = b.state.DeleteUserReaction(e.ChannelID, e.MessageID, e.UserID, ReactionEmoji)
 			if err != nil {
 				return fmt.Errorf("error removing reaction %s from message %s: %w", ReactionEmoji, e.MessageID, err)
@@ -69,18 +495,14 @@ func (b *Bot) handleAddParticipationReaction(e *gateway.MessageReactionAddEvent)
 			return nil
 		}
 
-		// has exactly one team associated with the user in the match
-		team := teams[0]
+		_, err = b.joinLineup(ctx, q, req, team.RoleID, e.UserID, true, time.Now())
+		if err != nil {
+			return err
+		}
 
-		// found match, add user to match
-		err = q.IncreaseMatchTeamConfirmedParticipants(
-			ctx,
-			sqlc.IncreaseMatchTeamConfirmedParticipantsParams{
-				ChannelID: team.ChannelID,
-				RoleID:    team.RoleID,
-			})
+		err = b.editMatchMessage(ctx, q, req.MatchID)
 		if err != nil {
-			return fmt.Errorf("error increasing match team confirmed participants for channel %s: %w", channelID, err)
+			return err
 		}
 		log.Printf("added user %s to match %s", e.Member.User.Username, channelID)
 		return nil
@@ -90,83 +512,61 @@ func (b *Bot) handleAddParticipationReaction(e *gateway.MessageReactionAddEvent)
 	}
 }
 
+// handleRemoveParticipationReaction handles the participation reactions of match messages
+// which were created before the participation buttons were introduced.
 func (b *Bot) handleRemoveParticipationReaction(e *gateway.MessageReactionRemoveEvent) {
 	if b.isMe(e.UserID) || e.Emoji.Name != ReactionEmoji {
 		return
 	}
 
 	var (
-		guildID   = e.GuildID
 		channelID = e.ChannelID
 		userID    = e.UserID
 	)
 
-	member, err := b.state.Member(guildID, userID)
-	if err != nil {
-		log.Println(fmt.Errorf("error getting member %s: %w", userID, err))
-		return
-	}
-	userRoleIDs := member.RoleIDs
-
-	rids := make([]string, 0, len(userRoleIDs))
-	for _, rid := range userRoleIDs {
-		rids = append(rids, rid.String())
-	}
-
-	err = b.TxQueries(b.ctx, func(ctx context.Context, q *sqlc.Queries) error {
-
-		teams, err := q.GetMatchTeamByRoles(ctx, sqlc.GetMatchTeamByRolesParams{
-			ChannelID: channelID.String(),
-			RoleIds:   rids,
-		})
+	var promotion *substitutePromotion
+	err := b.TxQueries(b.ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		req, err := q.GetParticipationRequirementsByChannel(ctx, channelID.String())
 		if err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
 				// no match found, ignore
 				return nil
 			}
-			return fmt.Errorf("error getting match for channel %s: %v", channelID, err)
-		} else if len(teams) == 0 {
-			// no match team found
-			return nil
+			return fmt.Errorf("error getting match for channel %s: %w", channelID, err)
 		}
 
-		var teamRoleID string
-		if len(teams) == 1 {
-			teamRoleID = teams[0].RoleID
-		} else if len(teams) > 1 {
+		if int64ToBool(req.EntryClosed) {
+			// the lineups are final
+			return nil
+		}
 
-			teamRoleIDs := make([]discord.RoleID, 0, len(teams))
-			for _, team := range teams {
-				roleID, err := parse.RoleID(team.RoleID)
-				if err != nil {
-					return fmt.Errorf("error parsing role ID %s: %v", team.RoleID, err)
-				}
-				teamRoleIDs = append(teamRoleIDs, roleID)
-			}
-			// we cannot recreate a user's reaction, which is why we need to try to guess as best as we can, where
-			// to remove the user from.
-			// this case should not happen, because we try to prevent the user from creating reactions when he has both teams assigned.
-			roleID, ok := sliceutils.ContainsOne(userRoleIDs, teamRoleIDs...)
-			if !ok {
-				return fmt.Errorf("invalid state, user does not have role ids, even tho he should have them: expected to have one of %v, but has %v", teamRoleIDs, userRoleIDs)
+		p, err := q.GetParticipant(ctx, sqlc.GetParticipantParams{
+			MatchID: req.MatchID,
+			UserID:  userID.String(),
+		})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				// not part of any lineup
+				return nil
 			}
-			teamRoleID = roleID.String()
+			return fmt.Errorf("error getting participant: %w", err)
 		}
 
-		// found match, remove user from match
-		err = q.DecreaseMatchTeamConfirmedParticipants(
-			ctx,
-			sqlc.DecreaseMatchTeamConfirmedParticipantsParams{
-				ChannelID: channelID.String(),
-				RoleID:    teamRoleID,
-			})
+		promotion, err = b.leaveLineup(ctx, q, p)
+		if err != nil {
+			return err
+		}
+
+		err = b.editMatchMessage(ctx, q, req.MatchID)
 		if err != nil {
-			return fmt.Errorf("error decreasing match team confirmed participants for channel %s: %v", channelID, err)
+			return err
 		}
-		log.Printf("removed user %s from match %s", member.User.Username, channelID)
+		log.Printf("removed user %s from match %s", userID, channelID)
 		return nil
 	})
 	if err != nil {
 		log.Println(err)
+		return
 	}
+	b.announcePromotion(promotion)
 }
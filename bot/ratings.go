@@ -0,0 +1,221 @@
+package bot
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/diamondburned/arikawa/v3/api"
+	"github.com/diamondburned/arikawa/v3/api/cmdroute"
+	"github.com/diamondburned/arikawa/v3/discord"
+	"github.com/diamondburned/arikawa/v3/utils/json/option"
+	"github.com/jxs13/league-discord-bot/internal/format"
+	"github.com/jxs13/league-discord-bot/internal/i18n"
+	"github.com/jxs13/league-discord-bot/internal/options"
+	"github.com/jxs13/league-discord-bot/internal/parse"
+	"github.com/jxs13/league-discord-bot/internal/rating"
+	"github.com/jxs13/league-discord-bot/sqlc"
+)
+
+// ratingTrendLength is the number of most recent rating changes that are shown for a team.
+const ratingTrendLength = 10
+
+func (b *Bot) commandRating(ctx context.Context, data cmdroute.CommandData) (resp *api.InteractionResponseData) {
+	var (
+		guildID    = data.Event.GuildID
+		guildIDStr = guildID.String()
+	)
+
+	var content string
+	err := b.TxQueries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		err := b.checkAccess(ctx, q, data.Event, READ)
+		if err != nil {
+			return err
+		}
+
+		roleID, ok, err := options.OptionalRoleID("team_role", data.Options)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			content, err = b.formatGuildRatings(ctx, q, guildID)
+			return err
+		}
+
+		r, err := q.GetTeamRating(ctx, sqlc.GetTeamRatingParams{
+			GuildID: guildIDStr,
+			RoleID:  roleID.String(),
+		})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				content = i18n.T(i18n.FromContext(ctx), "rating.unrated", roleID.Mention(), formatRating(rating.DefaultRating))
+				return nil
+			}
+			return fmt.Errorf("error getting team rating: %w", err)
+		}
+
+		history, err := q.ListTeamRatingHistory(ctx, sqlc.ListTeamRatingHistoryParams{
+			GuildID: guildIDStr,
+			RoleID:  roleID.String(),
+			Limit:   ratingTrendLength,
+		})
+		if err != nil {
+			return fmt.Errorf("error listing team rating history: %w", err)
+		}
+
+		var sb strings.Builder
+		sb.WriteString(i18n.T(
+			i18n.FromContext(ctx),
+			"rating.team",
+			roleID.Mention(),
+			format.MarkdownFat(formatRating(r.Rating)),
+			r.Matches,
+		))
+
+		if len(history) > 0 {
+			// history is sorted from the most recent to the oldest change
+			trend := history[0].RatingAfter - history[len(history)-1].RatingBefore
+			sb.WriteString(i18n.T(i18n.FromContext(ctx), "rating.trend", len(history), formatRatingDelta(trend)))
+
+			for _, h := range history {
+				sb.WriteString(fmt.Sprintf(
+					"* %s: %s (%s)\n",
+					format.DiscordLongDate(time.Unix(h.RatedAt, 0)),
+					formatRating(h.RatingAfter),
+					formatRatingDelta(h.RatingAfter-h.RatingBefore),
+				))
+			}
+		}
+
+		content = sb.String()
+		return nil
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return &api.InteractionResponseData{
+		Content:         option.NewNullableString(content),
+		Flags:           discord.EphemeralMessage,
+		AllowedMentions: &api.AllowedMentions{ /* none */ },
+	}
+}
+
+// recomputeGuildRatings replays all final results of a guild.
+// Replaying instead of applying single results keeps ratings correct in case results are corrected later on.
+func (b *Bot) recomputeGuildRatings(ctx context.Context, q *sqlc.Queries, guildID string) error {
+	results, err := q.ListGuildFinalTeamResults(ctx, guildID)
+	if err != nil {
+		return fmt.Errorf("error listing final team results: %w", err)
+	}
+
+	teamResults := make([]rating.TeamResult, 0, len(results))
+	for _, r := range results {
+		teamResults = append(teamResults, rating.TeamResult{
+			MatchID: r.MatchID,
+			TeamID:  r.RoleID,
+			Score:   r.Score,
+			At:      r.ScheduledAt,
+		})
+	}
+
+	ratings, history := rating.Replay(teamResults, rating.DefaultK)
+
+	err = q.DeleteGuildRatingHistory(ctx, guildID)
+	if err != nil {
+		return fmt.Errorf("error deleting rating history: %w", err)
+	}
+
+	err = q.DeleteGuildTeamRatings(ctx, guildID)
+	if err != nil {
+		return fmt.Errorf("error deleting team ratings: %w", err)
+	}
+
+	matches := make(map[string]int64, len(ratings))
+	for _, h := range history {
+		matches[h.TeamID]++
+
+		err = q.AddRatingHistory(ctx, sqlc.AddRatingHistoryParams{
+			GuildID:      guildID,
+			RoleID:       h.TeamID,
+			MatchID:      h.MatchID,
+			RatingBefore: h.Before,
+			RatingAfter:  h.After,
+			RatedAt:      h.At,
+		})
+		if err != nil {
+			return fmt.Errorf("error adding rating history: %w", err)
+		}
+	}
+
+	now := time.Now().Unix()
+	for roleID, r := range ratings {
+		err = q.AddTeamRating(ctx, sqlc.AddTeamRatingParams{
+			GuildID:   guildID,
+			RoleID:    roleID,
+			Rating:    r,
+			Matches:   matches[roleID],
+			UpdatedAt: now,
+		})
+		if err != nil {
+			return fmt.Errorf("error adding team rating: %w", err)
+		}
+	}
+	return nil
+}
+
+func (b *Bot) formatGuildRatings(ctx context.Context, q *sqlc.Queries, guildID discord.GuildID) (string, error) {
+	ratings, err := q.ListGuildTeamRatings(ctx, guildID.String())
+	if err != nil {
+		return "", fmt.Errorf("error listing team ratings: %w", err)
+	}
+
+	if len(ratings) == 0 {
+		return i18n.T(i18n.FromContext(ctx), "rating.none"), nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString(format.MarkdownFat(i18n.T(i18n.FromContext(ctx), "rating.title")))
+	sb.WriteString("\n")
+	for idx, r := range ratings {
+		roleID, err := parse.RoleID(r.RoleID)
+		if err != nil {
+			return "", err
+		}
+
+		line := i18n.T(i18n.FromContext(ctx), "rating.line", idx+1, roleID.Mention(), format.MarkdownFat(formatRating(r.Rating)), r.Matches)
+		// discord messages are limited to 2000 characters
+		if sb.Len()+len(line) > 2000 {
+			break
+		}
+		sb.WriteString(line)
+	}
+	return sb.String(), nil
+}
+
+// teamRatingSuffix returns the rating of a team formatted for announcements, or an empty string for unrated teams.
+func teamRatingSuffix(ctx context.Context, q *sqlc.Queries, guildID string, roleID discord.RoleID) (string, error) {
+	r, err := q.GetTeamRating(ctx, sqlc.GetTeamRatingParams{
+		GuildID: guildID,
+		RoleID:  roleID.String(),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+		return "", fmt.Errorf("error getting team rating: %w", err)
+	}
+	return fmt.Sprintf(" (%s)", formatRating(r.Rating)), nil
+}
+
+func formatRating(r float64) string {
+	return fmt.Sprintf("%.0f", math.Round(r))
+}
+
+func formatRatingDelta(d float64) string {
+	return fmt.Sprintf("%+.0f", math.Round(d))
+}
@@ -0,0 +1,634 @@
+package bot
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/diamondburned/arikawa/v3/api"
+	"github.com/diamondburned/arikawa/v3/api/cmdroute"
+	"github.com/diamondburned/arikawa/v3/discord"
+	"github.com/diamondburned/arikawa/v3/utils/json/option"
+	"github.com/jxs13/league-discord-bot/internal/discordutils"
+	"github.com/jxs13/league-discord-bot/internal/format"
+	"github.com/jxs13/league-discord-bot/internal/i18n"
+	"github.com/jxs13/league-discord-bot/internal/msgtemplate"
+	"github.com/jxs13/league-discord-bot/internal/options"
+	"github.com/jxs13/league-discord-bot/internal/parse"
+	"github.com/jxs13/league-discord-bot/sqlc"
+)
+
+const (
+	ComponentRescheduleAccept = "reschedule-accept"
+	ComponentRescheduleReject = "reschedule-reject"
+)
+
+func rescheduleProposalComponents(lang i18n.Language) discord.ContainerComponents {
+	return discord.ContainerComponents{
+		&discord.ActionRowComponent{
+			&discord.ButtonComponent{
+				Style:    discord.SuccessButtonStyle(),
+				CustomID: ComponentRescheduleAccept,
+				Label:    i18n.T(lang, "reschedule.accept"),
+			},
+			&discord.ButtonComponent{
+				Style:    discord.DangerButtonStyle(),
+				CustomID: ComponentRescheduleReject,
+				Label:    i18n.T(lang, "reschedule.reject"),
+			},
+		},
+	}
+}
+
+func (b *Bot) commandRescheduleMatch(ctx context.Context, data cmdroute.CommandData) (resp *api.InteractionResponseData) {
+	var (
+		now       = time.Now()
+		userIDStr = data.Event.SenderID().String()
+	)
+
+	err := b.TxQueries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		match, err := b.matchOption(ctx, q, data.Event, data.Options)
+		if err != nil {
+			return err
+		}
+
+		// moderators reschedule directly, captains of the match teams propose a new point in time
+		moderator := true
+		err = b.checkMatchModeratorAccess(ctx, q, data.Event, match.MatchID)
+		if errors.Is(err, ErrAccessForbidden) {
+			moderator = false
+			err = b.checkMatchCaptainAccess(ctx, q, data.Event, match.MatchID)
+		}
+		if err != nil {
+			return err
+		}
+
+		mention, err := matchMention(match)
+		if err != nil {
+			return err
+		}
+
+		scheduledAt, err := options.FutureTimeInLocation(
+			"scheduled_at",
+			"location",
+			time.Minute,
+			data.Options,
+		)
+		if err != nil {
+			return err
+		}
+
+		err = checkReschedulable(ctx, q, match, mention, scheduledAt)
+		if err != nil {
+			return err
+		}
+
+		if !moderator {
+			err = b.proposeReschedule(ctx, q, data.Event, match, mention, scheduledAt, now)
+			if err != nil {
+				return err
+			}
+
+			resp = &api.InteractionResponseData{
+				Content: option.NewNullableString(i18n.T(
+					i18n.FromContext(ctx),
+					"reschedule.proposed",
+					mention,
+					format.DiscordLongDateTime(scheduledAt),
+				)),
+				Flags: discord.EphemeralMessage,
+			}
+			return nil
+		}
+
+		// a pending proposal is superseded by the new point in time
+		err = b.discardRescheduleProposal(ctx, q, match.MatchID)
+		if err != nil {
+			return err
+		}
+
+		err = b.rescheduleMatch(ctx, q, match, mention, scheduledAt, userIDStr, now)
+		if err != nil {
+			return err
+		}
+
+		resp = &api.InteractionResponseData{
+			Content: option.NewNullableString(i18n.T(
+				i18n.FromContext(ctx),
+				"reschedule.done",
+				mention,
+				format.DiscordLongDateTime(scheduledAt),
+			)),
+			Flags: discord.EphemeralMessage,
+		}
+		return nil
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return resp
+}
+
+// checkReschedulable returns an error in case that the match cannot be moved to the given point in time.
+func checkReschedulable(ctx context.Context, q *sqlc.Queries, match sqlc.Match, mention string, scheduledAt time.Time) error {
+	if match.CancelledAt != 0 {
+		return i18n.Errorf("error.match_cancelled_reschedule", mention)
+	}
+	if match.ChannelDeleted != 0 {
+		return i18n.Errorf("error.match_archived", mention)
+	}
+	if scheduledAt.Unix() == match.ScheduledAt {
+		return i18n.Errorf("error.match_already_scheduled", mention, format.DiscordLongDateTime(scheduledAt))
+	}
+
+	// final results are recorded with the point in time at which the match took place
+	r, err := q.GetResult(ctx, match.MatchID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("error getting result: %w", err)
+	}
+	if err == nil && r.Status == ResultStatusConfirmed {
+		return i18n.Errorf("error.match_reschedule_result_final", mention)
+	}
+	return nil
+}
+
+// rescheduleMatch moves the match together with its event, notifications and participation deadline to the new point in time.
+func (b *Bot) rescheduleMatch(
+	ctx context.Context,
+	q *sqlc.Queries,
+	match sqlc.Match,
+	mention string,
+	scheduledAt time.Time,
+	userIDStr string,
+	now time.Time,
+) error {
+	nowUnix := now.Unix()
+	previouslyScheduledAt := time.Unix(match.ScheduledAt, 0)
+
+	guildID, err := parse.GuildID(match.GuildID)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := divisionConfig(ctx, q, match.GuildID, match.Division)
+	if err != nil {
+		return err
+	}
+
+	intervals, err := parse.ReminderIntervals(cfg.NotificationOffsets)
+	if err != nil {
+		return err
+	}
+
+	channelAccessibleAt, channelDeleteAt, deadlineAt := matchLifecycle(cfg, scheduledAt, now)
+	if match.ChannelAccessible != 0 {
+		// access was already granted, the channel stays accessible
+		channelAccessibleAt = time.Unix(match.ChannelAccessibleAt, 0)
+	}
+
+	eventID := match.EventID
+	if eventID != "" {
+		eventID, err = b.rescheduleGuildEvent(guildID, eventID, scheduledAt.Unix(), channelDeleteAt.Unix())
+		if err != nil {
+			return err
+		}
+	}
+
+	err = q.RescheduleMatch(ctx, sqlc.RescheduleMatchParams{
+		MatchID:             match.MatchID,
+		ChannelAccessibleAt: channelAccessibleAt.Unix(),
+		ChannelDeleteAt:     max(nowUnix, channelDeleteAt.Unix()),
+		MessageID:           match.MessageID,
+		EventID:             eventID,
+		ScheduledAt:         scheduledAt.Unix(),
+		UpdatedAt:           nowUnix,
+		UpdatedBy:           userIDStr,
+	})
+	if err != nil {
+		return fmt.Errorf("error rescheduling match: %w", err)
+	}
+
+	req, err := q.GetParticipationRequirements(ctx, match.MatchID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("error getting participation requirements: %w", err)
+	} else if err == nil {
+		// a new deadline reopens the participation entry
+		err = q.UpdateParticipationRequirements(ctx, sqlc.UpdateParticipationRequirementsParams{
+			MatchID:             match.MatchID,
+			ParticipantsPerTeam: req.ParticipantsPerTeam,
+			DeadlineAt:          max(nowUnix, deadlineAt.Unix()),
+			EntryClosed:         0,
+		})
+		if err != nil {
+			return fmt.Errorf("error updating participation requirements: %w", err)
+		}
+	}
+
+	// custom notifications are kept, generated ones are recreated for the new point in time
+	err = q.DeleteMatchGeneratedNotifications(ctx, match.MatchID)
+	if err != nil {
+		return fmt.Errorf("error deleting generated notifications: %w", err)
+	}
+
+	err = addGeneratedNotifications(ctx, q, match.MatchID, scheduledAt, intervals, now, userIDStr)
+	if err != nil {
+		return err
+	}
+
+	teamRoleIDs, err := b.listMatchTeamRoleIDs(ctx, q, match.MatchID)
+	if err != nil {
+		return err
+	}
+
+	modUserIDs, err := b.listMatchModeratorUserIDs(ctx, q, match.MatchID)
+	if err != nil {
+		return err
+	}
+
+	streamers, err := b.listMatchStreamerUserIDs(ctx, q, match.MatchID)
+	if err != nil {
+		return err
+	}
+
+	err = b.editMatchMessage(ctx, q, match.MatchID)
+	if err != nil {
+		return err
+	}
+
+	msg, err := formatNotification(
+		ctx,
+		q,
+		match.GuildID,
+		msgtemplate.KindMatchRescheduled,
+		msgtemplate.Data{
+			Channel:               mention,
+			ScheduledAt:           format.DiscordLongDateTime(scheduledAt),
+			PreviouslyScheduledAt: format.DiscordLongDateTime(previouslyScheduledAt),
+		},
+		teamRoleIDs,
+		modUserIDs,
+		streamers,
+		nil,
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	// matches whose channel is not created yet are announced with the new time once it is created
+	if match.ChannelID != "" {
+		channelID, err := parse.ChannelID(match.ChannelID)
+		if err != nil {
+			return err
+		}
+
+		_, err = b.state.SendMessageComplex(channelID, msg)
+		if err != nil {
+			return fmt.Errorf("error sending reschedule notice: %w", err)
+		}
+	}
+
+	err = b.refreshJobSchedules(ctx, q)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("rescheduled match %d in guild %s from %s to %s", match.Number, guildID, previouslyScheduledAt, scheduledAt)
+	return nil
+}
+
+// proposeReschedule asks the captains of the opposing teams to accept the new point in time.
+// A previous proposal of the match is replaced.
+func (b *Bot) proposeReschedule(
+	ctx context.Context,
+	q *sqlc.Queries,
+	e *discord.InteractionEvent,
+	match sqlc.Match,
+	mention string,
+	scheduledAt time.Time,
+	now time.Time,
+) error {
+	userIDStr := e.SenderID().String()
+	roleIDs, err := q.ListMatchCaptainRoles(ctx, sqlc.ListMatchCaptainRolesParams{
+		MatchID: match.MatchID,
+		UserID:  userIDStr,
+	})
+	if err != nil {
+		return fmt.Errorf("error listing captain roles: %w", err)
+	}
+
+	switch len(roleIDs) {
+	case 0:
+		return ErrAccessForbidden
+	case 1:
+	default:
+		return i18n.Errorf("error.reschedule_multiple_teams", mention)
+	}
+
+	roleID, err := parse.RoleID(roleIDs[0])
+	if err != nil {
+		return err
+	}
+
+	captains, err := q.ListMatchTeamCaptains(ctx, match.MatchID)
+	if err != nil {
+		return fmt.Errorf("error listing match team captains: %w", err)
+	}
+
+	userIDs := make([]discord.UserID, 0, len(captains))
+	for _, c := range captains {
+		if c.RoleID == roleIDs[0] {
+			continue
+		}
+
+		userID, err := parse.UserID(c.UserID)
+		if err != nil {
+			return err
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	if len(userIDs) == 0 {
+		// opposing teams without registered captains are represented by the match moderators
+		modUserIDs, err := b.listMatchModeratorUserIDs(ctx, q, match.MatchID)
+		if err != nil {
+			return err
+		}
+		userIDs = modUserIDs
+	}
+
+	mentions := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		mentions = append(mentions, userID.Mention())
+	}
+
+	channelID := e.ChannelID
+	if match.ChannelID != "" {
+		channelID, err = parse.ChannelID(match.ChannelID)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = b.discardRescheduleProposal(ctx, q, match.MatchID)
+	if err != nil {
+		return err
+	}
+
+	lang := i18n.FromContext(ctx)
+	m, err := b.state.SendMessageComplex(channelID, api.SendMessageData{
+		Content: i18n.T(
+			lang,
+			"reschedule.proposal",
+			strings.Join(mentions, " "),
+			roleID.Mention(),
+			mention,
+			format.DiscordLongDateTime(time.Unix(match.ScheduledAt, 0)),
+			format.DiscordLongDateTime(scheduledAt),
+		),
+		Components: rescheduleProposalComponents(lang),
+		AllowedMentions: &api.AllowedMentions{
+			Users: userIDs,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error sending reschedule proposal: %w", err)
+	}
+
+	err = q.AddRescheduleProposal(ctx, sqlc.AddRescheduleProposalParams{
+		MatchID:     match.MatchID,
+		RoleID:      roleIDs[0],
+		ScheduledAt: scheduledAt.Unix(),
+		ChannelID:   channelID.String(),
+		MessageID:   m.ID.String(),
+		ProposedAt:  now.Unix(),
+		ProposedBy:  userIDStr,
+	})
+	if err != nil {
+		return fmt.Errorf("error adding reschedule proposal: %w", err)
+	}
+
+	log.Printf("user %s of team %s proposed to reschedule match %d in guild %s to %s", userIDStr, roleID, match.Number, e.GuildID, scheduledAt)
+	return nil
+}
+
+// discardRescheduleProposal deletes the pending proposal of the match and removes its buttons.
+func (b *Bot) discardRescheduleProposal(ctx context.Context, q *sqlc.Queries, matchID int64) error {
+	proposal, err := q.GetRescheduleProposal(ctx, matchID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return fmt.Errorf("error getting reschedule proposal: %w", err)
+	}
+
+	err = q.DeleteRescheduleProposal(ctx, matchID)
+	if err != nil {
+		return fmt.Errorf("error deleting reschedule proposal: %w", err)
+	}
+
+	channelID, err := parse.ChannelID(proposal.ChannelID)
+	if err != nil {
+		return err
+	}
+	return b.removeMessageComponents(channelID, proposal.MessageID)
+}
+
+// rescheduleProposalOfMessage returns the pending proposal of the button message together with its match.
+func rescheduleProposalOfMessage(ctx context.Context, q *sqlc.Queries, e *discord.InteractionEvent) (sqlc.RescheduleProposal, sqlc.Match, error) {
+	if e.Message == nil {
+		return sqlc.RescheduleProposal{}, sqlc.Match{}, i18n.Errorf("error.reschedule_proposal_not_found")
+	}
+
+	proposal, err := q.GetRescheduleProposalByMessage(ctx, e.Message.ID.String())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return sqlc.RescheduleProposal{}, sqlc.Match{}, i18n.Errorf("error.reschedule_proposal_not_found")
+		}
+		return sqlc.RescheduleProposal{}, sqlc.Match{}, fmt.Errorf("error getting reschedule proposal: %w", err)
+	}
+
+	match, err := q.GetMatch(ctx, proposal.MatchID)
+	if err != nil {
+		return sqlc.RescheduleProposal{}, sqlc.Match{}, fmt.Errorf("error getting match: %w", err)
+	}
+	return proposal, match, nil
+}
+
+func (b *Bot) buttonAcceptReschedule(ctx context.Context, data cmdroute.ComponentData) *api.InteractionResponse {
+	var (
+		now       = time.Now()
+		userID    = data.Event.SenderID()
+		userIDStr = userID.String()
+	)
+
+	var content string
+	err := b.TxQueries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		proposal, match, err := rescheduleProposalOfMessage(ctx, q, data.Event)
+		if err != nil {
+			return err
+		}
+
+		// the proposal must be accepted by the other side
+		err = b.checkMatchModeratorAccess(ctx, q, data.Event, match.MatchID)
+		if errors.Is(err, ErrAccessForbidden) {
+			roleIDs, err := q.ListMatchCaptainRoles(ctx, sqlc.ListMatchCaptainRolesParams{
+				MatchID: match.MatchID,
+				UserID:  userIDStr,
+			})
+			if err != nil {
+				return fmt.Errorf("error listing captain roles: %w", err)
+			}
+
+			if !slices.ContainsFunc(roleIDs, func(roleID string) bool { return roleID != proposal.RoleID }) {
+				if slices.Contains(roleIDs, proposal.RoleID) {
+					return i18n.Errorf("error.reschedule_own_proposal")
+				}
+				return ErrAccessForbidden
+			}
+		} else if err != nil {
+			return err
+		}
+
+		mention, err := matchMention(match)
+		if err != nil {
+			return err
+		}
+
+		scheduledAt := time.Unix(proposal.ScheduledAt, 0)
+		if !scheduledAt.After(now) {
+			return i18n.Errorf("error.reschedule_proposal_expired", format.DiscordLongDateTime(scheduledAt))
+		}
+
+		err = checkReschedulable(ctx, q, match, mention, scheduledAt)
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteRescheduleProposal(ctx, match.MatchID)
+		if err != nil {
+			return fmt.Errorf("error deleting reschedule proposal: %w", err)
+		}
+
+		err = b.rescheduleMatch(ctx, q, match, mention, scheduledAt, userIDStr, now)
+		if err != nil {
+			return err
+		}
+
+		log.Printf("user %s accepted the reschedule proposal of match %d", userIDStr, match.Number)
+		content = i18n.T(
+			i18n.FromContext(ctx),
+			"reschedule.accepted",
+			userID.Mention(),
+			mention,
+			format.DiscordLongDateTime(scheduledAt),
+		)
+		return nil
+	})
+	if err != nil {
+		return &api.InteractionResponse{
+			Type: api.MessageInteractionWithSource,
+			Data: errorResponse(ctx, err),
+		}
+	}
+
+	return &api.InteractionResponse{
+		Type: api.UpdateMessage,
+		Data: &api.InteractionResponseData{
+			Content:         option.NewNullableString(content),
+			Components:      &discord.ContainerComponents{},
+			AllowedMentions: &api.AllowedMentions{ /* none */ },
+		},
+	}
+}
+
+func (b *Bot) buttonRejectReschedule(ctx context.Context, data cmdroute.ComponentData) *api.InteractionResponse {
+	userID := data.Event.SenderID()
+
+	var content string
+	err := b.TxQueries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		proposal, match, err := rescheduleProposalOfMessage(ctx, q, data.Event)
+		if err != nil {
+			return err
+		}
+
+		// the proposing team may withdraw its own proposal
+		err = b.checkMatchModeratorAccess(ctx, q, data.Event, match.MatchID)
+		if errors.Is(err, ErrAccessForbidden) {
+			err = b.checkMatchCaptainAccess(ctx, q, data.Event, match.MatchID)
+		}
+		if err != nil {
+			return err
+		}
+
+		mention, err := matchMention(match)
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteRescheduleProposal(ctx, match.MatchID)
+		if err != nil {
+			return fmt.Errorf("error deleting reschedule proposal: %w", err)
+		}
+
+		log.Printf("user %s rejected the reschedule proposal of match %d", userID, match.Number)
+		content = i18n.T(
+			i18n.FromContext(ctx),
+			"reschedule.rejected",
+			userID.Mention(),
+			mention,
+			format.DiscordLongDateTime(time.Unix(proposal.ScheduledAt, 0)),
+		)
+		return nil
+	})
+	if err != nil {
+		return &api.InteractionResponse{
+			Type: api.MessageInteractionWithSource,
+			Data: errorResponse(ctx, err),
+		}
+	}
+
+	return &api.InteractionResponse{
+		Type: api.UpdateMessage,
+		Data: &api.InteractionResponseData{
+			Content:         option.NewNullableString(content),
+			Components:      &discord.ContainerComponents{},
+			AllowedMentions: &api.AllowedMentions{ /* none */ },
+		},
+	}
+}
+
+// rescheduleGuildEvent moves the scheduled event to the new point in time.
+// In case the event does not exist anymore, an empty event id is returned.
+func (b *Bot) rescheduleGuildEvent(guildID discord.GuildID, eventIDStr string, scheduledAt, deleteAt int64) (string, error) {
+	eventID, err := parse.EventID(eventIDStr)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse event id: %w", err)
+	}
+
+	startsAt, endsAt := guildEventTimes(scheduledAt, deleteAt)
+	startsAtTs := discord.NewTimestamp(startsAt)
+	endsAtTs := discord.NewTimestamp(endsAt)
+
+	const reason = "match was rescheduled"
+	_, err = b.state.EditScheduledEvent(guildID, eventID, reason, api.EditScheduledEventData{
+		StartTime: &startsAtTs,
+		EndTime:   &endsAtTs,
+	})
+	if err != nil {
+		if discordutils.IsStatus4XX(err) {
+			log.Printf("scheduled event %s in guild %s not found, resetting event id: %v", eventID, guildID, err)
+			return "", nil
+		}
+		return "", fmt.Errorf("error rescheduling scheduled event %s in guild %s: %w", eventID, guildID, err)
+	}
+
+	log.Printf("rescheduled event %s in guild %s: starts at %s, ends at %s", eventID, guildID, startsAt.Local(), endsAt.Local())
+	return eventIDStr, nil
+}
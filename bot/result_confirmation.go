@@ -0,0 +1,462 @@
+package bot
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/diamondburned/arikawa/v3/api"
+	"github.com/diamondburned/arikawa/v3/api/cmdroute"
+	"github.com/diamondburned/arikawa/v3/discord"
+	"github.com/diamondburned/arikawa/v3/utils/json/option"
+	"github.com/jxs13/league-discord-bot/internal/discordutils"
+	"github.com/jxs13/league-discord-bot/internal/i18n"
+	"github.com/jxs13/league-discord-bot/internal/msgtemplate"
+	"github.com/jxs13/league-discord-bot/internal/parse"
+	"github.com/jxs13/league-discord-bot/sqlc"
+)
+
+const (
+	ResultStatusPending   = "PENDING"
+	ResultStatusConfirmed = "CONFIRMED"
+	ResultStatusDisputed  = "DISPUTED"
+
+	ComponentResultConfirm = "result-confirm"
+	ComponentResultDispute = "result-dispute"
+)
+
+func resultConfirmationComponents(lang i18n.Language) discord.ContainerComponents {
+	return discord.ContainerComponents{
+		&discord.ActionRowComponent{
+			&discord.ButtonComponent{
+				Style:    discord.SuccessButtonStyle(),
+				CustomID: ComponentResultConfirm,
+				Label:    i18n.T(lang, "result.confirm"),
+			},
+			&discord.ButtonComponent{
+				Style:    discord.DangerButtonStyle(),
+				CustomID: ComponentResultDispute,
+				Label:    i18n.T(lang, "result.dispute"),
+			},
+		},
+	}
+}
+
+func (b *Bot) buttonConfirmResult(ctx context.Context, data cmdroute.ComponentData) *api.InteractionResponse {
+	var (
+		channelID = data.Event.ChannelID
+		nowUnix   = time.Now().Unix()
+		userIDStr = data.Event.SenderID().String()
+		resp      *api.InteractionResponseData
+	)
+
+	err := b.TxQueries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		result, team, err := b.resultTeamOfMember(ctx, q, data.Event)
+		if err != nil {
+			return err
+		}
+
+		switch result.Status {
+		case ResultStatusConfirmed:
+			return i18n.Errorf("error.result_already_final")
+		case ResultStatusDisputed:
+			return i18n.Errorf("error.result_disputed")
+		}
+
+		numTeams, err := b.checkResultComplete(ctx, q, team.MatchID)
+		if err != nil {
+			return err
+		}
+
+		err = q.AddResultConfirmation(ctx, sqlc.AddResultConfirmationParams{
+			MatchID:     result.MatchID,
+			RoleID:      team.RoleID,
+			UserID:      userIDStr,
+			ConfirmedAt: nowUnix,
+		})
+		if err != nil {
+			return fmt.Errorf("error adding result confirmation: %w", err)
+		}
+
+		confirmations, err := q.CountResultConfirmations(ctx, result.MatchID)
+		if err != nil {
+			return fmt.Errorf("error counting result confirmations: %w", err)
+		}
+
+		roleID, err := parse.RoleID(team.RoleID)
+		if err != nil {
+			return err
+		}
+
+		content := i18n.T(i18n.FromContext(ctx), "result.confirmed", roleID.Mention())
+		if confirmations >= int64(numTeams) {
+			lang, err := guildLanguage(ctx, q, data.Event.GuildID.String())
+			if err != nil {
+				return err
+			}
+
+			err = b.finalizeResult(ctx, q, channelID, result, "", i18n.T(lang, "result.final_confirmed"))
+			if err != nil {
+				return err
+			}
+			content += i18n.T(i18n.FromContext(ctx), "result.confirmed_final")
+		}
+
+		log.Printf("user %s confirmed result of match %s for team %s", userIDStr, channelID, roleID)
+		resp = &api.InteractionResponseData{
+			Content:         option.NewNullableString(content),
+			Flags:           discord.EphemeralMessage,
+			AllowedMentions: &api.AllowedMentions{ /* none */ },
+		}
+		return nil
+	})
+	if err != nil {
+		resp = errorResponse(ctx, err)
+	}
+
+	return &api.InteractionResponse{
+		Type: api.MessageInteractionWithSource,
+		Data: resp,
+	}
+}
+
+func (b *Bot) buttonDisputeResult(ctx context.Context, data cmdroute.ComponentData) *api.InteractionResponse {
+	var (
+		channelID = data.Event.ChannelID
+		resp      *api.InteractionResponseData
+	)
+
+	err := b.TxQueries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		result, team, err := b.resultTeamOfMember(ctx, q, data.Event)
+		if err != nil {
+			return err
+		}
+
+		switch result.Status {
+		case ResultStatusConfirmed:
+			return i18n.Errorf("error.result_already_final")
+		case ResultStatusDisputed:
+			return i18n.Errorf("error.result_already_disputed")
+		}
+
+		err = q.UpdateResultStatus(ctx, sqlc.UpdateResultStatusParams{
+			MatchID: result.MatchID,
+			Status:  ResultStatusDisputed,
+		})
+		if err != nil {
+			return fmt.Errorf("error updating result status: %w", err)
+		}
+
+		err = b.removeResultComponents(result)
+		if err != nil {
+			return err
+		}
+
+		roleID, err := parse.RoleID(team.RoleID)
+		if err != nil {
+			return err
+		}
+
+		match, err := q.GetMatch(ctx, result.MatchID)
+		if err != nil {
+			return fmt.Errorf("error getting match: %w", err)
+		}
+
+		mention, err := matchMention(match)
+		if err != nil {
+			return err
+		}
+
+		modUserIDs, err := b.listMatchModeratorUserIDs(ctx, q, team.MatchID)
+		if err != nil {
+			return err
+		}
+
+		msg, err := formatNotification(
+			ctx,
+			q,
+			data.Event.GuildID.String(),
+			msgtemplate.KindResultDisputed,
+			msgtemplate.Data{
+				Channel: mention,
+				Team:    roleID.Mention(),
+			},
+			nil,
+			modUserIDs,
+			nil,
+			nil,
+			nil,
+		)
+		if err != nil {
+			return err
+		}
+
+		_, err = b.state.SendMessageComplex(channelID, msg)
+		if err != nil {
+			return fmt.Errorf("error sending dispute notice: %w", err)
+		}
+
+		log.Printf("user %s disputed result of match %d for team %s", data.Event.SenderID(), match.Number, roleID)
+		resp = &api.InteractionResponseData{
+			Content:         option.NewNullableString(i18n.T(i18n.FromContext(ctx), "result.disputed")),
+			Flags:           discord.EphemeralMessage,
+			AllowedMentions: &api.AllowedMentions{ /* none */ },
+		}
+		return nil
+	})
+	if err != nil {
+		resp = errorResponse(ctx, err)
+	}
+
+	return &api.InteractionResponse{
+		Type: api.MessageInteractionWithSource,
+		Data: resp,
+	}
+}
+
+func (b *Bot) commandFinalizeResult(ctx context.Context, data cmdroute.CommandData) (resp *api.InteractionResponseData) {
+	userID := data.Event.SenderID()
+
+	err := b.TxQueries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		match, err := b.matchOption(ctx, q, data.Event, data.Options)
+		if err != nil {
+			return err
+		}
+
+		err = b.checkMatchModeratorAccess(ctx, q, data.Event, match.MatchID)
+		if err != nil {
+			return err
+		}
+
+		mention, err := matchMention(match)
+		if err != nil {
+			return err
+		}
+
+		// results can still be finalized after the match channel was deleted
+		var channelID discord.ChannelID
+		if match.ChannelID != "" {
+			channelID, err = parse.ChannelID(match.ChannelID)
+			if err != nil {
+				return err
+			}
+		}
+
+		result, err := q.GetResult(ctx, match.MatchID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return i18n.Errorf("error.result_not_reported", mention)
+			}
+			return fmt.Errorf("error getting result: %w", err)
+		}
+
+		if result.Status == ResultStatusConfirmed {
+			return i18n.Errorf("error.result_of_match_already_final", mention)
+		}
+
+		_, err = b.checkResultComplete(ctx, q, match.MatchID)
+		if err != nil {
+			return err
+		}
+
+		lang, err := guildLanguage(ctx, q, match.GuildID)
+		if err != nil {
+			return err
+		}
+
+		err = b.finalizeResult(
+			ctx,
+			q,
+			channelID,
+			result,
+			userID.String(),
+			i18n.T(lang, "result.final_moderator", userID.Mention()),
+		)
+		if err != nil {
+			return err
+		}
+
+		log.Printf("user %s finalized result of match %d (previous status: %s)", userID, match.Number, result.Status)
+		resp = &api.InteractionResponseData{
+			Content: option.NewNullableString(i18n.T(i18n.FromContext(ctx), "result.finalized", mention)),
+			Flags:   discord.EphemeralMessage,
+		}
+		return nil
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return resp
+}
+
+// resultTeamOfMember returns the reported result whose summary message the interaction belongs to
+// and the match team of the interacting member.
+func (b *Bot) resultTeamOfMember(ctx context.Context, q *sqlc.Queries, e *discord.InteractionEvent) (sqlc.Result, sqlc.Team, error) {
+	err := b.checkGuildEnabled(ctx, q, e.GuildID)
+	if err != nil {
+		return sqlc.Result{}, sqlc.Team{}, err
+	}
+
+	// the summary is not necessarily posted in the match channel, e.g. when the match has no channel
+	if e.Message == nil {
+		return sqlc.Result{}, sqlc.Team{}, i18n.Errorf("error.result_not_in_channel")
+	}
+
+	result, err := q.GetResultByMessage(ctx, sqlc.GetResultByMessageParams{
+		MessageChannelID: e.ChannelID.String(),
+		MessageID:        e.Message.ID.String(),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return sqlc.Result{}, sqlc.Team{}, i18n.Errorf("error.result_not_in_channel")
+		}
+		return sqlc.Result{}, sqlc.Team{}, fmt.Errorf("error getting result: %w", err)
+	}
+
+	if e.Member == nil {
+		return sqlc.Result{}, sqlc.Team{}, ErrAccessForbidden
+	}
+
+	rids := make([]string, 0, len(e.Member.RoleIDs))
+	for _, rid := range e.Member.RoleIDs {
+		rids = append(rids, rid.String())
+	}
+
+	teams, err := q.GetMatchTeamByRoles(ctx, sqlc.GetMatchTeamByRolesParams{
+		MatchID: result.MatchID,
+		RoleIds: rids,
+	})
+	if err != nil {
+		return sqlc.Result{}, sqlc.Team{}, fmt.Errorf("error getting match teams: %w", err)
+	}
+
+	switch len(teams) {
+	case 0:
+		return sqlc.Result{}, sqlc.Team{}, i18n.Errorf("error.result_not_team_member")
+	case 1:
+		return result, teams[0], nil
+	default:
+		return sqlc.Result{}, sqlc.Team{}, i18n.Errorf("error.result_multiple_teams")
+	}
+}
+
+// checkResultComplete returns the number of match teams in case that all of them have a reported result.
+func (b *Bot) checkResultComplete(ctx context.Context, q *sqlc.Queries, matchID int64) (int, error) {
+	teams, err := q.ListMatchTeams(ctx, matchID)
+	if err != nil {
+		return 0, fmt.Errorf("error listing match teams: %w", err)
+	}
+
+	results, err := q.ListTeamResults(ctx, matchID)
+	if err != nil {
+		return 0, fmt.Errorf("error listing team results: %w", err)
+	}
+
+	if len(results) < len(teams) {
+		return 0, i18n.Errorf("error.results_incomplete", len(results), len(teams))
+	}
+	return len(teams), nil
+}
+
+// finalizeResult marks the result as final and announces it in the given channel.
+// In case the match has no channel anymore, the result is announced with its summary.
+// Winners of bracket matches move on to their next match.
+func (b *Bot) finalizeResult(ctx context.Context, q *sqlc.Queries, channelID discord.ChannelID, result sqlc.Result, finalizedBy, notice string) error {
+	err := q.UpdateResultStatus(ctx, sqlc.UpdateResultStatusParams{
+		MatchID:     result.MatchID,
+		Status:      ResultStatusConfirmed,
+		FinalizedAt: time.Now().Unix(),
+		FinalizedBy: finalizedBy,
+	})
+	if err != nil {
+		return fmt.Errorf("error updating result status: %w", err)
+	}
+
+	err = b.removeResultComponents(result)
+	if err != nil {
+		return err
+	}
+
+	if !channelID.IsValid() && result.MessageChannelID != "" {
+		channelID, err = parse.ChannelID(result.MessageChannelID)
+		if err != nil {
+			return err
+		}
+	}
+
+	if channelID.IsValid() {
+		_, err = b.state.SendMessageComplex(channelID, api.SendMessageData{
+			Content:         notice,
+			AllowedMentions: &api.AllowedMentions{ /* none */ },
+		})
+		if err != nil && !discordutils.IsStatus4XX(err) {
+			return fmt.Errorf("error sending result notice: %w", err)
+		}
+	}
+
+	err = b.refreshResultStats(ctx, q, result.GuildID)
+	if err != nil {
+		return err
+	}
+
+	return b.advanceBracket(ctx, q, result.MatchID, channelID)
+}
+
+// refreshResultStats recomputes the ratings and updates the standings and the schedule board
+// after a result became final or was reopened.
+func (b *Bot) refreshResultStats(ctx context.Context, q *sqlc.Queries, guildIDStr string) error {
+	err := b.recomputeGuildRatings(ctx, q, guildIDStr)
+	if err != nil {
+		return err
+	}
+
+	guildID, err := parse.GuildID(guildIDStr)
+	if err != nil {
+		return err
+	}
+
+	err = b.refreshStandingsMessage(ctx, q, guildID)
+	if err != nil {
+		return err
+	}
+
+	return b.refreshScheduleBoard(ctx, q, guildID)
+}
+
+// removeResultComponents removes the confirm and dispute buttons from the latest summary of the result.
+func (b *Bot) removeResultComponents(result sqlc.Result) error {
+	if result.MessageChannelID == "" {
+		return nil
+	}
+
+	channelID, err := parse.ChannelID(result.MessageChannelID)
+	if err != nil {
+		return err
+	}
+	return b.removeMessageComponents(channelID, result.MessageID)
+}
+
+// removeMessageComponents removes all buttons from a message, e.g. the confirm and dispute buttons of a result summary.
+// Messages that do not exist anymore are ignored.
+func (b *Bot) removeMessageComponents(channelID discord.ChannelID, messageIDStr string) error {
+	if messageIDStr == "" {
+		return nil
+	}
+
+	messageID, err := parse.MessageID(messageIDStr)
+	if err != nil {
+		return err
+	}
+
+	_, err = b.state.EditMessageComplex(channelID, messageID, api.EditMessageData{
+		Components: &discord.ContainerComponents{},
+	})
+	if err != nil && !discordutils.IsStatus4XX(err) {
+		return fmt.Errorf("error removing buttons from message %s: %w", messageID, err)
+	}
+	return nil
+}
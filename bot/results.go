@@ -0,0 +1,346 @@
+package bot
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/diamondburned/arikawa/v3/api"
+	"github.com/diamondburned/arikawa/v3/api/cmdroute"
+	"github.com/diamondburned/arikawa/v3/discord"
+	"github.com/diamondburned/arikawa/v3/utils/json/option"
+	"github.com/diamondburned/arikawa/v3/utils/sendpart"
+	"github.com/jxs13/league-discord-bot/internal/discordutils"
+	"github.com/jxs13/league-discord-bot/internal/format"
+	"github.com/jxs13/league-discord-bot/internal/i18n"
+	"github.com/jxs13/league-discord-bot/internal/options"
+	"github.com/jxs13/league-discord-bot/internal/parse"
+	"github.com/jxs13/league-discord-bot/sqlc"
+)
+
+// MaxResultAttachmentSize is the maximum size of a screenshot or demo that is stored in the database.
+const MaxResultAttachmentSize = 10 * 1024 * 1024
+
+func (b *Bot) commandReportResult(ctx context.Context, data cmdroute.CommandData) (resp *api.InteractionResponseData) {
+	var (
+		guildIDStr = data.Event.GuildID.String()
+		now        = time.Now()
+		nowUnix    = now.Unix()
+		userIDStr  = data.Event.SenderID().String()
+	)
+
+	// attachments are downloaded before the transaction is opened, as they may take a while
+	screenshot, screenshotName, err := resultAttachment(ctx, "screenshot", data.Data)
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	demo, demoName, err := resultAttachment(ctx, "demo", data.Data)
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	err = b.TxQueries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		match, err := b.matchOption(ctx, q, data.Event, data.Options)
+		if err != nil {
+			return err
+		}
+
+		mention, err := matchMention(match)
+		if err != nil {
+			return err
+		}
+
+		isModerator := true
+		err = b.checkMatchModeratorAccess(ctx, q, data.Event, match.MatchID)
+		if errors.Is(err, ErrAccessForbidden) {
+			// captains may report the results of their own matches
+			isModerator = false
+			err = b.checkMatchCaptainAccess(ctx, q, data.Event, match.MatchID)
+		}
+		if err != nil {
+			return err
+		}
+
+		reopen, _, err := options.BoolOption("reopen", data.Options)
+		if err != nil {
+			return err
+		}
+
+		roleID, err := options.RoleID("team_role", data.Options)
+		if err != nil {
+			return err
+		}
+		roleIDStr := roleID.String()
+
+		if !isModerator {
+			// captains must not report the result of the opposing team
+			err = b.checkTeamCaptainAccess(ctx, q, data.Event, roleIDStr)
+			if errors.Is(err, ErrAccessForbidden) {
+				return i18n.Errorf("error.result_not_own_team", roleID.Mention())
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		score, err := data.Options.Find("score").IntValue()
+		if err != nil {
+			return fmt.Errorf("invalid parameter 'score': %w", err)
+		}
+
+		playTime, err := options.Duration("time", time.Second, 24*time.Hour, data.Options)
+		if err != nil {
+			return err
+		}
+
+		if match.CancelledAt != 0 {
+			return i18n.Errorf("error.match_cancelled_results", mention)
+		}
+
+		scheduledAt := time.Unix(match.ScheduledAt, 0)
+		if now.Before(scheduledAt) {
+			return i18n.Errorf("error.match_not_started", mention, format.DiscordLongDateTime(scheduledAt))
+		}
+
+		_, err = q.GetMatchTeam(ctx, sqlc.GetMatchTeamParams{
+			MatchID: match.MatchID,
+			RoleID:  roleIDStr,
+		})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return i18n.Errorf("error.team_not_in_match", roleID.Mention(), mention)
+			}
+			return fmt.Errorf("error getting match team: %w", err)
+		}
+
+		previous, err := q.GetResult(ctx, match.MatchID)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("error getting result: %w", err)
+		}
+
+		// final results are only changed on purpose, as they already count towards standings, ratings and brackets
+		reopened := err == nil && previous.Status == ResultStatusConfirmed
+		if reopened {
+			if !reopen {
+				return i18n.Errorf("error.result_final_reopen", mention)
+			}
+			if !isModerator {
+				return i18n.Errorf("error.result_reopen_forbidden")
+			}
+
+			err = b.revertBracket(ctx, q, match.MatchID)
+			if err != nil {
+				return err
+			}
+		}
+
+		channelID, channelName, err := b.resultSummaryChannel(match, data.Event.ChannelID)
+		if err != nil {
+			return err
+		}
+
+		// the channel name is kept for the record, as the match outlives its channel
+		err = q.AddResult(ctx, sqlc.AddResultParams{
+			MatchID:     match.MatchID,
+			GuildID:     guildIDStr,
+			ChannelName: channelName,
+			ScheduledAt: match.ScheduledAt,
+			ReportedAt:  nowUnix,
+			ReportedBy:  userIDStr,
+			Division:    match.Division,
+		})
+		if err != nil {
+			return fmt.Errorf("error adding result: %w", err)
+		}
+
+		err = q.AddTeamResult(ctx, sqlc.AddTeamResultParams{
+			MatchID:        match.MatchID,
+			RoleID:         roleIDStr,
+			Score:          score,
+			Time:           int64(playTime / time.Second),
+			Screenshot:     screenshot,
+			ScreenshotName: screenshotName,
+			Demo:           demo,
+			DemoName:       demoName,
+			ReportedAt:     nowUnix,
+			ReportedBy:     userIDStr,
+		})
+		if err != nil {
+			return fmt.Errorf("error adding team result: %w", err)
+		}
+
+		// a changed result must be confirmed again by all teams
+		err = q.DeleteResultConfirmations(ctx, match.MatchID)
+		if err != nil {
+			return fmt.Errorf("error deleting result confirmations: %w", err)
+		}
+
+		if reopened {
+			// the reopened result does not count anymore until it is final again
+			err = b.refreshResultStats(ctx, q, guildIDStr)
+			if err != nil {
+				return err
+			}
+			log.Printf("user %s reopened the final result of match %d", userIDStr, match.Number)
+		}
+
+		teamRoleIDs, err := b.listMatchTeamRoleIDs(ctx, q, match.MatchID)
+		if err != nil {
+			return err
+		}
+
+		results, err := q.ListTeamResults(ctx, match.MatchID)
+		if err != nil {
+			return fmt.Errorf("error listing team results: %w", err)
+		}
+
+		lang, err := guildLanguage(ctx, q, guildIDStr)
+		if err != nil {
+			return err
+		}
+
+		msg := api.SendMessageData{
+			Content: formatResultSummary(lang, data.Event.SenderID(), teamRoleIDs, results),
+			// results are only posted for the record, nobody needs to be notified
+			AllowedMentions: &api.AllowedMentions{
+				Parse: []api.AllowedMentionType{},
+			},
+		}
+		if screenshot != nil {
+			msg.Files = append(msg.Files, sendpart.File{Name: screenshotName, Reader: bytes.NewReader(screenshot)})
+		}
+		if demo != nil {
+			msg.Files = append(msg.Files, sendpart.File{Name: demoName, Reader: bytes.NewReader(demo)})
+		}
+
+		msg.Content += i18n.T(lang, "result.confirmation_hint")
+		msg.Components = resultConfirmationComponents(lang)
+
+		// only the latest result summary can be confirmed or disputed
+		err = b.removeResultComponents(previous)
+		if err != nil {
+			return err
+		}
+
+		m, err := b.state.SendMessageComplex(channelID, msg)
+		if err != nil {
+			return fmt.Errorf("error sending result summary: %w", err)
+		}
+
+		err = q.UpdateResultMessage(ctx, sqlc.UpdateResultMessageParams{
+			MatchID:          match.MatchID,
+			MessageChannelID: channelID.String(),
+			MessageID:        m.ID.String(),
+		})
+		if err != nil {
+			return fmt.Errorf("error updating result message: %w", err)
+		}
+
+		log.Printf("reported result of team %s in match %d: score %d, time %s", roleID, match.Number, score, playTime)
+
+		resp = &api.InteractionResponseData{
+			Content: option.NewNullableString(
+				i18n.T(
+					i18n.FromContext(ctx),
+					"result.reported",
+					roleID.Mention(),
+					mention,
+					score,
+					format.MarkdownInlineCodeBlock(playTime.String()),
+				),
+			),
+			Flags: discord.EphemeralMessage,
+		}
+		return nil
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return resp
+}
+
+// resultSummaryChannel returns the channel in which the result summary of the match is posted and the name
+// of the match channel. Matches without a channel, e.g. because it was deleted, post their summary to the
+// fallback channel, which is the channel in which the result was reported.
+func (b *Bot) resultSummaryChannel(match sqlc.Match, fallbackID discord.ChannelID) (discord.ChannelID, string, error) {
+	if match.ChannelID == "" {
+		return fallbackID, "", nil
+	}
+
+	channelID, err := parse.ChannelID(match.ChannelID)
+	if err != nil {
+		return 0, "", err
+	}
+
+	c, err := b.state.Channel(channelID)
+	if err != nil {
+		if discordutils.IsStatus4XX(err) {
+			// the channel was deleted, but the match was not yet updated
+			return fallbackID, "", nil
+		}
+		return 0, "", fmt.Errorf("error getting channel: %w", err)
+	}
+	return channelID, c.Name, nil
+}
+
+// resultAttachment downloads an optional attachment of the result report.
+// In case the attachment was not provided, nil is returned.
+func resultAttachment(ctx context.Context, name string, data *discord.CommandInteraction) ([]byte, string, error) {
+	a, ok, err := options.OptionalAttachment(name, data)
+	if err != nil {
+		return nil, "", err
+	}
+	if !ok {
+		return nil, "", nil
+	}
+
+	b, err := discordutils.DownloadAttachment(ctx, a, MaxResultAttachmentSize)
+	if err != nil {
+		return nil, "", err
+	}
+	return b, a.Filename, nil
+}
+
+func formatResultSummary(lang i18n.Language, reportedBy discord.UserID, teamRoleIDs []discord.RoleID, results []sqlc.ListTeamResultsRow) string {
+	byRole := make(map[string]sqlc.ListTeamResultsRow, len(results))
+	for _, r := range results {
+		byRole[r.RoleID] = r
+	}
+
+	var sb strings.Builder
+	sb.WriteString(format.MarkdownFat(i18n.T(lang, "result.title")))
+	sb.WriteString(i18n.T(lang, "result.reported_by", reportedBy.Mention()))
+
+	for _, rid := range teamRoleIDs {
+		sb.WriteString(rid.Mention())
+		r, ok := byRole[rid.String()]
+		if !ok {
+			sb.WriteString(i18n.T(lang, "result.not_reported"))
+			continue
+		}
+
+		sb.WriteString(i18n.T(
+			lang,
+			"result.team",
+			format.MarkdownFat(fmt.Sprintf("%d", r.Score)),
+			format.MarkdownInlineCodeBlock((time.Duration(r.Time) * time.Second).String()),
+		))
+		if r.ScreenshotName != "" {
+			sb.WriteString(i18n.T(lang, "result.screenshot"))
+			sb.WriteString(format.MarkdownInlineCodeBlock(r.ScreenshotName))
+		}
+		if r.DemoName != "" {
+			sb.WriteString(i18n.T(lang, "result.demo"))
+			sb.WriteString(format.MarkdownInlineCodeBlock(r.DemoName))
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
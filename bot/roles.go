@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"github.com/diamondburned/arikawa/v3/discord"
+
+	"github.com/jxs13/league-discord-bot/internal/i18n"
 )
 
 func (b *Bot) checkRoleIDs(guildID discord.GuildID, roleIDs ...discord.RoleID) (err error) {
@@ -18,7 +20,7 @@ outer:
 		for _, role := range roles {
 			if role.Name == "@everyone" {
 				if id == role.ID {
-					return fmt.Errorf("invalid role %s", role.Name)
+					return i18n.Errorf("error.role_invalid", role.Name)
 				}
 
 				continue inner
@@ -28,7 +30,7 @@ outer:
 				continue outer
 			}
 		}
-		return fmt.Errorf("role %s not found", discord.Snowflake(id))
+		return i18n.Errorf("error.role_not_found", discord.Snowflake(id))
 	}
 
 	return nil
@@ -56,7 +58,7 @@ outer:
 				continue outer
 			}
 		}
-		return nil, fmt.Errorf("role %s not found", m)
+		return nil, i18n.Errorf("error.role_not_found", m)
 	}
 
 	return result, nil
@@ -78,7 +80,7 @@ outer:
 				continue outer
 			}
 		}
-		return nil, fmt.Errorf("role %s not found", id)
+		return nil, i18n.Errorf("error.role_not_found", id)
 	}
 
 	return result, nil
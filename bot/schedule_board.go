@@ -0,0 +1,362 @@
+package bot
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/diamondburned/arikawa/v3/api"
+	"github.com/diamondburned/arikawa/v3/api/cmdroute"
+	"github.com/diamondburned/arikawa/v3/discord"
+	"github.com/diamondburned/arikawa/v3/utils/json/option"
+	"github.com/jxs13/league-discord-bot/internal/discordutils"
+	"github.com/jxs13/league-discord-bot/internal/i18n"
+	"github.com/jxs13/league-discord-bot/internal/options"
+	"github.com/jxs13/league-discord-bot/internal/parse"
+	"github.com/jxs13/league-discord-bot/sqlc"
+)
+
+const (
+	DefaultScheduleBoardDays = 7
+	MaxScheduleBoardDays     = 60
+
+	// ScheduleBoardRefreshInterval is the interval in which all schedule boards are updated, so that
+	// matches which started in the meantime are removed and matches which entered the time window are added.
+	ScheduleBoardRefreshInterval = 15 * time.Minute
+)
+
+func (b *Bot) commandScheduleBoardEnable(ctx context.Context, data cmdroute.CommandData) (resp *api.InteractionResponseData) {
+	guildIDStr := data.Event.GuildID.String()
+
+	var content string
+	err := b.TxQueries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		err := b.checkAccess(ctx, q, data.Event, WRITE)
+		if err != nil {
+			return err
+		}
+
+		targetChannelID, err := options.ChannelID("schedule_board_channel", data.Options)
+		if err != nil {
+			return err
+		}
+
+		err = b.checkIsGuildChannel(data.Event, targetChannelID)
+		if err != nil {
+			return err
+		}
+
+		days := int64(DefaultScheduleBoardDays)
+		if o := data.Options.Find("days"); o.Type != 0 {
+			days, err = options.MinMaxInteger("days", data.Options, 1, MaxScheduleBoardDays)
+			if err != nil {
+				return err
+			}
+		}
+
+		// replace a previously configured schedule board
+		previous, err := q.GetScheduleBoard(ctx, guildIDStr)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("error getting schedule board: %w", err)
+		} else if err == nil {
+			err = b.deleteScheduleBoard(ctx, q, previous)
+			if err != nil {
+				return err
+			}
+		}
+
+		board := sqlc.ScheduleBoard{
+			GuildID:   guildIDStr,
+			ChannelID: targetChannelID.String(),
+			Days:      days,
+		}
+		err = q.AddScheduleBoard(ctx, sqlc.AddScheduleBoardParams{
+			GuildID:   board.GuildID,
+			ChannelID: board.ChannelID,
+			Days:      board.Days,
+		})
+		if err != nil {
+			return fmt.Errorf("error adding schedule board: %w", err)
+		}
+
+		err = b.updateScheduleBoard(ctx, q, board)
+		if err != nil {
+			return err
+		}
+
+		content = i18n.T(
+			i18n.FromContext(ctx),
+			"board.enabled",
+			days,
+			targetChannelID.Mention(),
+		)
+		return nil
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return &api.InteractionResponseData{
+		Content: option.NewNullableString(content),
+		Flags:   discord.EphemeralMessage,
+	}
+}
+
+func (b *Bot) commandScheduleBoardDisable(ctx context.Context, data cmdroute.CommandData) (resp *api.InteractionResponseData) {
+	guildIDStr := data.Event.GuildID.String()
+
+	var content string
+	err := b.TxQueries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		err := b.checkAccess(ctx, q, data.Event, WRITE)
+		if err != nil {
+			return err
+		}
+
+		board, err := q.GetScheduleBoard(ctx, guildIDStr)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				content = i18n.T(i18n.FromContext(ctx), "board.none")
+				return nil
+			}
+			return fmt.Errorf("error getting schedule board: %w", err)
+		}
+
+		err = b.deleteScheduleBoard(ctx, q, board)
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteScheduleBoard(ctx, guildIDStr)
+		if err != nil {
+			return fmt.Errorf("error deleting schedule board: %w", err)
+		}
+
+		content = i18n.T(i18n.FromContext(ctx), "board.disabled")
+		return nil
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return &api.InteractionResponseData{
+		Content: option.NewNullableString(content),
+		Flags:   discord.EphemeralMessage,
+	}
+}
+
+// asyncRefreshScheduleBoards updates the schedule boards of all guilds.
+func (b *Bot) asyncRefreshScheduleBoards() (err error) {
+	defer func() {
+		if err != nil {
+			log.Printf("error in schedule board routine: %v", err)
+		}
+	}()
+
+	var boards []sqlc.ScheduleBoard
+	err = b.Queries(b.ctx, func(ctx context.Context, q *sqlc.Queries) (err error) {
+		boards, err = q.ListEnabledScheduleBoards(ctx)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("error listing schedule boards: %w", err)
+	}
+
+	for _, board := range boards {
+		// every board is updated on its own, so that a single broken board does not block all others
+		err = b.TxQueries(b.ctx, func(ctx context.Context, q *sqlc.Queries) error {
+			return b.updateScheduleBoard(ctx, q, board)
+		})
+		if err != nil {
+			if discordutils.IsStatus4XX(err) {
+				log.Printf("skipping schedule board of guild %s: %v", board.GuildID, err)
+				continue
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// refreshScheduleBoard updates the schedule board of a guild, in case one is configured.
+// Channels which are not accessible anymore are ignored.
+func (b *Bot) refreshScheduleBoard(ctx context.Context, q *sqlc.Queries, guildID discord.GuildID) error {
+	board, err := q.GetScheduleBoard(ctx, guildID.String())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return fmt.Errorf("error getting schedule board: %w", err)
+	}
+
+	err = b.updateScheduleBoard(ctx, q, board)
+	if err != nil {
+		if discordutils.IsStatus4XX(err) {
+			log.Printf("schedule board channel %s in guild %s not accessible, ignoring: %v", board.ChannelID, guildID, err)
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// updateScheduleBoard edits the messages of the schedule board in place.
+// Messages which were deleted in the meantime are sent again together with all following messages
+// in order to keep their order. Surplus messages of a previously longer board are deleted.
+func (b *Bot) updateScheduleBoard(ctx context.Context, q *sqlc.Queries, board sqlc.ScheduleBoard) error {
+	channelID, err := parse.ChannelID(board.ChannelID)
+	if err != nil {
+		return err
+	}
+
+	msgs, err := b.scheduleBoardMessages(ctx, q, board, time.Now())
+	if err != nil {
+		return err
+	}
+
+	existing, err := q.ListScheduleBoardMessages(ctx, board.GuildID)
+	if err != nil {
+		return fmt.Errorf("error listing schedule board messages: %w", err)
+	}
+
+	for idx, msg := range msgs {
+		if idx < len(existing) {
+			messageID, err := parse.MessageID(existing[idx].MessageID)
+			if err != nil {
+				return err
+			}
+
+			embeds := msg.Embeds
+			if embeds == nil {
+				embeds = []discord.Embed{}
+			}
+			_, err = b.state.EditMessageComplex(channelID, messageID, api.EditMessageData{
+				Content:         option.NewNullableString(msg.Content),
+				Embeds:          &embeds,
+				Flags:           &msg.Flags,
+				AllowedMentions: &api.AllowedMentions{ /* none */ },
+			})
+			if err == nil {
+				continue
+			} else if !discordutils.IsStatus4XX(err) {
+				return fmt.Errorf("error updating schedule board message: %w", err)
+			}
+			log.Printf("schedule board message %s in guild %s not found, sending it and all following messages again: %v", messageID, board.GuildID, err)
+
+			err = b.deleteScheduleBoardMessages(channelID, existing[idx+1:])
+			if err != nil {
+				return err
+			}
+
+			err = q.DeleteScheduleBoardMessagesFrom(ctx, sqlc.DeleteScheduleBoardMessagesFromParams{
+				GuildID:  board.GuildID,
+				Position: int64(idx),
+			})
+			if err != nil {
+				return fmt.Errorf("error deleting schedule board messages: %w", err)
+			}
+			existing = existing[:idx]
+		}
+
+		msg.AllowedMentions = &api.AllowedMentions{ /* none */ }
+		m, err := b.state.SendMessageComplex(channelID, msg)
+		if err != nil {
+			return fmt.Errorf("error sending schedule board message, the bot requires the permission to send messages in %s: %w", channelID.Mention(), err)
+		}
+
+		err = q.AddScheduleBoardMessage(ctx, sqlc.AddScheduleBoardMessageParams{
+			GuildID:   board.GuildID,
+			Position:  int64(idx),
+			MessageID: m.ID.String(),
+		})
+		if err != nil {
+			return fmt.Errorf("error adding schedule board message: %w", err)
+		}
+	}
+
+	if len(existing) > len(msgs) {
+		err = b.deleteScheduleBoardMessages(channelID, existing[len(msgs):])
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteScheduleBoardMessagesFrom(ctx, sqlc.DeleteScheduleBoardMessagesFromParams{
+			GuildID:  board.GuildID,
+			Position: int64(len(msgs)),
+		})
+		if err != nil {
+			return fmt.Errorf("error deleting schedule board messages: %w", err)
+		}
+	}
+	return nil
+}
+
+// scheduleBoardMessages renders the matches within the time window of the board with the announcement formatting.
+func (b *Bot) scheduleBoardMessages(ctx context.Context, q *sqlc.Queries, board sqlc.ScheduleBoard, now time.Time) ([]api.SendMessageData, error) {
+	msgs, ok, err := b.generateGuildAnnouncement(
+		ctx,
+		q,
+		sqlc.Announcement{GuildID: board.GuildID},
+		now,
+		now.Add(time.Duration(board.Days)*24*time.Hour),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return msgs, nil
+	}
+
+	lang, err := guildLanguage(ctx, q, board.GuildID)
+	if err != nil {
+		return nil, err
+	}
+
+	return []api.SendMessageData{{
+		Content: i18n.T(lang, "board.empty", board.Days),
+	}}, nil
+}
+
+// deleteScheduleBoard deletes all messages of the schedule board.
+func (b *Bot) deleteScheduleBoard(ctx context.Context, q *sqlc.Queries, board sqlc.ScheduleBoard) error {
+	channelID, err := parse.ChannelID(board.ChannelID)
+	if err != nil {
+		return err
+	}
+
+	msgs, err := q.ListScheduleBoardMessages(ctx, board.GuildID)
+	if err != nil {
+		return fmt.Errorf("error listing schedule board messages: %w", err)
+	}
+
+	err = b.deleteScheduleBoardMessages(channelID, msgs)
+	if err != nil {
+		return err
+	}
+
+	err = q.DeleteScheduleBoardMessagesFrom(ctx, sqlc.DeleteScheduleBoardMessagesFromParams{
+		GuildID:  board.GuildID,
+		Position: 0,
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting schedule board messages: %w", err)
+	}
+	return nil
+}
+
+// deleteScheduleBoardMessages deletes the given messages, messages that do not exist anymore are ignored.
+func (b *Bot) deleteScheduleBoardMessages(channelID discord.ChannelID, msgs []sqlc.ScheduleBoardMessage) error {
+	for _, m := range msgs {
+		messageID, err := parse.MessageID(m.MessageID)
+		if err != nil {
+			return err
+		}
+
+		err = b.state.DeleteMessage(channelID, messageID, "schedule board was updated")
+		if err != nil && !discordutils.IsStatus4XX(err) {
+			return fmt.Errorf("error deleting schedule board message: %w", err)
+		}
+	}
+	return nil
+}
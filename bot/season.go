@@ -0,0 +1,365 @@
+package bot
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/diamondburned/arikawa/v3/api"
+	"github.com/diamondburned/arikawa/v3/api/cmdroute"
+	"github.com/diamondburned/arikawa/v3/discord"
+	"github.com/diamondburned/arikawa/v3/utils/json/option"
+	"github.com/jxs13/league-discord-bot/internal/format"
+	"github.com/jxs13/league-discord-bot/internal/i18n"
+	"github.com/jxs13/league-discord-bot/internal/options"
+	"github.com/jxs13/league-discord-bot/internal/parse"
+	"github.com/jxs13/league-discord-bot/internal/season"
+	"github.com/jxs13/league-discord-bot/sqlc"
+)
+
+const (
+	MaxSeasonTeams = 32
+
+	ComponentSeasonConfirm = "season-confirm"
+	ComponentSeasonDiscard = "season-discard"
+)
+
+func (b *Bot) commandSeasonGenerate(ctx context.Context, data cmdroute.CommandData) (resp *api.InteractionResponseData) {
+	var (
+		guildID    = data.Event.GuildID
+		guildIDStr = guildID.String()
+		now        = time.Now()
+		userIDStr  = data.Event.SenderID().String()
+	)
+
+	err := b.TxQueries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		err := b.checkAccess(ctx, q, data.Event, WRITE)
+		if err != nil {
+			return err
+		}
+
+		teams, err := parse.RoleMentions(data.Options.Find("teams").String())
+		if err != nil {
+			return fmt.Errorf("invalid parameter 'teams': %w", err)
+		}
+		if len(teams) < 2 || len(teams) > MaxSeasonTeams {
+			return i18n.Errorf("error.season_teams_count", MaxSeasonTeams, len(teams))
+		}
+
+		moderators, err := parse.UserMentions(data.Options.Find("moderators").String())
+		if err != nil {
+			return fmt.Errorf("invalid parameter 'moderators': %w", err)
+		}
+		if len(moderators) == 0 {
+			return i18n.Errorf("error.moderators_required")
+		}
+
+		loc, err := parse.Location(data.Options.Find("location").String())
+		if err != nil {
+			return fmt.Errorf("invalid parameter 'location': %w", err)
+		}
+
+		startDate, err := parse.DateInLocation(data.Options.Find("start_date").String(), loc)
+		if err != nil {
+			return fmt.Errorf("invalid parameter 'start_date': %w", err)
+		}
+
+		slots, err := season.ParseSlots(data.Options.Find("slots").String())
+		if err != nil {
+			return fmt.Errorf("invalid parameter 'slots': %w", err)
+		}
+
+		double, _, err := options.BoolOption("double_round_robin", data.Options)
+		if err != nil {
+			return err
+		}
+
+		participantsPerTeam, _, err := options.OptionalInteger("participants_per_team", data.Options)
+		if err != nil {
+			return err
+		}
+		if participantsPerTeam < 0 {
+			return i18n.Errorf("error.participants_per_team_negative")
+		}
+
+		err = b.checkRoleIDs(guildID, teams...)
+		if err != nil {
+			return err
+		}
+
+		err = b.checkUserIDs(guildID, moderators...)
+		if err != nil {
+			return err
+		}
+
+		rounds := season.RoundRobin(teams, double)
+		numFixtures := 0
+		for _, round := range rounds {
+			numFixtures += len(round)
+		}
+
+		// matches need to be at least a minute in the future, like scheduled matches
+		start := startDate
+		if earliest := now.Add(time.Minute).In(loc); start.Before(earliest) {
+			start = earliest
+		}
+		times := season.Times(slots, start, numFixtures)
+
+		// only a single draft per user is kept
+		err = q.DeleteSeasonDrafts(ctx, sqlc.DeleteSeasonDraftsParams{
+			GuildID:   guildIDStr,
+			CreatedBy: userIDStr,
+		})
+		if err != nil {
+			return fmt.Errorf("error deleting season drafts: %w", err)
+		}
+
+		seasonID, err := q.AddSeason(ctx, sqlc.AddSeasonParams{
+			GuildID:             guildIDStr,
+			ParticipantsPerTeam: participantsPerTeam,
+			CreatedAt:           now.Unix(),
+			CreatedBy:           userIDStr,
+		})
+		if err != nil {
+			return fmt.Errorf("error adding season: %w", err)
+		}
+
+		var preview strings.Builder
+		lang := i18n.FromContext(ctx)
+		preview.WriteString(i18n.T(
+			lang,
+			"season.preview",
+			roundRobinName(lang, double),
+			len(teams),
+			numFixtures,
+		))
+
+		truncated := false
+		idx := 0
+		for r, round := range rounds {
+			if !truncated {
+				preview.WriteString(fmt.Sprintf("\n%s\n", format.MarkdownFat(i18n.T(lang, "season.round", r+1))))
+			}
+
+			for _, pair := range round {
+				scheduledAt := times[idx]
+				moderatorID := moderators[idx%len(moderators)]
+				idx++
+
+				_, err = addFixture(ctx, q, seasonID, int64(r+1), scheduledAt, moderatorID.String(), []string{pair[0].String(), pair[1].String()})
+				if err != nil {
+					return err
+				}
+
+				line := i18n.T(
+					lang,
+					"season.fixture",
+					format.DiscordLongDateTime(scheduledAt),
+					pair[0].Mention(),
+					pair[1].Mention(),
+					moderatorID.Mention(),
+				)
+
+				// discord messages are limited to 2000 characters
+				if truncated || preview.Len()+len(line) > 1800 {
+					truncated = true
+					continue
+				}
+				preview.WriteString(line)
+			}
+		}
+
+		if truncated {
+			preview.WriteString("\n...\n")
+		}
+		preview.WriteString(i18n.T(
+			lang,
+			"season.last_match",
+			format.DiscordLongDateTime(times[len(times)-1]),
+		))
+
+		resp = &api.InteractionResponseData{
+			Content:         option.NewNullableString(preview.String()),
+			Flags:           discord.EphemeralMessage,
+			AllowedMentions: &api.AllowedMentions{ /* none */ },
+			Components: &discord.ContainerComponents{
+				&discord.ActionRowComponent{
+					&discord.ButtonComponent{
+						Style:    discord.SuccessButtonStyle(),
+						CustomID: ComponentSeasonConfirm,
+						Label:    i18n.T(lang, "season.create"),
+					},
+					&discord.ButtonComponent{
+						Style:    discord.SecondaryButtonStyle(),
+						CustomID: ComponentSeasonDiscard,
+						Label:    i18n.T(lang, "season.discard"),
+					},
+				},
+			},
+		}
+		return nil
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return resp
+}
+
+func (b *Bot) buttonSeasonConfirm(ctx context.Context, data cmdroute.ComponentData) *api.InteractionResponse {
+	var (
+		guildIDStr = data.Event.GuildID.String()
+		userIDStr  = data.Event.SenderID().String()
+	)
+
+	var content string
+	err := b.TxQueries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		err := b.checkAccess(ctx, q, data.Event, WRITE)
+		if err != nil {
+			return err
+		}
+
+		draft, err := q.GetSeasonDraft(ctx, sqlc.GetSeasonDraftParams{
+			GuildID:   guildIDStr,
+			CreatedBy: userIDStr,
+		})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return i18n.Errorf("error.season_draft_not_found")
+			}
+			return fmt.Errorf("error getting season draft: %w", err)
+		}
+
+		err = q.ConfirmSeason(ctx, draft.SeasonID)
+		if err != nil {
+			return fmt.Errorf("error confirming season: %w", err)
+		}
+
+		err = b.createFixtureMatches(ctx, q, draft.SeasonID, time.Now())
+		if err != nil {
+			return err
+		}
+
+		n, err := q.CountSeasonFixtures(ctx, draft.SeasonID)
+		if err != nil {
+			return fmt.Errorf("error counting season fixtures: %w", err)
+		}
+
+		err = b.refreshJobSchedules(ctx, q)
+		if err != nil {
+			return err
+		}
+
+		log.Printf("user %s confirmed season %d with %d matches in guild %s", userIDStr, draft.SeasonID, n, guildIDStr)
+		content = i18n.T(i18n.FromContext(ctx), "season.created", n)
+		return nil
+	})
+	if err != nil {
+		return &api.InteractionResponse{
+			Type: api.MessageInteractionWithSource,
+			Data: errorResponse(ctx, err),
+		}
+	}
+
+	return &api.InteractionResponse{
+		Type: api.UpdateMessage,
+		Data: &api.InteractionResponseData{
+			Content:    option.NewNullableString(content),
+			Components: &discord.ContainerComponents{},
+		},
+	}
+}
+
+func (b *Bot) buttonSeasonDiscard(ctx context.Context, data cmdroute.ComponentData) *api.InteractionResponse {
+	var (
+		guildIDStr = data.Event.GuildID.String()
+		userIDStr  = data.Event.SenderID().String()
+	)
+
+	err := b.TxQueries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		err := b.checkAccess(ctx, q, data.Event, WRITE)
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteSeasonDrafts(ctx, sqlc.DeleteSeasonDraftsParams{
+			GuildID:   guildIDStr,
+			CreatedBy: userIDStr,
+		})
+		if err != nil {
+			return fmt.Errorf("error deleting season drafts: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return &api.InteractionResponse{
+			Type: api.MessageInteractionWithSource,
+			Data: errorResponse(ctx, err),
+		}
+	}
+
+	return &api.InteractionResponse{
+		Type: api.UpdateMessage,
+		Data: &api.InteractionResponseData{
+			Content:    option.NewNullableString(i18n.T(i18n.FromContext(ctx), "season.discarded")),
+			Components: &discord.ContainerComponents{},
+		},
+	}
+}
+
+// nextFixtureTime returns the next weekly slot that leaves the teams the whole channel access window of the guild.
+func nextFixtureTime(ctx context.Context, q *sqlc.Queries, guildID, slots, location string, now time.Time) (time.Time, error) {
+	cfg, err := q.GetGuildConfig(ctx, guildID)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("error getting guild config: %w", err)
+	}
+
+	loc, err := parse.Location(location)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	ss, err := season.ParseSlots(slots)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	earliest := now.Add(time.Duration(cfg.ChannelAccessOffset) * time.Second).In(loc)
+	return season.Times(ss, earliest, 1)[0], nil
+}
+
+// addFixture adds a fixture whose match channel is created once its channel access window opens.
+func addFixture(ctx context.Context, q *sqlc.Queries, seasonID, round int64, scheduledAt time.Time, moderatorID string, teams []string) (int64, error) {
+	fixtureID, err := q.AddFixture(ctx, sqlc.AddFixtureParams{
+		SeasonID:    seasonID,
+		Round:       round,
+		ScheduledAt: scheduledAt.Unix(),
+		ModeratorID: moderatorID,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error adding fixture: %w", err)
+	}
+
+	for pos, team := range teams {
+		err = q.AddFixtureTeam(ctx, sqlc.AddFixtureTeamParams{
+			FixtureID: fixtureID,
+			RoleID:    team,
+			Position:  int64(pos),
+		})
+		if err != nil {
+			return 0, fmt.Errorf("error adding fixture team: %w", err)
+		}
+	}
+	return fixtureID, nil
+}
+
+func roundRobinName(lang i18n.Language, double bool) string {
+	if double {
+		return i18n.T(lang, "season.double_round_robin")
+	}
+	return i18n.T(lang, "season.round_robin")
+}
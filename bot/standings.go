@@ -0,0 +1,383 @@
+package bot
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/diamondburned/arikawa/v3/api"
+	"github.com/diamondburned/arikawa/v3/api/cmdroute"
+	"github.com/diamondburned/arikawa/v3/discord"
+	"github.com/diamondburned/arikawa/v3/utils/json/option"
+	"github.com/jxs13/league-discord-bot/internal/discordutils"
+	"github.com/jxs13/league-discord-bot/internal/format"
+	"github.com/jxs13/league-discord-bot/internal/i18n"
+	"github.com/jxs13/league-discord-bot/internal/options"
+	"github.com/jxs13/league-discord-bot/internal/parse"
+	"github.com/jxs13/league-discord-bot/internal/standings"
+	"github.com/jxs13/league-discord-bot/sqlc"
+)
+
+// maxStandingsTeamNameLength keeps the standings table readable on small screens.
+const maxStandingsTeamNameLength = 20
+
+func (b *Bot) commandStandings(ctx context.Context, data cmdroute.CommandData) (resp *api.InteractionResponseData) {
+	guildID := data.Event.GuildID
+
+	var content string
+	err := b.TxQueries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		err := b.checkAccess(ctx, q, data.Event, READ)
+		if err != nil {
+			return err
+		}
+
+		division, err := divisionOption(ctx, q, guildID.String(), divisionNameOptionName, data.Options)
+		if err != nil {
+			return err
+		}
+
+		content, err = b.formatGuildStandings(ctx, q, guildID, division)
+		return err
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return &api.InteractionResponseData{
+		Content:         option.NewNullableString(content),
+		Flags:           discord.EphemeralMessage,
+		AllowedMentions: &api.AllowedMentions{ /* none */ },
+	}
+}
+
+func (b *Bot) commandStandingsEnable(ctx context.Context, data cmdroute.CommandData) (resp *api.InteractionResponseData) {
+	var (
+		guildID    = data.Event.GuildID
+		guildIDStr = guildID.String()
+	)
+
+	err := b.TxQueries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		err := b.checkAccess(ctx, q, data.Event, WRITE)
+		if err != nil {
+			return err
+		}
+
+		targetChannelID, err := options.ChannelID("standings_channel", data.Options)
+		if err != nil {
+			return err
+		}
+
+		err = b.checkIsGuildChannel(data.Event, targetChannelID)
+		if err != nil {
+			return err
+		}
+
+		division, err := divisionOption(ctx, q, guildIDStr, divisionNameOptionName, data.Options)
+		if err != nil {
+			return err
+		}
+
+		// replace a previously configured standings message
+		previous, err := q.GetStandingsMessage(ctx, guildIDStr)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("error getting standings message: %w", err)
+		} else if err == nil {
+			err = b.deleteStandingsMessage(previous)
+			if err != nil {
+				return err
+			}
+		}
+
+		content, err := b.formatGuildStandings(ctx, q, guildID, division)
+		if err != nil {
+			return err
+		}
+
+		m, err := b.state.SendMessageComplex(targetChannelID, api.SendMessageData{
+			Content:         content,
+			AllowedMentions: &api.AllowedMentions{ /* none */ },
+		})
+		if err != nil {
+			return fmt.Errorf("error sending standings message: %w", err)
+		}
+
+		err = b.state.PinMessage(targetChannelID, m.ID, "league standings")
+		if err != nil {
+			return fmt.Errorf("error pinning standings message, the bot requires the permission to manage messages: %w", err)
+		}
+
+		err = q.AddStandingsMessage(ctx, sqlc.AddStandingsMessageParams{
+			GuildID:   guildIDStr,
+			ChannelID: targetChannelID.String(),
+			MessageID: m.ID.String(),
+			Division:  division,
+		})
+		if err != nil {
+			return fmt.Errorf("error adding standings message: %w", err)
+		}
+
+		resp = &api.InteractionResponseData{
+			Content: option.NewNullableString(i18n.T(i18n.FromContext(ctx), "standings.enabled", targetChannelID.Mention())),
+			Flags:   discord.EphemeralMessage,
+		}
+		return nil
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return resp
+}
+
+func (b *Bot) commandStandingsDisable(ctx context.Context, data cmdroute.CommandData) (resp *api.InteractionResponseData) {
+	guildIDStr := data.Event.GuildID.String()
+
+	var content string
+	err := b.TxQueries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		err := b.checkAccess(ctx, q, data.Event, WRITE)
+		if err != nil {
+			return err
+		}
+
+		sm, err := q.GetStandingsMessage(ctx, guildIDStr)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				content = i18n.T(i18n.FromContext(ctx), "standings.none")
+				return nil
+			}
+			return fmt.Errorf("error getting standings message: %w", err)
+		}
+
+		err = b.deleteStandingsMessage(sm)
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteStandingsMessage(ctx, guildIDStr)
+		if err != nil {
+			return fmt.Errorf("error deleting standings message: %w", err)
+		}
+
+		content = i18n.T(i18n.FromContext(ctx), "standings.disabled")
+		return nil
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return &api.InteractionResponseData{
+		Content: option.NewNullableString(content),
+		Flags:   discord.EphemeralMessage,
+	}
+}
+
+// refreshStandingsMessage updates the pinned standings message of a guild, in case one is configured.
+// A standings message that was deleted in the meantime is sent and pinned again.
+func (b *Bot) refreshStandingsMessage(ctx context.Context, q *sqlc.Queries, guildID discord.GuildID) error {
+	sm, err := q.GetStandingsMessage(ctx, guildID.String())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return fmt.Errorf("error getting standings message: %w", err)
+	}
+
+	channelID, err := parse.ChannelID(sm.ChannelID)
+	if err != nil {
+		return err
+	}
+
+	content, err := b.formatGuildStandings(ctx, q, guildID, sm.Division)
+	if err != nil {
+		return err
+	}
+
+	if sm.MessageID != "" {
+		messageID, err := parse.MessageID(sm.MessageID)
+		if err != nil {
+			return err
+		}
+
+		_, err = b.state.EditMessage(channelID, messageID, content)
+		if err == nil {
+			return nil
+		} else if !discordutils.IsStatus4XX(err) {
+			return fmt.Errorf("error updating standings message: %w", err)
+		}
+		log.Printf("standings message %s in guild %s not found, sending a new one: %v", messageID, guildID, err)
+	}
+
+	m, err := b.state.SendMessageComplex(channelID, api.SendMessageData{
+		Content:         content,
+		AllowedMentions: &api.AllowedMentions{ /* none */ },
+	})
+	if err != nil {
+		if discordutils.IsStatus4XX(err) {
+			log.Printf("standings channel %s in guild %s not accessible, ignoring: %v", channelID, guildID, err)
+			return nil
+		}
+		return fmt.Errorf("error sending standings message: %w", err)
+	}
+
+	err = b.state.PinMessage(channelID, m.ID, "league standings")
+	if err != nil {
+		log.Printf("failed to pin standings message %s in guild %s: %v", m.ID, guildID, err)
+	}
+
+	err = q.AddStandingsMessage(ctx, sqlc.AddStandingsMessageParams{
+		GuildID:   sm.GuildID,
+		ChannelID: sm.ChannelID,
+		MessageID: m.ID.String(),
+		Division:  sm.Division,
+	})
+	if err != nil {
+		return fmt.Errorf("error updating standings message: %w", err)
+	}
+	return nil
+}
+
+// deleteStandingsMessage deletes the standings message, messages that do not exist anymore are ignored.
+func (b *Bot) deleteStandingsMessage(sm sqlc.StandingsMessage) error {
+	if sm.MessageID == "" {
+		return nil
+	}
+
+	channelID, err := parse.ChannelID(sm.ChannelID)
+	if err != nil {
+		return err
+	}
+
+	messageID, err := parse.MessageID(sm.MessageID)
+	if err != nil {
+		return err
+	}
+
+	err = b.state.DeleteMessage(channelID, messageID, "standings message was disabled")
+	if err != nil && !discordutils.IsStatus4XX(err) {
+		return fmt.Errorf("error deleting standings message: %w", err)
+	}
+	return nil
+}
+
+func (b *Bot) formatGuildStandings(ctx context.Context, q *sqlc.Queries, guildID discord.GuildID, division string) (string, error) {
+	table, err := guildStandings(ctx, q, guildID, division)
+	if err != nil {
+		return "", err
+	}
+
+	lang, err := guildLanguage(ctx, q, guildID.String())
+	if err != nil {
+		return "", err
+	}
+
+	if len(table) == 0 {
+		return i18n.T(lang, "standings.empty"), nil
+	}
+
+	roles, err := b.state.Roles(guildID)
+	if err != nil {
+		return "", fmt.Errorf("error getting guild roles: %w", err)
+	}
+
+	roleNames := make(map[string]string, len(roles))
+	for _, r := range roles {
+		roleNames[r.ID.String()] = r.Name
+	}
+
+	return formatStandings(lang, table, roleNames), nil
+}
+
+// guildStandings computes the current standings of a guild based on all final results.
+// A non-empty division restricts the standings to the results of the matches of that division.
+func guildStandings(ctx context.Context, q *sqlc.Queries, guildID discord.GuildID, division string) ([]standings.Row, error) {
+	cfg, err := q.GetGuildConfig(ctx, guildID.String())
+	if err != nil {
+		return nil, fmt.Errorf("error getting guild config: %w", err)
+	}
+
+	var results []sqlc.ListGuildFinalTeamResultsRow
+	if division == "" {
+		results, err = q.ListGuildFinalTeamResults(ctx, guildID.String())
+		if err != nil {
+			return nil, fmt.Errorf("error listing final team results: %w", err)
+		}
+	} else {
+		divisionResults, err := q.ListDivisionFinalTeamResults(ctx, sqlc.ListDivisionFinalTeamResultsParams{
+			GuildID:  guildID.String(),
+			Division: division,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error listing final team results of division %s: %w", division, err)
+		}
+
+		results = make([]sqlc.ListGuildFinalTeamResultsRow, 0, len(divisionResults))
+		for _, r := range divisionResults {
+			results = append(results, sqlc.ListGuildFinalTeamResultsRow(r))
+		}
+	}
+
+	teamResults := make([]standings.TeamResult, 0, len(results))
+	for _, r := range results {
+		teamResults = append(teamResults, standings.TeamResult{
+			MatchID: r.MatchID,
+			TeamID:  r.RoleID,
+			Score:   r.Score,
+		})
+	}
+
+	return standings.Compute(teamResults, standings.Points{
+		Win:  cfg.PointsWin,
+		Draw: cfg.PointsDraw,
+		Loss: cfg.PointsLoss,
+	}), nil
+}
+
+func formatStandings(lang i18n.Language, table []standings.Row, roleNames map[string]string) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf(
+		"%-3s %-*s %3s %3s %3s %3s %5s %4s\n",
+		"#",
+		maxStandingsTeamNameLength,
+		i18n.T(lang, "standings.team"),
+		i18n.T(lang, "standings.played"),
+		i18n.T(lang, "standings.wins"),
+		i18n.T(lang, "standings.draws"),
+		i18n.T(lang, "standings.losses"),
+		i18n.T(lang, "standings.difference"),
+		i18n.T(lang, "standings.points"),
+	))
+
+	for idx, row := range table {
+		name, ok := roleNames[row.TeamID]
+		if !ok {
+			name = i18n.T(lang, "standings.deleted_team")
+		}
+		if r := []rune(name); len(r) > maxStandingsTeamNameLength {
+			name = string(r[:maxStandingsTeamNameLength-1]) + "…"
+		}
+
+		line := fmt.Sprintf(
+			"%-3d %-*s %3d %3d %3d %3d %+5d %4d\n",
+			idx+1,
+			maxStandingsTeamNameLength,
+			name,
+			row.Played,
+			row.Wins,
+			row.Draws,
+			row.Losses,
+			row.ScoreDifference(),
+			row.Points,
+		)
+
+		// discord messages are limited to 2000 characters
+		if sb.Len()+len(line) > 1900 {
+			break
+		}
+		sb.WriteString(line)
+	}
+
+	return format.MarkdownFat(i18n.T(lang, "standings.title")) + "\n" + format.MarkdownMultilineCodeBlock("\n"+sb.String())
+}
@@ -10,14 +10,14 @@ import (
 	"github.com/jxs13/league-discord-bot/sqlc"
 )
 
-func (b *Bot) listMatchStreamerUserIDs(ctx context.Context, q *sqlc.Queries, channelID discord.ChannelID) (_ []model.Streamer, err error) {
+func (b *Bot) listMatchStreamerUserIDs(ctx context.Context, q *sqlc.Queries, matchID int64) (_ []model.Streamer, err error) {
 	defer func() {
 		if err != nil {
 			err = fmt.Errorf("error listing match streamers: %w", err)
 		}
 	}()
 
-	streamers, err := q.ListMatchStreamers(ctx, channelID.String())
+	streamers, err := q.ListMatchStreamers(ctx, matchID)
 	if err != nil {
 		return nil, fmt.Errorf("error getting match streamers: %w", err)
 	}
@@ -35,3 +35,18 @@ func (b *Bot) listMatchStreamerUserIDs(ctx context.Context, q *sqlc.Queries, cha
 	}
 	return result, nil
 }
+
+// newStreamers returns the streamers of a match which is not persisted yet.
+func newStreamers(userIDs []discord.UserID, url string) []model.Streamer {
+	result := make([]model.Streamer, 0, len(userIDs))
+	for _, uid := range userIDs {
+		result = append(result, model.Streamer{
+			UserID: uid,
+			Info: sqlc.Streamer{
+				UserID: uid.String(),
+				Url:    url,
+			},
+		})
+	}
+	return result
+}
@@ -0,0 +1,515 @@
+package bot
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/diamondburned/arikawa/v3/api"
+	"github.com/diamondburned/arikawa/v3/api/cmdroute"
+	"github.com/diamondburned/arikawa/v3/discord"
+	"github.com/diamondburned/arikawa/v3/utils/json/option"
+	"github.com/jxs13/league-discord-bot/internal/format"
+	"github.com/jxs13/league-discord-bot/internal/i18n"
+	"github.com/jxs13/league-discord-bot/internal/options"
+	"github.com/jxs13/league-discord-bot/internal/parse"
+	"github.com/jxs13/league-discord-bot/internal/season"
+	"github.com/jxs13/league-discord-bot/internal/swiss"
+	"github.com/jxs13/league-discord-bot/sqlc"
+)
+
+const (
+	MaxSwissTeams      = 128
+	MaxSwissNameLength = 32
+)
+
+func (b *Bot) commandSwissCreate(ctx context.Context, data cmdroute.CommandData) (resp *api.InteractionResponseData) {
+	var (
+		guildID    = data.Event.GuildID
+		guildIDStr = guildID.String()
+		now        = time.Now()
+		userIDStr  = data.Event.SenderID().String()
+	)
+
+	err := b.TxQueries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		err := b.checkAccess(ctx, q, data.Event, WRITE)
+		if err != nil {
+			return err
+		}
+
+		name := strings.TrimSpace(data.Options.Find("name").String())
+		if name == "" || len([]rune(name)) > MaxSwissNameLength {
+			return i18n.Errorf("error.swiss_name_length", MaxSwissNameLength)
+		}
+
+		teams, err := parse.RoleMentions(data.Options.Find("teams").String())
+		if err != nil {
+			return fmt.Errorf("invalid parameter 'teams': %w", err)
+		}
+		if len(teams) < 2 || len(teams) > MaxSwissTeams {
+			return i18n.Errorf("error.swiss_teams_count", MaxSwissTeams, len(teams))
+		}
+
+		loc, err := parse.Location(data.Options.Find("location").String())
+		if err != nil {
+			return fmt.Errorf("invalid parameter 'location': %w", err)
+		}
+
+		slots, err := season.ParseSlots(data.Options.Find("slots").String())
+		if err != nil {
+			return fmt.Errorf("invalid parameter 'slots': %w", err)
+		}
+
+		moderators, err := parse.UserMentions(data.Options.Find("moderators").String())
+		if err != nil {
+			return fmt.Errorf("invalid parameter 'moderators': %w", err)
+		}
+		if len(moderators) == 0 {
+			return i18n.Errorf("error.moderators_required")
+		}
+
+		participantsPerTeam, _, err := options.OptionalInteger("participants_per_team", data.Options)
+		if err != nil {
+			return err
+		}
+		if participantsPerTeam < 0 {
+			return i18n.Errorf("error.participants_per_team_negative")
+		}
+
+		err = b.checkRoleIDs(guildID, teams...)
+		if err != nil {
+			return err
+		}
+
+		err = b.checkUserIDs(guildID, moderators...)
+		if err != nil {
+			return err
+		}
+
+		_, err = q.GetSwissTournamentByName(ctx, sqlc.GetSwissTournamentByNameParams{
+			GuildID: guildIDStr,
+			Name:    name,
+		})
+		if err == nil {
+			return i18n.Errorf("error.swiss_exists", format.MarkdownInlineCodeBlock(name))
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("error getting swiss tournament: %w", err)
+		}
+
+		// swiss rounds are scheduled as season fixtures, whose channels are created once their access window opens
+		seasonID, err := q.AddSeason(ctx, sqlc.AddSeasonParams{
+			GuildID:             guildIDStr,
+			ParticipantsPerTeam: participantsPerTeam,
+			CreatedAt:           now.Unix(),
+			CreatedBy:           userIDStr,
+		})
+		if err != nil {
+			return fmt.Errorf("error adding swiss season: %w", err)
+		}
+
+		err = q.ConfirmSeason(ctx, seasonID)
+		if err != nil {
+			return fmt.Errorf("error confirming swiss season: %w", err)
+		}
+
+		slotNames := make([]string, 0, len(slots))
+		for _, s := range slots {
+			slotNames = append(slotNames, s.String())
+		}
+
+		moderatorIDs := make([]string, 0, len(moderators))
+		for _, uid := range moderators {
+			moderatorIDs = append(moderatorIDs, uid.String())
+		}
+
+		swissID, err := q.AddSwissTournament(ctx, sqlc.AddSwissTournamentParams{
+			GuildID:      guildIDStr,
+			SeasonID:     seasonID,
+			Name:         name,
+			Slots:        strings.Join(slotNames, ", "),
+			Location:     loc.String(),
+			ModeratorIds: strings.Join(moderatorIDs, " "),
+			CreatedAt:    now.Unix(),
+			CreatedBy:    userIDStr,
+		})
+		if err != nil {
+			return fmt.Errorf("error adding swiss tournament: %w", err)
+		}
+
+		for _, rid := range teams {
+			err = q.AddSwissTeam(ctx, sqlc.AddSwissTeamParams{
+				SwissID: swissID,
+				RoleID:  rid.String(),
+			})
+			if err != nil {
+				return fmt.Errorf("error adding swiss team: %w", err)
+			}
+		}
+
+		log.Printf("user %s created swiss tournament %q with %d teams in guild %s", userIDStr, name, len(teams), guildIDStr)
+		resp = &api.InteractionResponseData{
+			Content: option.NewNullableString(i18n.T(
+				i18n.FromContext(ctx),
+				"swiss.created",
+				format.MarkdownInlineCodeBlock(name),
+				len(teams),
+			)),
+			Flags: discord.EphemeralMessage,
+		}
+		return nil
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return resp
+}
+
+func (b *Bot) commandSwissNextRound(ctx context.Context, data cmdroute.CommandData) (resp *api.InteractionResponseData) {
+	now := time.Now()
+
+	err := b.TxQueries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		err := b.checkAccess(ctx, q, data.Event, WRITE)
+		if err != nil {
+			return err
+		}
+
+		st, err := getSwissTournamentByName(ctx, q, data.Event.GuildID, data.Options.Find("swiss_name").String())
+		if err != nil {
+			return err
+		}
+
+		skipUnfinished, _, err := options.BoolOption("skip_unfinished", data.Options)
+		if err != nil {
+			return err
+		}
+
+		teams, matches, byes, err := loadSwissTournament(ctx, q, st)
+		if err != nil {
+			return err
+		}
+
+		unfinished := 0
+		for _, m := range matches {
+			if m.Round == int(st.CurrentRound) && !m.Finished {
+				unfinished++
+			}
+		}
+		if unfinished > 0 && !skipUnfinished {
+			return fmt.Errorf(
+				"%d matches of round %d have no final result yet, finalize them or use the parameter 'skip_unfinished' in order to count them as not played",
+				unfinished,
+				st.CurrentRound,
+			)
+		}
+
+		standings := swiss.Standings(teams, matches, byes)
+		pairs, bye := swiss.Pair(standings, matches, byes)
+
+		moderatorIDs := strings.Fields(st.ModeratorIds)
+		if len(moderatorIDs) == 0 {
+			return fmt.Errorf("swiss tournament %q has no moderators", st.Name)
+		}
+
+		scheduledAt, err := nextFixtureTime(ctx, q, st.GuildID, st.Slots, st.Location, now)
+		if err != nil {
+			return err
+		}
+
+		var (
+			round = st.CurrentRound + 1
+			turn  = st.ModeratorTurn
+			sb    strings.Builder
+		)
+		lang := i18n.FromContext(ctx)
+		sb.WriteString(i18n.T(
+			lang,
+			"swiss.round",
+			format.MarkdownFat(st.Name),
+			round,
+			format.DiscordLongDateTime(scheduledAt),
+		))
+
+		for _, p := range pairs {
+			moderatorID := moderatorIDs[turn%int64(len(moderatorIDs))]
+			turn++
+
+			_, err = addFixture(ctx, q, st.SeasonID, round, scheduledAt, moderatorID, p[:])
+			if err != nil {
+				return err
+			}
+
+			line, err := formatSwissPairing(lang, p, moderatorID)
+			if err != nil {
+				return err
+			}
+
+			// discord messages are limited to 2000 characters
+			if sb.Len()+len(line) <= 1900 {
+				sb.WriteString(line)
+			}
+		}
+
+		if bye != "" {
+			err = q.AddSwissBye(ctx, sqlc.AddSwissByeParams{
+				SwissID: st.SwissID,
+				Round:   round,
+				RoleID:  bye,
+			})
+			if err != nil {
+				return fmt.Errorf("error adding swiss bye: %w", err)
+			}
+
+			rid, err := parse.RoleID(bye)
+			if err != nil {
+				return err
+			}
+			sb.WriteString(i18n.T(lang, "swiss.bye", rid.Mention()))
+		}
+
+		err = q.UpdateSwissTournamentRound(ctx, sqlc.UpdateSwissTournamentRoundParams{
+			SwissID:       st.SwissID,
+			CurrentRound:  round,
+			ModeratorTurn: turn,
+		})
+		if err != nil {
+			return fmt.Errorf("error updating swiss tournament round: %w", err)
+		}
+
+		err = b.createFixtureMatches(ctx, q, st.SeasonID, now)
+		if err != nil {
+			return err
+		}
+
+		err = b.refreshJobSchedules(ctx, q)
+		if err != nil {
+			return err
+		}
+
+		log.Printf("user %s paired round %d of swiss tournament %q in guild %s", data.Event.SenderID(), round, st.Name, st.GuildID)
+		resp = &api.InteractionResponseData{
+			Content:         option.NewNullableString(sb.String()),
+			Flags:           discord.EphemeralMessage,
+			AllowedMentions: &api.AllowedMentions{ /* none */ },
+		}
+		return nil
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return resp
+}
+
+func (b *Bot) commandSwissStandings(ctx context.Context, data cmdroute.CommandData) (resp *api.InteractionResponseData) {
+	guildID := data.Event.GuildID
+
+	var content string
+	err := b.TxQueries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		err := b.checkAccess(ctx, q, data.Event, READ)
+		if err != nil {
+			return err
+		}
+
+		st, err := getSwissTournamentByName(ctx, q, guildID, data.Options.Find("swiss_name").String())
+		if err != nil {
+			return err
+		}
+
+		teams, matches, byes, err := loadSwissTournament(ctx, q, st)
+		if err != nil {
+			return err
+		}
+
+		roles, err := b.state.Roles(guildID)
+		if err != nil {
+			return fmt.Errorf("error getting guild roles: %w", err)
+		}
+
+		roleNames := make(map[string]string, len(roles))
+		for _, r := range roles {
+			roleNames[r.ID.String()] = r.Name
+		}
+
+		content = formatSwissStandings(i18n.FromContext(ctx), st, swiss.Standings(teams, matches, byes), roleNames)
+		return nil
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return &api.InteractionResponseData{
+		Content:         option.NewNullableString(content),
+		Flags:           discord.EphemeralMessage,
+		AllowedMentions: &api.AllowedMentions{ /* none */ },
+	}
+}
+
+func (b *Bot) commandSwissDelete(ctx context.Context, data cmdroute.CommandData) (resp *api.InteractionResponseData) {
+	var content string
+	err := b.TxQueries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		err := b.checkAccess(ctx, q, data.Event, WRITE)
+		if err != nil {
+			return err
+		}
+
+		st, err := getSwissTournamentByName(ctx, q, data.Event.GuildID, data.Options.Find("swiss_name").String())
+		if err != nil {
+			return err
+		}
+
+		// deleting the season removes the tournament and its fixtures, matches whose channel was not created yet are deleted beforehand
+		err = b.deleteSeasonMatchesWithoutChannel(ctx, q, data.Event.GuildID, st.SeasonID)
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteSeason(ctx, st.SeasonID)
+		if err != nil {
+			return fmt.Errorf("error deleting swiss tournament: %w", err)
+		}
+
+		err = b.refreshJobSchedules(ctx, q)
+		if err != nil {
+			return err
+		}
+
+		log.Printf("user %s deleted swiss tournament %q in guild %s", data.Event.SenderID(), st.Name, st.GuildID)
+		content = i18n.T(i18n.FromContext(ctx), "swiss.deleted", format.MarkdownInlineCodeBlock(st.Name))
+		return nil
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return &api.InteractionResponseData{
+		Content: option.NewNullableString(content),
+		Flags:   discord.EphemeralMessage,
+	}
+}
+
+func getSwissTournamentByName(ctx context.Context, q *sqlc.Queries, guildID discord.GuildID, name string) (sqlc.SwissTournament, error) {
+	st, err := q.GetSwissTournamentByName(ctx, sqlc.GetSwissTournamentByNameParams{
+		GuildID: guildID.String(),
+		Name:    strings.TrimSpace(name),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return sqlc.SwissTournament{}, i18n.Errorf("error.swiss_not_found", format.MarkdownInlineCodeBlock(name))
+		}
+		return sqlc.SwissTournament{}, fmt.Errorf("error getting swiss tournament: %w", err)
+	}
+	return st, nil
+}
+
+// loadSwissTournament returns the teams, all matches of previous rounds and all teams that received a bye.
+func loadSwissTournament(ctx context.Context, q *sqlc.Queries, st sqlc.SwissTournament) (teams []string, matches []swiss.Match, byes []string, err error) {
+	teams, err = q.ListSwissTeams(ctx, st.SwissID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error listing swiss teams: %w", err)
+	}
+
+	swissByes, err := q.ListSwissByes(ctx, st.SwissID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error listing swiss byes: %w", err)
+	}
+	for _, bye := range swissByes {
+		byes = append(byes, bye.RoleID)
+	}
+
+	rows, err := q.ListSeasonFixtureResults(ctx, st.SeasonID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error listing swiss results: %w", err)
+	}
+
+	// rows are sorted by fixture and team position
+	byFixture := make(map[int64]int, len(rows)/2)
+	for _, r := range rows {
+		idx, ok := byFixture[r.FixtureID]
+		if !ok {
+			idx = len(matches)
+			byFixture[r.FixtureID] = idx
+			matches = append(matches, swiss.Match{
+				Round:    int(r.Round),
+				Finished: r.Finished != 0,
+			})
+		}
+
+		if r.Position < 0 || r.Position > 1 {
+			continue
+		}
+		matches[idx].Teams[r.Position] = r.RoleID
+		matches[idx].Scores[r.Position] = r.Score
+	}
+	return teams, matches, byes, nil
+}
+
+func formatSwissPairing(lang i18n.Language, p [2]string, moderatorID string) (string, error) {
+	a, err := parse.RoleID(p[0])
+	if err != nil {
+		return "", err
+	}
+	b, err := parse.RoleID(p[1])
+	if err != nil {
+		return "", err
+	}
+	mod, err := parse.UserID(moderatorID)
+	if err != nil {
+		return "", err
+	}
+	return i18n.T(lang, "swiss.pairing", a.Mention(), b.Mention(), mod.Mention()), nil
+}
+
+func formatSwissStandings(lang i18n.Language, st sqlc.SwissTournament, rows []swiss.Row, roleNames map[string]string) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf(
+		"%-3s %-*s %3s %3s %3s %3s %3s %4s %5s\n",
+		"#",
+		maxStandingsTeamNameLength,
+		i18n.T(lang, "standings.team"),
+		i18n.T(lang, "standings.played"),
+		i18n.T(lang, "standings.wins"),
+		i18n.T(lang, "standings.draws"),
+		i18n.T(lang, "standings.losses"),
+		i18n.T(lang, "standings.byes"),
+		i18n.T(lang, "standings.points"),
+		"Buchh",
+	))
+
+	for idx, row := range rows {
+		name, ok := roleNames[row.TeamID]
+		if !ok {
+			name = i18n.T(lang, "standings.deleted_team")
+		}
+		if r := []rune(name); len(r) > maxStandingsTeamNameLength {
+			name = string(r[:maxStandingsTeamNameLength-1]) + "…"
+		}
+
+		line := fmt.Sprintf(
+			"%-3d %-*s %3d %3d %3d %3d %3d %4.1f %5.1f\n",
+			idx+1,
+			maxStandingsTeamNameLength,
+			name,
+			row.Played,
+			row.Wins,
+			row.Draws,
+			row.Losses,
+			row.Byes,
+			row.Points,
+			row.Buchholz,
+		)
+
+		// discord messages are limited to 2000 characters
+		if sb.Len()+len(line) > 1800 {
+			break
+		}
+		sb.WriteString(line)
+	}
+
+	header := i18n.T(lang, "swiss.standings", st.Name, st.CurrentRound)
+	return format.MarkdownFat(header) + "\n" + format.MarkdownMultilineCodeBlock("\n"+sb.String())
+}
@@ -0,0 +1,697 @@
+package bot
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/diamondburned/arikawa/v3/api"
+	"github.com/diamondburned/arikawa/v3/api/cmdroute"
+	"github.com/diamondburned/arikawa/v3/discord"
+	"github.com/diamondburned/arikawa/v3/utils/json/option"
+	"github.com/jxs13/league-discord-bot/internal/format"
+	"github.com/jxs13/league-discord-bot/internal/i18n"
+	"github.com/jxs13/league-discord-bot/internal/options"
+	"github.com/jxs13/league-discord-bot/internal/parse"
+	"github.com/jxs13/league-discord-bot/sqlc"
+)
+
+const (
+	MaxTeamNameLength = 64
+	MaxTeamTagLength  = 8
+
+	// clearValue removes optional team properties like the tag or the logo url
+	clearValue = "-"
+)
+
+func (b *Bot) commandTeamRegister(ctx context.Context, data cmdroute.CommandData) (resp *api.InteractionResponseData) {
+	var (
+		guildID    = data.Event.GuildID
+		guildIDStr = guildID.String()
+		nowUnix    = time.Now().Unix()
+		userIDStr  = data.Event.SenderID().String()
+	)
+
+	var content string
+	err := b.TxQueries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		err := b.checkAccess(ctx, q, data.Event, WRITE)
+		if err != nil {
+			return err
+		}
+
+		roleID, err := options.RoleID("role", data.Options)
+		if err != nil {
+			return err
+		}
+
+		err = b.checkRoleIDs(guildID, roleID)
+		if err != nil {
+			return err
+		}
+
+		name := strings.TrimSpace(data.Options.Find("name").String())
+		if name == "" {
+			return i18n.Errorf("error.team_name_empty")
+		}
+
+		tag := strings.TrimSpace(data.Options.Find("tag").String())
+
+		logoUrl, _, err := options.OptionalUrl("logo_url", data.Options)
+		if err != nil {
+			return err
+		}
+
+		captains, err := parse.UserMentions(data.Options.Find("captains").String())
+		if err != nil {
+			return fmt.Errorf("invalid parameter 'captains': %w", err)
+		}
+
+		err = b.checkUserIDs(guildID, captains...)
+		if err != nil {
+			return err
+		}
+
+		_, err = q.GetRegisteredTeam(ctx, sqlc.GetRegisteredTeamParams{
+			GuildID: guildIDStr,
+			RoleID:  roleID.String(),
+		})
+		if err == nil {
+			return i18n.Errorf("error.team_already_registered", roleID.Mention())
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("failed to get team: %w", err)
+		}
+
+		err = checkTeamNameAvailable(ctx, q, guildIDStr, name, roleID)
+		if err != nil {
+			return err
+		}
+
+		err = q.AddRegisteredTeam(ctx, sqlc.AddRegisteredTeamParams{
+			GuildID:   guildIDStr,
+			RoleID:    roleID.String(),
+			Name:      name,
+			Tag:       tag,
+			LogoUrl:   logoUrl,
+			CreatedAt: nowUnix,
+			CreatedBy: userIDStr,
+			UpdatedAt: nowUnix,
+			UpdatedBy: userIDStr,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to register team: %w", err)
+		}
+
+		for _, uid := range captains {
+			err = q.AddTeamCaptain(ctx, sqlc.AddTeamCaptainParams{
+				GuildID: guildIDStr,
+				RoleID:  roleID.String(),
+				UserID:  uid.String(),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to add team captain: %w", err)
+			}
+		}
+
+		log.Printf("user %s registered team %q (%s) in guild %s", userIDStr, name, roleID, guildIDStr)
+		content = i18n.T(i18n.FromContext(ctx), "team.registered", format.MarkdownFat(name), roleID.Mention())
+		return nil
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return &api.InteractionResponseData{
+		Content:         option.NewNullableString(content),
+		Flags:           discord.EphemeralMessage,
+		AllowedMentions: &api.AllowedMentions{ /* none */ },
+	}
+}
+
+func (b *Bot) commandTeamEdit(ctx context.Context, data cmdroute.CommandData) (resp *api.InteractionResponseData) {
+	var (
+		guildID    = data.Event.GuildID
+		guildIDStr = guildID.String()
+		nowUnix    = time.Now().Unix()
+		userIDStr  = data.Event.SenderID().String()
+	)
+
+	var content string
+	err := b.TxQueries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		err := b.checkAccess(ctx, q, data.Event, WRITE)
+		if err != nil {
+			return err
+		}
+
+		team, err := getRegisteredTeam(ctx, q, guildIDStr, data.Options.Find("team").String())
+		if err != nil {
+			return err
+		}
+		roleID, err := parse.RoleID(team.RoleID)
+		if err != nil {
+			return err
+		}
+
+		if o := data.Options.Find("name"); o.Type != 0 {
+			name := strings.TrimSpace(o.String())
+			if name == "" {
+				return i18n.Errorf("error.team_name_empty")
+			}
+
+			err = checkTeamNameAvailable(ctx, q, guildIDStr, name, roleID)
+			if err != nil {
+				return err
+			}
+			team.Name = name
+		}
+
+		if o := data.Options.Find("tag"); o.Type != 0 {
+			team.Tag = strings.TrimSpace(o.String())
+			if team.Tag == clearValue {
+				team.Tag = ""
+			}
+		}
+
+		if o := data.Options.Find("logo_url"); o.Type != 0 {
+			if o.String() == clearValue {
+				team.LogoUrl = ""
+			} else {
+				team.LogoUrl, _, err = options.OptionalUrl("logo_url", data.Options)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		err = q.UpdateRegisteredTeam(ctx, sqlc.UpdateRegisteredTeamParams{
+			Name:      team.Name,
+			Tag:       team.Tag,
+			LogoUrl:   team.LogoUrl,
+			UpdatedAt: nowUnix,
+			UpdatedBy: userIDStr,
+			GuildID:   guildIDStr,
+			RoleID:    team.RoleID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update team: %w", err)
+		}
+
+		if o := data.Options.Find("captains"); o.Type != 0 {
+			// the given captains replace all previous captains
+			captains, err := parse.UserMentions(o.String())
+			if err != nil {
+				return fmt.Errorf("invalid parameter 'captains': %w", err)
+			}
+
+			err = b.checkUserIDs(guildID, captains...)
+			if err != nil {
+				return err
+			}
+
+			err = q.ResetTeamCaptains(ctx, sqlc.ResetTeamCaptainsParams{
+				GuildID: guildIDStr,
+				RoleID:  team.RoleID,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to reset team captains: %w", err)
+			}
+
+			for _, uid := range captains {
+				err = q.AddTeamCaptain(ctx, sqlc.AddTeamCaptainParams{
+					GuildID: guildIDStr,
+					RoleID:  team.RoleID,
+					UserID:  uid.String(),
+				})
+				if err != nil {
+					return fmt.Errorf("failed to add team captain: %w", err)
+				}
+			}
+		}
+
+		log.Printf("user %s updated team %q (%s) in guild %s", userIDStr, team.Name, team.RoleID, guildIDStr)
+		content = i18n.T(i18n.FromContext(ctx), "team.updated", format.MarkdownFat(team.Name))
+		return nil
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return &api.InteractionResponseData{
+		Content: option.NewNullableString(content),
+		Flags:   discord.EphemeralMessage,
+	}
+}
+
+func (b *Bot) commandTeamDelete(ctx context.Context, data cmdroute.CommandData) (resp *api.InteractionResponseData) {
+	guildIDStr := data.Event.GuildID.String()
+
+	var content string
+	err := b.TxQueries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		err := b.checkAccess(ctx, q, data.Event, WRITE)
+		if err != nil {
+			return err
+		}
+
+		team, err := getRegisteredTeam(ctx, q, guildIDStr, data.Options.Find("team").String())
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteRegisteredTeam(ctx, sqlc.DeleteRegisteredTeamParams{
+			GuildID: guildIDStr,
+			RoleID:  team.RoleID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to delete team: %w", err)
+		}
+
+		log.Printf("user %s deleted team %q (%s) in guild %s", data.Event.SenderID(), team.Name, team.RoleID, guildIDStr)
+		content = i18n.T(i18n.FromContext(ctx), "team.deleted", format.MarkdownFat(team.Name))
+		return nil
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return &api.InteractionResponseData{
+		Content: option.NewNullableString(content),
+		Flags:   discord.EphemeralMessage,
+	}
+}
+
+func (b *Bot) commandTeamShow(ctx context.Context, data cmdroute.CommandData) (resp *api.InteractionResponseData) {
+	guildIDStr := data.Event.GuildID.String()
+
+	var content string
+	err := b.Queries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		err := b.checkAccess(ctx, q, data.Event, READ)
+		if err != nil {
+			return err
+		}
+
+		team, err := getRegisteredTeam(ctx, q, guildIDStr, data.Options.Find("team").String())
+		if err != nil {
+			return err
+		}
+
+		members, err := q.ListTeamMembers(ctx, sqlc.ListTeamMembersParams{
+			GuildID: guildIDStr,
+			RoleID:  team.RoleID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list team members: %w", err)
+		}
+
+		content = formatRegisteredTeam(i18n.FromContext(ctx), team, members)
+		return nil
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return &api.InteractionResponseData{
+		Content:         option.NewNullableString(content),
+		Flags:           discord.EphemeralMessage,
+		AllowedMentions: &api.AllowedMentions{ /* none */ },
+	}
+}
+
+func (b *Bot) commandTeamList(ctx context.Context, data cmdroute.CommandData) (resp *api.InteractionResponseData) {
+	guildIDStr := data.Event.GuildID.String()
+
+	var content string
+	err := b.Queries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		err := b.checkAccess(ctx, q, data.Event, READ)
+		if err != nil {
+			return err
+		}
+
+		teams, err := q.ListRegisteredTeams(ctx, guildIDStr)
+		if err != nil {
+			return fmt.Errorf("failed to list teams: %w", err)
+		}
+
+		if len(teams) == 0 {
+			content = i18n.T(i18n.FromContext(ctx), "team.none")
+			return nil
+		}
+
+		var sb strings.Builder
+		sb.WriteString(format.MarkdownFat(i18n.T(i18n.FromContext(ctx), "team.list")))
+		sb.WriteString("\n")
+		for _, t := range teams {
+			line := fmt.Sprintf("- %s <@&%s>\n", format.MarkdownFat(teamDisplayName(t)), t.RoleID)
+
+			// discord messages are limited to 2000 characters
+			if sb.Len()+len(line) > 1900 {
+				sb.WriteString("...")
+				break
+			}
+			sb.WriteString(line)
+		}
+		content = sb.String()
+		return nil
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return &api.InteractionResponseData{
+		Content:         option.NewNullableString(content),
+		Flags:           discord.EphemeralMessage,
+		AllowedMentions: &api.AllowedMentions{ /* none */ },
+	}
+}
+
+func (b *Bot) commandTeamRosterAdd(ctx context.Context, data cmdroute.CommandData) (resp *api.InteractionResponseData) {
+	var (
+		guildID    = data.Event.GuildID
+		guildIDStr = guildID.String()
+	)
+
+	var content string
+	err := b.TxQueries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		team, err := getRegisteredTeam(ctx, q, guildIDStr, data.Options.Find("team").String())
+		if err != nil {
+			return err
+		}
+
+		err = b.checkTeamCaptainAccess(ctx, q, data.Event, team.RoleID)
+		if err != nil {
+			return err
+		}
+
+		users, err := parse.UserMentions(data.Options.Find("users").String())
+		if err != nil {
+			return fmt.Errorf("invalid parameter 'users': %w", err)
+		}
+		if len(users) == 0 {
+			return i18n.Errorf("error.users_required")
+		}
+
+		err = b.checkUserIDs(guildID, users...)
+		if err != nil {
+			return err
+		}
+
+		for _, uid := range users {
+			err = q.AddTeamMember(ctx, sqlc.AddTeamMemberParams{
+				GuildID: guildIDStr,
+				RoleID:  team.RoleID,
+				UserID:  uid.String(),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to add team member: %w", err)
+			}
+		}
+
+		content = i18n.T(i18n.FromContext(ctx), "team.players_added", len(users), format.MarkdownFat(team.Name))
+		return nil
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return &api.InteractionResponseData{
+		Content: option.NewNullableString(content),
+		Flags:   discord.EphemeralMessage,
+	}
+}
+
+func (b *Bot) commandTeamRosterRemove(ctx context.Context, data cmdroute.CommandData) (resp *api.InteractionResponseData) {
+	guildIDStr := data.Event.GuildID.String()
+
+	var content string
+	err := b.TxQueries(ctx, func(ctx context.Context, q *sqlc.Queries) error {
+		team, err := getRegisteredTeam(ctx, q, guildIDStr, data.Options.Find("team").String())
+		if err != nil {
+			return err
+		}
+
+		err = b.checkTeamCaptainAccess(ctx, q, data.Event, team.RoleID)
+		if err != nil {
+			return err
+		}
+
+		// users may have left the server, which is why they are not validated here
+		users, err := parse.UserMentions(data.Options.Find("users").String())
+		if err != nil {
+			return fmt.Errorf("invalid parameter 'users': %w", err)
+		}
+		if len(users) == 0 {
+			return i18n.Errorf("error.users_required")
+		}
+
+		for _, uid := range users {
+			err = q.RemoveTeamMember(ctx, sqlc.RemoveTeamMemberParams{
+				GuildID: guildIDStr,
+				RoleID:  team.RoleID,
+				UserID:  uid.String(),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to remove team member: %w", err)
+			}
+		}
+
+		content = i18n.T(i18n.FromContext(ctx), "team.players_removed", len(users), format.MarkdownFat(team.Name))
+		return nil
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return &api.InteractionResponseData{
+		Content: option.NewNullableString(content),
+		Flags:   discord.EphemeralMessage,
+	}
+}
+
+// getRegisteredTeam returns the registered team by its name or by its role.
+func getRegisteredTeam(ctx context.Context, q *sqlc.Queries, guildIDStr, value string) (sqlc.RegisteredTeam, error) {
+	value = strings.TrimSpace(value)
+
+	team, err := q.GetRegisteredTeamByName(ctx, sqlc.GetRegisteredTeamByNameParams{
+		GuildID: guildIDStr,
+		Name:    value,
+	})
+	if err == nil {
+		return team, nil
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return sqlc.RegisteredTeam{}, fmt.Errorf("failed to get team: %w", err)
+	}
+
+	roleID, ok := parseRoleValue(value)
+	if ok {
+		team, err = q.GetRegisteredTeam(ctx, sqlc.GetRegisteredTeamParams{
+			GuildID: guildIDStr,
+			RoleID:  roleID.String(),
+		})
+		if err == nil {
+			return team, nil
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return sqlc.RegisteredTeam{}, fmt.Errorf("failed to get team: %w", err)
+		}
+	}
+
+	return sqlc.RegisteredTeam{}, i18n.Errorf("error.team_not_registered", value)
+}
+
+// resolveTeamRoleID resolves the role of a team option, which is either the name of a registered team,
+// a role mention, a role ID or a role name.
+func (b *Bot) resolveTeamRoleID(ctx context.Context, q *sqlc.Queries, guildID discord.GuildID, value string) (discord.RoleID, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, i18n.Errorf("error.team_empty")
+	}
+
+	team, err := q.GetRegisteredTeamByName(ctx, sqlc.GetRegisteredTeamByNameParams{
+		GuildID: guildID.String(),
+		Name:    value,
+	})
+	if err == nil {
+		return parse.RoleID(team.RoleID)
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return 0, fmt.Errorf("failed to get team: %w", err)
+	}
+
+	roleID, ok := parseRoleValue(value)
+	if ok {
+		return roleID, nil
+	}
+
+	roles, err := b.resolveRoles(guildID, []string{value})
+	if err != nil {
+		return 0, i18n.Errorf("error.team_unknown", value)
+	}
+	return roles[0].ID, nil
+}
+
+// parseRoleValue parses a role mention or a role ID.
+func parseRoleValue(value string) (discord.RoleID, bool) {
+	roleIDs, err := parse.RoleMentions(value)
+	if err == nil && len(roleIDs) == 1 {
+		return roleIDs[0], true
+	}
+
+	roleID, err := parse.RoleID(value)
+	if err == nil {
+		return roleID, true
+	}
+	return 0, false
+}
+
+func checkTeamNameAvailable(ctx context.Context, q *sqlc.Queries, guildIDStr, name string, roleID discord.RoleID) error {
+	if len([]rune(name)) > MaxTeamNameLength {
+		return i18n.Errorf("error.team_name_length", MaxTeamNameLength)
+	}
+
+	team, err := q.GetRegisteredTeamByName(ctx, sqlc.GetRegisteredTeamByNameParams{
+		GuildID: guildIDStr,
+		Name:    name,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return fmt.Errorf("failed to get team: %w", err)
+	}
+
+	if team.RoleID != roleID.String() {
+		return i18n.Errorf("error.team_name_used", name, team.RoleID)
+	}
+	return nil
+}
+
+// teamDisplayName returns the name of a registered team prefixed with its tag.
+func teamDisplayName(t sqlc.RegisteredTeam) string {
+	if t.Tag == "" {
+		return t.Name
+	}
+	return fmt.Sprintf("[%s] %s", t.Tag, t.Name)
+}
+
+// listRegisteredTeams returns the registered teams of the given roles.
+// Roles without a registered team are not part of the result.
+func listRegisteredTeams(ctx context.Context, q *sqlc.Queries, guildID discord.GuildID, roleIDs []discord.RoleID) (map[discord.RoleID]sqlc.RegisteredTeam, error) {
+	rids := make([]string, 0, len(roleIDs))
+	for _, rid := range roleIDs {
+		rids = append(rids, rid.String())
+	}
+
+	teams, err := q.ListRegisteredTeamsByRoles(ctx, sqlc.ListRegisteredTeamsByRolesParams{
+		GuildID: guildID.String(),
+		RoleIds: rids,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list registered teams: %w", err)
+	}
+
+	result := make(map[discord.RoleID]sqlc.RegisteredTeam, len(teams))
+	for _, t := range teams {
+		rid, err := parse.RoleID(t.RoleID)
+		if err != nil {
+			return nil, err
+		}
+		result[rid] = t
+	}
+	return result, nil
+}
+
+// teamNames returns the display names of the given team roles.
+// Registered teams use their registered name, all other teams the name of their role.
+func (b *Bot) teamNames(ctx context.Context, q *sqlc.Queries, guildID discord.GuildID, roleIDs []discord.RoleID) (map[discord.RoleID]string, error) {
+	registered, err := listRegisteredTeams(ctx, q, guildID, roleIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	unregistered := make([]discord.RoleID, 0, len(roleIDs))
+	for _, rid := range roleIDs {
+		if _, ok := registered[rid]; !ok {
+			unregistered = append(unregistered, rid)
+		}
+	}
+
+	roleMap := map[discord.RoleID]discord.Role{}
+	if len(unregistered) > 0 {
+		roleMap, err = b.resolveRoleIDs(guildID, unregistered)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result := make(map[discord.RoleID]string, len(roleIDs))
+	for _, rid := range roleIDs {
+		if t, ok := registered[rid]; ok {
+			result[rid] = teamDisplayName(t)
+		} else {
+			result[rid] = roleMap[rid].Name
+		}
+	}
+	return result, nil
+}
+
+// teamMentions returns the role mentions of the given teams.
+// Registered teams are followed by their registered name.
+func teamMentions(ctx context.Context, q *sqlc.Queries, guildID discord.GuildID, roleIDs []discord.RoleID) ([]string, error) {
+	registered, err := listRegisteredTeams(ctx, q, guildID, roleIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]string, 0, len(roleIDs))
+	for _, rid := range roleIDs {
+		if t, ok := registered[rid]; ok {
+			result = append(result, fmt.Sprintf("%s (%s)", rid.Mention(), format.MarkdownFat(teamDisplayName(t))))
+		} else {
+			result = append(result, rid.Mention())
+		}
+	}
+	return result, nil
+}
+
+func formatRegisteredTeam(lang i18n.Language, t sqlc.RegisteredTeam, members []sqlc.TeamMember) string {
+	var sb strings.Builder
+	sb.WriteString(format.MarkdownFat(teamDisplayName(t)))
+	sb.WriteString("\n")
+	sb.WriteString(i18n.T(lang, "team.role", t.RoleID))
+	if t.LogoUrl != "" {
+		sb.WriteString(i18n.T(lang, "team.logo", t.LogoUrl))
+	}
+
+	captains := make([]string, 0, len(members))
+	roster := make([]string, 0, len(members))
+	for _, m := range members {
+		if int64ToBool(m.Captain) {
+			captains = append(captains, fmt.Sprintf("<@%s>", m.UserID))
+		}
+		roster = append(roster, fmt.Sprintf("<@%s>", m.UserID))
+	}
+
+	if len(captains) == 1 {
+		sb.WriteString(i18n.T(lang, "team.captain"))
+	} else {
+		sb.WriteString(i18n.T(lang, "team.captains"))
+	}
+	if len(captains) == 0 {
+		sb.WriteString(i18n.T(lang, "team.captains_none"))
+	} else {
+		sb.WriteString(strings.Join(captains, ", "))
+	}
+	sb.WriteString("\n")
+
+	sb.WriteString(i18n.T(lang, "team.roster", len(roster)))
+	for _, m := range roster {
+		line := "\n- " + m
+		// discord messages are limited to 2000 characters
+		if sb.Len()+len(line) > 1900 {
+			sb.WriteString("\n...")
+			break
+		}
+		sb.WriteString(line)
+	}
+	return sb.String()
+}
@@ -9,14 +9,14 @@ import (
 	"github.com/jxs13/league-discord-bot/sqlc"
 )
 
-func (b *Bot) listMatchTeamRoleIDs(ctx context.Context, q *sqlc.Queries, channelID discord.ChannelID) (_ []discord.RoleID, err error) {
+func (b *Bot) listMatchTeamRoleIDs(ctx context.Context, q *sqlc.Queries, matchID int64) (_ []discord.RoleID, err error) {
 	defer func() {
 		if err != nil {
 			err = fmt.Errorf("error listing match teams: %w", err)
 		}
 	}()
 	// we need to give access to the corresponding teams
-	teams, err := q.ListMatchTeams(ctx, channelID.String())
+	teams, err := q.ListMatchTeams(ctx, matchID)
 	if err != nil {
 		return nil, fmt.Errorf("error getting match teams: %w", err)
 	}
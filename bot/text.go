@@ -6,6 +6,7 @@ import (
 
 	"github.com/diamondburned/arikawa/v3/api"
 	"github.com/diamondburned/arikawa/v3/discord"
+	"github.com/jxs13/league-discord-bot/internal/i18n"
 	"github.com/jxs13/league-discord-bot/internal/model"
 )
 
@@ -94,14 +95,14 @@ func FormatAnnouncementText(prefix string,
 	return sb.String()
 }
 
-func FormatNotification(
-	prefix string,
-	suffix string,
+// FormatRoster formats the teams with their participants, the moderators and the streamers of a match.
+func FormatRoster(
+	lang i18n.Language,
 	teamRoleIDs []discord.RoleID,
 	modUserIDs []discord.UserID,
 	streamers []model.Streamer,
 	participants map[discord.RoleID][]discord.UserID,
-) api.SendMessageData {
+) string {
 
 	numParticipants := 0
 	for _, members := range participants {
@@ -110,23 +111,16 @@ func FormatNotification(
 
 	const idlen = 20
 	var sb strings.Builder
-	sb.Grow((3+idlen)*(len(teamRoleIDs)+len(modUserIDs)+len(streamers)+numParticipants) + len(prefix) + len(suffix))
-
-	sb.WriteString(prefix)
-	sb.WriteString("\n")
-
-	roleIDs := make([]discord.RoleID, 0, len(teamRoleIDs))
-	userIDs := make([]discord.UserID, 0, len(modUserIDs)+len(streamers)+numParticipants)
+	sb.Grow((3 + idlen) * (len(teamRoleIDs) + len(modUserIDs) + len(streamers) + numParticipants))
 
 	if len(teamRoleIDs) > 0 {
-		sb.WriteString("Teams:")
+		sb.WriteString(i18n.T(lang, "roster.teams"))
 
 		if numParticipants > 0 {
 			sb.WriteString("\n")
 		}
 
 		for _, rid := range teamRoleIDs {
-			roleIDs = append(roleIDs, rid)
 			sb.WriteString(" ")
 			sb.WriteString(rid.Mention())
 
@@ -138,8 +132,6 @@ func FormatNotification(
 			if ok {
 				sb.WriteString("\n")
 				for _, uid := range members {
-					userIDs = append(userIDs, uid)
-
 					sb.WriteString(uid.Mention())
 					sb.WriteString("\n")
 				}
@@ -152,13 +144,12 @@ func FormatNotification(
 
 	if len(modUserIDs) > 0 {
 		if len(modUserIDs) > 1 {
-			sb.WriteString("Moderators: ")
+			sb.WriteString(i18n.T(lang, "roster.moderators"))
 		} else {
-			sb.WriteString("Moderator: ")
+			sb.WriteString(i18n.T(lang, "roster.moderator"))
 		}
 
 		for idx, uid := range modUserIDs {
-			userIDs = append(userIDs, uid)
 			sb.WriteString(uid.Mention())
 			if idx < len(modUserIDs)-1 {
 				sb.WriteString(", ")
@@ -169,13 +160,11 @@ func FormatNotification(
 
 	if len(streamers) > 0 {
 		if len(streamers) > 1 {
-			sb.WriteString("Streamers: \n")
+			sb.WriteString(i18n.T(lang, "roster.streamers"))
 		} else {
-			sb.WriteString("Streamer: ")
+			sb.WriteString(i18n.T(lang, "roster.streamer"))
 		}
 		for idx, s := range streamers {
-			userIDs = append(userIDs, s.UserID)
-
 			sb.WriteString("  ")
 			sb.WriteString(s.Mention())
 			if idx < len(streamers)-1 {
@@ -184,22 +173,8 @@ func FormatNotification(
 		}
 	}
 	sb.WriteString("\n")
-	sb.WriteString(suffix)
-
-	slices.Sort(userIDs)
-	slices.Sort(roleIDs)
 
-	content := sb.String()
-	allowedMentions := &api.AllowedMentions{
-		Roles: slices.Compact(roleIDs),
-		Users: slices.Compact(userIDs),
-	}
-
-	return api.SendMessageData{
-		Content:         content,
-		AllowedMentions: allowedMentions,
-		Flags:           discord.SuppressEmbeds,
-	}
+	return sb.String()
 }
 
 func AllowedMentions(
@@ -241,3 +216,30 @@ func AllowedMentions(
 
 	return allowedMentions
 }
+
+// FormatMentions formats the teams with their participants, the moderators and the streamers of a match
+// as a single line of mentions.
+func FormatMentions(
+	teamRoleIDs []discord.RoleID,
+	modUserIDs []discord.UserID,
+	streamers []model.Streamer,
+	participants map[discord.RoleID][]discord.UserID,
+) string {
+	mentions := make([]string, 0, len(teamRoleIDs)+len(modUserIDs)+len(streamers))
+	for _, rid := range teamRoleIDs {
+		mentions = append(mentions, rid.Mention())
+		for _, uid := range participants[rid] {
+			mentions = append(mentions, uid.Mention())
+		}
+	}
+	for _, uid := range modUserIDs {
+		mentions = append(mentions, uid.Mention())
+	}
+	for _, s := range streamers {
+		mentions = append(mentions, s.UserID.Mention())
+	}
+	if len(mentions) == 0 {
+		return ""
+	}
+	return strings.Join(mentions, " ") + "\n"
+}
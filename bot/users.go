@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"github.com/diamondburned/arikawa/v3/discord"
+
+	"github.com/jxs13/league-discord-bot/internal/i18n"
 )
 
 func (b *Bot) checkUserIDs(guildID discord.GuildID, userIDs ...discord.UserID) (err error) {
@@ -15,7 +17,7 @@ func (b *Bot) checkUserIDs(guildID discord.GuildID, userIDs ...discord.UserID) (
 			return fmt.Errorf("failed to check user id %s: %w", userIDs[0], err)
 		}
 		if member.User.ID != userIDs[0] {
-			return fmt.Errorf("user %s not found", userIDs[0])
+			return i18n.Errorf("error.user_not_found", userIDs[0])
 		}
 		return nil
 	}
@@ -34,7 +36,7 @@ func (b *Bot) checkUserIDs(guildID discord.GuildID, userIDs ...discord.UserID) (
 
 	for _, id := range userIDs {
 		if _, ok := available[id]; !ok {
-			return fmt.Errorf("user %s not found", id)
+			return i18n.Errorf("error.user_not_found", id)
 		}
 	}
 
@@ -0,0 +1,362 @@
+package bracket
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+)
+
+type Kind string
+
+const (
+	SingleElimination Kind = "SINGLE"
+	DoubleElimination Kind = "DOUBLE"
+)
+
+type Section string
+
+const (
+	Winners Section = "W"
+	Losers  Section = "L"
+	Final   Section = "F"
+)
+
+// None marks a missing follow-up slot.
+const None = -1
+
+// Slot is a single match of a bracket.
+// A side of a slot is either taken by a team, a bye or still unknown.
+type Slot struct {
+	Index    int
+	Section  Section
+	Round    int
+	Position int
+
+	Teams [2]string
+	Byes  [2]bool
+
+	// the winner and loser of the match move on to these slots
+	WinnerTo   int
+	WinnerSide int
+	LoserTo    int
+	LoserSide  int
+
+	Done   bool
+	Winner string
+}
+
+// Known returns true in case the given side is either taken by a team or a bye.
+func (s *Slot) Known(side int) bool {
+	return s.Teams[side] != "" || s.Byes[side]
+}
+
+// Ready returns true in case both teams of the match are known and the match is not decided yet.
+func (s *Slot) Ready() bool {
+	return !s.Done && s.Teams[0] != "" && s.Teams[1] != ""
+}
+
+type Bracket struct {
+	Kind  Kind
+	Slots []Slot
+}
+
+// New creates a bracket for the given teams which are expected to be sorted by their seed.
+// Missing teams up to the next power of two are filled with byes which are given to the best seeds.
+// Double elimination brackets end with a single grand final without a bracket reset.
+func New(kind Kind, seeds []string) (*Bracket, error) {
+	switch kind {
+	case SingleElimination:
+		if len(seeds) < 2 {
+			return nil, errors.New("a single elimination bracket requires at least 2 teams")
+		}
+	case DoubleElimination:
+		if len(seeds) < 3 {
+			return nil, errors.New("a double elimination bracket requires at least 3 teams")
+		}
+	default:
+		return nil, fmt.Errorf("unknown bracket kind: %q", kind)
+	}
+
+	size := 1 << bits.Len(uint(len(seeds)-1))
+	rounds := bits.Len(uint(size)) - 1
+
+	b := &Bracket{Kind: kind}
+
+	// winner bracket
+	wb := make([][]int, rounds+1)
+	for r := 1; r <= rounds; r++ {
+		for p := 0; p < size>>r; p++ {
+			wb[r] = append(wb[r], b.add(Winners, r, p))
+		}
+	}
+
+	for r := 1; r < rounds; r++ {
+		for p, idx := range wb[r] {
+			b.Slots[idx].WinnerTo = wb[r+1][p/2]
+			b.Slots[idx].WinnerSide = p % 2
+		}
+	}
+
+	if kind == DoubleElimination {
+		b.addLosers(wb, size, rounds)
+	}
+
+	order := seedOrder(size)
+	for p, idx := range wb[1] {
+		for side := 0; side < 2; side++ {
+			seed := order[2*p+side]
+			if seed <= len(seeds) {
+				b.Slots[idx].Teams[side] = seeds[seed-1]
+			} else {
+				b.Slots[idx].Byes[side] = true
+			}
+		}
+	}
+
+	b.resolveByes()
+	return b, nil
+}
+
+func (b *Bracket) addLosers(wb [][]int, size, rounds int) {
+	// losers bracket rounds alternate between matches among losers bracket teams
+	// and matches against teams that dropped out of the winner bracket.
+	lb := make([][]int, 2*(rounds-1)+1)
+	for r := 1; r < len(lb); r++ {
+		k := (r + 1) / 2
+		for p := 0; p < size>>(k+1); p++ {
+			lb[r] = append(lb[r], b.add(Losers, r, p))
+		}
+	}
+
+	for p, idx := range wb[1] {
+		b.Slots[idx].LoserTo = lb[1][p/2]
+		b.Slots[idx].LoserSide = p % 2
+	}
+
+	for k := 1; k < rounds; k++ {
+		dropIn := lb[2*k]
+		for p, idx := range wb[k+1] {
+			target := p
+			// reversing every other drop in round avoids early rematches
+			if k%2 == 1 {
+				target = len(dropIn) - 1 - p
+			}
+			b.Slots[idx].LoserTo = dropIn[target]
+			b.Slots[idx].LoserSide = 1
+		}
+
+		for p, idx := range lb[2*k-1] {
+			b.Slots[idx].WinnerTo = dropIn[p]
+			b.Slots[idx].WinnerSide = 0
+		}
+
+		if 2*k+1 < len(lb) {
+			for p, idx := range dropIn {
+				b.Slots[idx].WinnerTo = lb[2*k+1][p/2]
+				b.Slots[idx].WinnerSide = p % 2
+			}
+		}
+	}
+
+	final := b.add(Final, 1, 0)
+	wbFinal := wb[rounds][0]
+	b.Slots[wbFinal].WinnerTo = final
+	b.Slots[wbFinal].WinnerSide = 0
+
+	lbFinal := lb[len(lb)-1][0]
+	b.Slots[lbFinal].WinnerTo = final
+	b.Slots[lbFinal].WinnerSide = 1
+}
+
+func (b *Bracket) add(section Section, round, position int) int {
+	idx := len(b.Slots)
+	b.Slots = append(b.Slots, Slot{
+		Index:    idx,
+		Section:  section,
+		Round:    round,
+		Position: position,
+		WinnerTo: None,
+		LoserTo:  None,
+	})
+	return idx
+}
+
+// Advance decides the match of the given slot and moves the winner and loser on to their next slots.
+// The returned slots became ready to be played because of this decision.
+func (b *Bracket) Advance(index int, winner string) ([]int, error) {
+	if index < 0 || index >= len(b.Slots) {
+		return nil, fmt.Errorf("unknown bracket slot: %d", index)
+	}
+
+	s := &b.Slots[index]
+	if !s.Ready() {
+		return nil, fmt.Errorf("bracket match %d cannot be decided", index)
+	}
+
+	var loser string
+	switch winner {
+	case s.Teams[0]:
+		loser = s.Teams[1]
+	case s.Teams[1]:
+		loser = s.Teams[0]
+	default:
+		return nil, fmt.Errorf("team %s is not part of bracket match %d", winner, index)
+	}
+
+	before := b.ready()
+	b.decide(index, winner, loser)
+	b.resolveByes()
+
+	var ready []int
+	for _, idx := range b.Ready() {
+		if !before[idx] {
+			ready = append(ready, idx)
+		}
+	}
+	return ready, nil
+}
+
+// Revert undoes the decision of the match of the given slot, e.g. in case its result was reopened.
+// Follow-up matches that were decided because of a bye are undone as well, played follow-up matches cannot be undone.
+// The returned slots are not ready to be played anymore because of this.
+func (b *Bracket) Revert(index int) ([]int, error) {
+	if index < 0 || index >= len(b.Slots) {
+		return nil, fmt.Errorf("unknown bracket slot: %d", index)
+	}
+
+	s := &b.Slots[index]
+	if !s.Done || s.Byes[0] || s.Byes[1] {
+		return nil, fmt.Errorf("bracket match %d cannot be reverted", index)
+	}
+
+	before := b.ready()
+	err := b.revert(index)
+	if err != nil {
+		return nil, err
+	}
+
+	after := b.ready()
+	var unready []int
+	for idx := range b.Slots {
+		if before[idx] && !after[idx] {
+			unready = append(unready, idx)
+		}
+	}
+	return unready, nil
+}
+
+// Ready returns all slots whose teams are known and which still have to be played.
+func (b *Bracket) Ready() []int {
+	var ready []int
+	for idx := range b.Slots {
+		if b.Slots[idx].Ready() {
+			ready = append(ready, idx)
+		}
+	}
+	return ready
+}
+
+// Champion returns the winner of the bracket, in case the bracket was completed.
+func (b *Bracket) Champion() (string, bool) {
+	for _, s := range b.Slots {
+		if s.WinnerTo == None && (s.Section == Final || b.Kind == SingleElimination) {
+			return s.Winner, s.Done && s.Winner != ""
+		}
+	}
+	return "", false
+}
+
+func (b *Bracket) ready() map[int]bool {
+	m := make(map[int]bool)
+	for _, idx := range b.Ready() {
+		m[idx] = true
+	}
+	return m
+}
+
+// decide sets the result of a slot, an empty team moves on as bye.
+func (b *Bracket) decide(index int, winner, loser string) {
+	s := &b.Slots[index]
+	s.Done = true
+	s.Winner = winner
+
+	b.place(s.WinnerTo, s.WinnerSide, winner)
+	b.place(s.LoserTo, s.LoserSide, loser)
+}
+
+// revert removes the teams of a decided slot from its follow-up slots.
+func (b *Bracket) revert(index int) error {
+	s := &b.Slots[index]
+	for _, next := range [][2]int{{s.WinnerTo, s.WinnerSide}, {s.LoserTo, s.LoserSide}} {
+		to, side := next[0], next[1]
+		if to == None {
+			continue
+		}
+
+		t := &b.Slots[to]
+		if t.Done {
+			if !t.Byes[1-side] {
+				return fmt.Errorf("bracket match %d was already played", to)
+			}
+			// the team moved on without playing, so that decision is undone as well
+			err := b.revert(to)
+			if err != nil {
+				return err
+			}
+			t.Done = false
+			t.Winner = ""
+		}
+
+		t.Teams[side] = ""
+		t.Byes[side] = false
+	}
+
+	s.Done = false
+	s.Winner = ""
+	return nil
+}
+
+func (b *Bracket) place(index, side int, team string) {
+	if index == None {
+		return
+	}
+	if team == "" {
+		b.Slots[index].Byes[side] = true
+		return
+	}
+	b.Slots[index].Teams[side] = team
+}
+
+// resolveByes decides all matches in which a team plays against a bye.
+func (b *Bracket) resolveByes() {
+	for changed := true; changed; {
+		changed = false
+		for idx := range b.Slots {
+			s := &b.Slots[idx]
+			if s.Done || !s.Known(0) || !s.Known(1) || (!s.Byes[0] && !s.Byes[1]) {
+				continue
+			}
+
+			winner := s.Teams[0]
+			if winner == "" {
+				winner = s.Teams[1]
+			}
+			b.decide(idx, winner, "")
+			changed = true
+		}
+	}
+}
+
+// seedOrder returns the seeds of the first round in the order of the bracket positions,
+// so that the best seeds meet as late as possible.
+func seedOrder(size int) []int {
+	order := []int{1}
+	for n := 2; n <= size; n *= 2 {
+		next := make([]int, 0, n)
+		for _, s := range order {
+			next = append(next, s, n+1-s)
+		}
+		order = next
+	}
+	return order
+}
@@ -0,0 +1,196 @@
+package bracket
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeedOrder(t *testing.T) {
+	assert.Equal(t, []int{1, 2}, seedOrder(2))
+	assert.Equal(t, []int{1, 4, 2, 3}, seedOrder(4))
+	assert.Equal(t, []int{1, 8, 4, 5, 2, 7, 3, 6}, seedOrder(8))
+}
+
+func TestSingleElimination(t *testing.T) {
+	b, err := New(SingleElimination, []string{"a", "b", "c", "d"})
+	require.NoError(t, err)
+	require.Len(t, b.Slots, 3)
+
+	assert.Equal(t, [2]string{"a", "d"}, b.Slots[0].Teams)
+	assert.Equal(t, [2]string{"b", "c"}, b.Slots[1].Teams)
+	assert.Equal(t, []int{0, 1}, b.Ready())
+
+	ready, err := b.Advance(0, "a")
+	require.NoError(t, err)
+	assert.Empty(t, ready)
+
+	_, err = b.Advance(0, "a")
+	assert.Error(t, err)
+
+	_, err = b.Advance(1, "a")
+	assert.Error(t, err)
+
+	ready, err = b.Advance(1, "c")
+	require.NoError(t, err)
+	assert.Equal(t, []int{2}, ready)
+	assert.Equal(t, [2]string{"a", "c"}, b.Slots[2].Teams)
+
+	_, ok := b.Champion()
+	assert.False(t, ok)
+
+	ready, err = b.Advance(2, "c")
+	require.NoError(t, err)
+	assert.Empty(t, ready)
+
+	champion, ok := b.Champion()
+	assert.True(t, ok)
+	assert.Equal(t, "c", champion)
+}
+
+func TestSingleEliminationByes(t *testing.T) {
+	b, err := New(SingleElimination, []string{"a", "b", "c", "d", "e"})
+	require.NoError(t, err)
+	require.Len(t, b.Slots, 7)
+
+	// the three best seeds have a bye in the first round
+	assert.True(t, b.Slots[0].Done)
+	assert.Equal(t, "a", b.Slots[0].Winner)
+	assert.False(t, b.Slots[1].Done)
+	assert.Equal(t, [2]string{"d", "e"}, b.Slots[1].Teams)
+	assert.True(t, b.Slots[2].Done)
+	assert.True(t, b.Slots[3].Done)
+
+	// b and c meet in the second round right away
+	assert.Equal(t, [2]string{"b", "c"}, b.Slots[5].Teams)
+	assert.Equal(t, []int{1, 5}, b.Ready())
+
+	ready, err := b.Advance(1, "e")
+	require.NoError(t, err)
+	assert.Equal(t, []int{4}, ready)
+	assert.Equal(t, [2]string{"a", "e"}, b.Slots[4].Teams)
+}
+
+func TestRevert(t *testing.T) {
+	b, err := New(SingleElimination, []string{"a", "b", "c", "d"})
+	require.NoError(t, err)
+
+	_, err = b.Revert(0)
+	assert.Error(t, err)
+
+	_, err = b.Advance(0, "a")
+	require.NoError(t, err)
+	_, err = b.Advance(1, "c")
+	require.NoError(t, err)
+
+	unready, err := b.Revert(0)
+	require.NoError(t, err)
+	assert.Equal(t, []int{2}, unready)
+	assert.Equal(t, [2]string{"", "c"}, b.Slots[2].Teams)
+	assert.Equal(t, []int{0}, b.Ready())
+
+	ready, err := b.Advance(0, "d")
+	require.NoError(t, err)
+	assert.Equal(t, []int{2}, ready)
+	assert.Equal(t, [2]string{"d", "c"}, b.Slots[2].Teams)
+
+	_, err = b.Advance(2, "d")
+	require.NoError(t, err)
+
+	// the final was already played
+	_, err = b.Revert(0)
+	assert.Error(t, err)
+}
+
+func TestRevertByes(t *testing.T) {
+	b, err := New(DoubleElimination, []string{"a", "b", "c"})
+	require.NoError(t, err)
+
+	// matches decided by a bye cannot be reverted
+	_, err = b.Revert(0)
+	assert.Error(t, err)
+
+	before := make([]Slot, len(b.Slots))
+	copy(before, b.Slots)
+
+	ready := b.Ready()
+	require.Len(t, ready, 1)
+	idx := ready[0]
+	winner := b.Slots[idx].Teams[0]
+
+	_, err = b.Advance(idx, winner)
+	require.NoError(t, err)
+
+	_, err = b.Revert(idx)
+	require.NoError(t, err)
+	assert.Equal(t, before, b.Slots)
+}
+
+func TestDoubleElimination(t *testing.T) {
+	b, err := New(DoubleElimination, []string{"a", "b", "c", "d"})
+	require.NoError(t, err)
+
+	// 3 winner bracket matches, 2 loser bracket matches and the grand final
+	require.Len(t, b.Slots, 6)
+
+	_, err = b.Advance(0, "a")
+	require.NoError(t, err)
+	ready, err := b.Advance(1, "b")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []int{2, 3}, ready)
+
+	// the losers of the first round meet in the loser bracket
+	assert.Equal(t, [2]string{"d", "c"}, b.Slots[3].Teams)
+
+	ready, err = b.Advance(2, "a")
+	require.NoError(t, err)
+	assert.Empty(t, ready)
+	assert.Equal(t, "b", b.Slots[4].Teams[1])
+
+	ready, err = b.Advance(3, "c")
+	require.NoError(t, err)
+	assert.Equal(t, []int{4}, ready)
+	assert.Equal(t, [2]string{"c", "b"}, b.Slots[4].Teams)
+
+	ready, err = b.Advance(4, "c")
+	require.NoError(t, err)
+	assert.Equal(t, []int{5}, ready)
+	assert.Equal(t, Final, b.Slots[5].Section)
+	assert.Equal(t, [2]string{"a", "c"}, b.Slots[5].Teams)
+
+	_, err = b.Advance(5, "c")
+	require.NoError(t, err)
+
+	champion, ok := b.Champion()
+	assert.True(t, ok)
+	assert.Equal(t, "c", champion)
+}
+
+func TestDoubleEliminationByes(t *testing.T) {
+	b, err := New(DoubleElimination, []string{"a", "b", "c"})
+	require.NoError(t, err)
+
+	assert.True(t, b.Slots[0].Done)
+	assert.Equal(t, []int{1}, b.Ready())
+
+	ready, err := b.Advance(1, "b")
+	require.NoError(t, err)
+	assert.Equal(t, []int{2}, ready)
+
+	// the loser of the only first round match gets a bye in the loser bracket
+	assert.True(t, b.Slots[3].Done)
+	assert.Equal(t, "c", b.Slots[3].Winner)
+	assert.Equal(t, "c", b.Slots[4].Teams[0])
+}
+
+func TestNewInvalid(t *testing.T) {
+	_, err := New(SingleElimination, []string{"a"})
+	assert.Error(t, err)
+
+	_, err = New(DoubleElimination, []string{"a", "b"})
+	assert.Error(t, err)
+
+	_, err = New("TRIPLE", []string{"a", "b", "c"})
+	assert.Error(t, err)
+}
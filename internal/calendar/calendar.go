@@ -0,0 +1,178 @@
+// Package calendar contains recurring schedules which are evaluated in the wall clock time of a location,
+// so that they keep their local time across daylight saving time changes.
+package calendar
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jxs13/league-discord-bot/internal/i18n"
+	"github.com/jxs13/league-discord-bot/internal/season"
+	"github.com/robfig/cron/v3"
+)
+
+// Schedule is a recurring point in time.
+type Schedule interface {
+	// Next returns the first point in time of the schedule after t.
+	// The schedule is evaluated in the location of t.
+	Next(t time.Time) time.Time
+}
+
+// Interval is a fixed amount of time between two points in time, independent of the calendar.
+type Interval time.Duration
+
+func (i Interval) Next(t time.Time) time.Time {
+	return t.Add(time.Duration(i))
+}
+
+// Weekly recurs at the given weekly slots.
+type Weekly []season.Slot
+
+func (w Weekly) Next(t time.Time) time.Time {
+	// slots have a precision of one minute
+	times := season.Times(w, t.Truncate(time.Minute).Add(time.Minute), 1)
+	if len(times) == 0 {
+		return time.Time{}
+	}
+	return times[0]
+}
+
+// Daily recurs every day at the same time.
+type Daily struct {
+	Hour   int
+	Minute int
+}
+
+func (d Daily) Next(t time.Time) time.Time {
+	year, month, day := t.Date()
+	for offset := 0; ; offset++ {
+		// the day is normalized by time.Date and keeps the wall clock time
+		next := time.Date(year, month, day+offset, d.Hour, d.Minute, 0, 0, t.Location())
+		if next.After(t) {
+			return next
+		}
+	}
+}
+
+// Monthly recurs every month on the same day at the same time.
+// Days which do not exist in a month are moved to the last day of that month.
+type Monthly struct {
+	Day    int
+	Hour   int
+	Minute int
+}
+
+func (m Monthly) Next(t time.Time) time.Time {
+	year, month, _ := t.Date()
+	for offset := 0; ; offset++ {
+		first := time.Date(year, month+time.Month(offset), 1, 0, 0, 0, 0, t.Location())
+		lastDay := first.AddDate(0, 1, -1).Day()
+		next := time.Date(first.Year(), first.Month(), min(m.Day, lastDay), m.Hour, m.Minute, 0, 0, t.Location())
+		if next.After(t) {
+			return next
+		}
+	}
+}
+
+// Cron recurs according to a standard cron expression with five fields.
+type Cron struct {
+	schedule cron.Schedule
+}
+
+func (c Cron) Next(t time.Time) time.Time {
+	return c.schedule.Next(t)
+}
+
+// Parse parses a calendar schedule:
+//
+//	mon 18:00, thu 18:00   weekly at the given weekdays and times
+//	daily 18:00            every day at the given time
+//	monthly 1 09:00        every month on the given day at the given time
+//	cron 0 18 * * 1        standard cron expression
+func Parse(expr string) (Schedule, error) {
+	expr = strings.TrimSpace(expr)
+	fields := strings.Fields(strings.ToLower(expr))
+	if len(fields) == 0 {
+		return nil, i18n.Errorf("error.schedule_empty")
+	}
+
+	var (
+		s   Schedule
+		err error
+	)
+	switch fields[0] {
+	case "daily":
+		s, err = parseDaily(fields[1:])
+	case "monthly":
+		s, err = parseMonthly(fields[1:])
+	case "cron":
+		s, err = parseCron(strings.TrimSpace(expr[len(fields[0]):]))
+	default:
+		var slots []season.Slot
+		slots, err = season.ParseSlots(expr)
+		if err != nil {
+			return nil, i18n.Errorf("error.schedule_invalid", expr, err)
+		}
+		s = Weekly(slots)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// e.g. the 30th of february never occurs
+	if s.Next(time.Now()).IsZero() {
+		return nil, i18n.Errorf("error.schedule_never", expr)
+	}
+	return s, nil
+}
+
+func parseDaily(fields []string) (Schedule, error) {
+	if len(fields) != 1 {
+		return nil, i18n.Errorf("error.schedule_daily")
+	}
+
+	hour, minute, err := parseClock(fields[0])
+	if err != nil {
+		return nil, err
+	}
+	return Daily{Hour: hour, Minute: minute}, nil
+}
+
+func parseMonthly(fields []string) (Schedule, error) {
+	if len(fields) != 2 {
+		return nil, i18n.Errorf("error.schedule_monthly")
+	}
+
+	day, err := strconv.Atoi(fields[0])
+	if err != nil || day < 1 || day > 31 {
+		return nil, i18n.Errorf("error.schedule_monthly")
+	}
+
+	hour, minute, err := parseClock(fields[1])
+	if err != nil {
+		return nil, err
+	}
+	return Monthly{Day: day, Hour: hour, Minute: minute}, nil
+}
+
+func parseCron(spec string) (Schedule, error) {
+	if strings.Contains(strings.ToUpper(spec), "TZ=") {
+		// the location is configured separately
+		return nil, i18n.Errorf("error.schedule_cron_location")
+	}
+
+	s, err := cron.ParseStandard(spec)
+	if err != nil {
+		return nil, i18n.Errorf("error.schedule_cron", spec, err)
+	}
+	return Cron{schedule: s}, nil
+}
+
+func parseClock(s string) (hour, minute int, err error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, 0, i18n.Errorf("error.schedule_time", s)
+	}
+	return t.Hour(), t.Minute(), nil
+}
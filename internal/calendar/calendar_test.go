@@ -0,0 +1,92 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func berlin(t *testing.T) *time.Location {
+	loc, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	return loc
+}
+
+func TestWeeklyKeepsLocalTimeAcrossDST(t *testing.T) {
+	loc := berlin(t)
+	s, err := Parse("mon 18:00")
+	require.NoError(t, err)
+
+	// the clocks are set forward on sunday 2025-03-30
+	next := s.Next(time.Date(2025, 3, 24, 18, 0, 0, 0, loc))
+	assert.Equal(t, time.Date(2025, 3, 31, 18, 0, 0, 0, loc), next)
+	assert.Equal(t, 7*24*time.Hour-time.Hour, next.Sub(time.Date(2025, 3, 24, 18, 0, 0, 0, loc)))
+
+	// the clocks are set back on sunday 2025-10-26
+	next = s.Next(time.Date(2025, 10, 20, 18, 0, 0, 0, loc))
+	assert.Equal(t, time.Date(2025, 10, 27, 18, 0, 0, 0, loc), next)
+}
+
+func TestWeeklyMultipleSlots(t *testing.T) {
+	loc := berlin(t)
+	s, err := Parse("thu 20:00, mon 18:00")
+	require.NoError(t, err)
+
+	next := s.Next(time.Date(2025, 3, 24, 17, 59, 30, 0, loc))
+	assert.Equal(t, time.Date(2025, 3, 24, 18, 0, 0, 0, loc), next)
+
+	next = s.Next(next)
+	assert.Equal(t, time.Date(2025, 3, 27, 20, 0, 0, 0, loc), next)
+}
+
+func TestDaily(t *testing.T) {
+	loc := berlin(t)
+	s, err := Parse("daily 09:30")
+	require.NoError(t, err)
+
+	assert.Equal(t, time.Date(2025, 3, 29, 9, 30, 0, 0, loc), s.Next(time.Date(2025, 3, 29, 9, 0, 0, 0, loc)))
+	assert.Equal(t, time.Date(2025, 3, 30, 9, 30, 0, 0, loc), s.Next(time.Date(2025, 3, 29, 9, 30, 0, 0, loc)))
+}
+
+func TestMonthly(t *testing.T) {
+	loc := berlin(t)
+	s, err := Parse("monthly 1 09:00")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 4, 1, 9, 0, 0, 0, loc), s.Next(time.Date(2025, 3, 1, 9, 0, 0, 0, loc)))
+
+	s, err = Parse("monthly 31 12:00")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 2, 28, 12, 0, 0, 0, loc), s.Next(time.Date(2025, 1, 31, 12, 0, 0, 0, loc)))
+	assert.Equal(t, time.Date(2025, 3, 31, 12, 0, 0, 0, loc), s.Next(time.Date(2025, 2, 28, 12, 0, 0, 0, loc)))
+}
+
+func TestCron(t *testing.T) {
+	loc := berlin(t)
+	s, err := Parse("cron 0 18 * * 1")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 3, 31, 18, 0, 0, 0, loc), s.Next(time.Date(2025, 3, 24, 18, 0, 0, 0, loc)))
+}
+
+func TestInterval(t *testing.T) {
+	start := time.Date(2025, 3, 24, 18, 0, 0, 0, time.UTC)
+	assert.Equal(t, start.Add(time.Hour), Interval(time.Hour).Next(start))
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"daily",
+		"daily 25:00",
+		"monthly 0 09:00",
+		"monthly 1",
+		"cron * *",
+		"cron TZ=Europe/Berlin 0 18 * * 1",
+		"cron 0 0 30 2 *",
+		"someday 18:00",
+	} {
+		_, err := Parse(expr)
+		assert.Error(t, err, expr)
+	}
+}
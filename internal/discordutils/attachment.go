@@ -0,0 +1,46 @@
+package discordutils
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/diamondburned/arikawa/v3/discord"
+)
+
+var attachmentClient = &http.Client{
+	Timeout: 30 * time.Second,
+}
+
+// DownloadAttachment fetches the content of an attachment that is at most maxSize bytes large.
+func DownloadAttachment(ctx context.Context, a discord.Attachment, maxSize int64) ([]byte, error) {
+	if a.Size > uint64(maxSize) {
+		return nil, fmt.Errorf("attachment %q is too large: %d bytes, at most %d bytes are allowed", a.Filename, a.Size, maxSize)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, a.URL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request for attachment %q: %w", a.Filename, err)
+	}
+
+	resp, err := attachmentClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download attachment %q: %w", a.Filename, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download attachment %q: unexpected status %s", a.Filename, resp.Status)
+	}
+
+	b, err := io.ReadAll(io.LimitReader(resp.Body, maxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read attachment %q: %w", a.Filename, err)
+	}
+	if int64(len(b)) > maxSize {
+		return nil, fmt.Errorf("attachment %q is too large, at most %d bytes are allowed", a.Filename, maxSize)
+	}
+	return b, nil
+}
@@ -14,3 +14,13 @@ func DiscordLongDateTime(t time.Time) string {
 func DiscordLongDate(t time.Time) string {
 	return fmt.Sprintf("<t:%d:D>", t.UTC().Unix())
 }
+
+// <t:1543392060:t>
+func DiscordShortTime(t time.Time) string {
+	return fmt.Sprintf("<t:%d:t>", t.UTC().Unix())
+}
+
+// <t:1543392060:R>
+func DiscordRelativeTime(t time.Time) string {
+	return fmt.Sprintf("<t:%d:R>", t.UTC().Unix())
+}
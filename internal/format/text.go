@@ -0,0 +1,33 @@
+package format
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	progressFull  = "▰"
+	progressEmpty = "▱"
+)
+
+// ProgressBar renders the progress of current out of total with at most width segments.
+func ProgressBar(current, total int64, width int) string {
+	if total <= 0 || width <= 0 {
+		return ""
+	}
+
+	segments := min(int64(width), total)
+	filled := min(segments, max(0, current*segments/total))
+	return strings.Repeat(progressFull, int(filled)) + strings.Repeat(progressEmpty, int(segments-filled))
+}
+
+// Truncate shortens the text to at most limit characters and marks the cut with an ellipsis.
+func Truncate(text string, limit int) string {
+	if utf8.RuneCountInString(text) <= limit {
+		return text
+	}
+	if limit <= 3 {
+		return string([]rune(text)[:max(0, limit)])
+	}
+	return string([]rune(text)[:limit-3]) + "..."
+}
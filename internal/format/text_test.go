@@ -0,0 +1,22 @@
+package format
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProgressBar(t *testing.T) {
+	assert.Equal(t, "▱▱▱", ProgressBar(0, 3, 10))
+	assert.Equal(t, "▰▰▱", ProgressBar(2, 3, 10))
+	assert.Equal(t, "▰▰▰", ProgressBar(5, 3, 10))
+	assert.Equal(t, "▰▰▰▰▰▱▱▱▱▱", ProgressBar(10, 20, 10))
+	assert.Equal(t, "", ProgressBar(1, 0, 10))
+}
+
+func TestTruncate(t *testing.T) {
+	assert.Equal(t, "abc", Truncate("abc", 3))
+	assert.Equal(t, "a...", Truncate("abcdef", 4))
+	assert.Equal(t, "äö...", Truncate("äöüäöü", 5))
+	assert.Equal(t, "ab", Truncate("abcdef", 2))
+}
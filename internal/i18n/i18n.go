@@ -0,0 +1,169 @@
+// Package i18n contains the translations of all texts which the bot generates.
+//
+// Every language is a flat JSON catalog in the locales directory which maps message keys to
+// fmt format strings. English is the reference catalog, every other catalog may only contain keys
+// that are also part of the English catalog, except for the slash command localizations with the
+// "commands." prefix. Missing keys fall back to English. In order to add a new language, copy the
+// German catalog, name it after the language's Discord locale and translate the values.
+package i18n
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/diamondburned/arikawa/v3/discord"
+)
+
+type Language string
+
+const (
+	English Language = "en"
+	German  Language = "de"
+)
+
+//go:embed locales/*.json
+var locales embed.FS
+
+var catalogs = mustLoadCatalogs()
+
+func mustLoadCatalogs() map[Language]map[string]string {
+	c, err := loadCatalogs()
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+func loadCatalogs() (map[Language]map[string]string, error) {
+	entries, err := locales.ReadDir("locales")
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[Language]map[string]string, len(entries))
+	for _, e := range entries {
+		data, err := locales.ReadFile(path.Join("locales", e.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		var catalog map[string]string
+		err = json.Unmarshal(data, &catalog)
+		if err != nil {
+			return nil, fmt.Errorf("invalid catalog %s: %w", e.Name(), err)
+		}
+		result[Language(strings.TrimSuffix(e.Name(), ".json"))] = catalog
+	}
+
+	if _, ok := result[English]; !ok {
+		return nil, errors.New("english catalog is missing")
+	}
+	return result, nil
+}
+
+// Languages returns all languages which have a catalog.
+func Languages() []Language {
+	result := make([]Language, 0, len(catalogs))
+	for lang := range catalogs {
+		result = append(result, lang)
+	}
+	slices.Sort(result)
+	return result
+}
+
+// ParseLanguage returns the language with the given name.
+func ParseLanguage(s string) (Language, error) {
+	lang := Language(s)
+	if _, ok := catalogs[lang]; !ok {
+		return "", fmt.Errorf("unsupported language: %s", s)
+	}
+	return lang, nil
+}
+
+// Locale returns the Discord locale of the language.
+func (l Language) Locale() discord.Language {
+	if l == English {
+		return discord.EnglishUS
+	}
+	return discord.Language(l)
+}
+
+// Lookup returns the message of the given language without falling back to English.
+func Lookup(lang Language, key string) (string, bool) {
+	msg, ok := catalogs[lang][key]
+	return msg, ok
+}
+
+// T returns the formatted message of the given language.
+// Messages which are missing in the given language fall back to English, unknown keys are returned as is.
+func T(lang Language, key string, args ...any) string {
+	msg, ok := Lookup(lang, key)
+	if !ok {
+		msg, ok = Lookup(English, key)
+		if !ok {
+			return key
+		}
+	}
+
+	if len(args) == 0 {
+		return msg
+	}
+	return fmt.Sprintf(msg, args...)
+}
+
+// Error is an error which is shown to users and can therefore be translated.
+type Error struct {
+	Key  string
+	Args []any
+}
+
+// Errorf returns a translatable error with the message of the given key.
+func Errorf(key string, args ...any) error {
+	return &Error{
+		Key:  key,
+		Args: args,
+	}
+}
+
+func (e *Error) Error() string {
+	return e.Translate(English)
+}
+
+// Translate returns the error message in the given language.
+func (e *Error) Translate(lang Language) string {
+	return T(lang, e.Key, e.Args...)
+}
+
+// Message returns the error message in the given language.
+// In case that err wraps a translatable error, only that part of the message is translated.
+func Message(lang Language, err error) string {
+	msg := err.Error()
+
+	var e *Error
+	if lang == English || !errors.As(err, &e) {
+		return msg
+	}
+	return strings.Replace(msg, e.Error(), e.Translate(lang), 1)
+}
+
+type ctxKey struct{}
+
+// WithLanguage returns a context which carries the language.
+func WithLanguage(ctx context.Context, lang Language) context.Context {
+	return context.WithValue(ctx, ctxKey{}, lang)
+}
+
+// FromContext returns the language of the context or English.
+func FromContext(ctx context.Context) Language {
+	lang, ok := ctx.Value(ctxKey{}).(Language)
+	if !ok {
+		return English
+	}
+	return lang
+}
@@ -0,0 +1,80 @@
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	verbRegex        = regexp.MustCompile(`%[-+# 0]*[0-9]*[a-zA-Z%]`)
+	commandNameRegex = regexp.MustCompile(`^[-_\p{Ll}\p{N}]{1,32}$`)
+)
+
+func verbs(msg string) []string {
+	result := verbRegex.FindAllString(msg, -1)
+	slices.Sort(result)
+	return result
+}
+
+func TestCatalogs(t *testing.T) {
+	require.Contains(t, Languages(), English)
+	require.Contains(t, Languages(), German)
+
+	for _, lang := range Languages() {
+		for key, msg := range catalogs[lang] {
+			if strings.HasPrefix(key, "commands.") {
+				if strings.HasSuffix(key, ".name") {
+					assert.Regexp(t, commandNameRegex, msg, "%s: %s", lang, key)
+				} else {
+					n := utf8.RuneCountInString(msg)
+					assert.True(t, n >= 1 && n <= 100, "%s: %s: description must be between 1 and 100 characters long", lang, key)
+				}
+				continue
+			}
+
+			en, ok := Lookup(English, key)
+			if !assert.True(t, ok, "%s: %s is not part of the english catalog", lang, key) {
+				continue
+			}
+			assert.Equal(t, verbs(en), verbs(msg), "%s: %s", lang, key)
+		}
+	}
+}
+
+func TestGermanCatalogIsComplete(t *testing.T) {
+	for key := range catalogs[English] {
+		_, ok := Lookup(German, key)
+		assert.True(t, ok, "missing german translation: %s", key)
+	}
+}
+
+func TestT(t *testing.T) {
+	assert.Equal(t, "role 1 not found", T(English, "error.role_not_found", "1"))
+	assert.Equal(t, "Rolle 1 nicht gefunden", T(German, "error.role_not_found", "1"))
+	assert.Equal(t, "role 1 not found", T(Language("xx"), "error.role_not_found", "1"))
+	assert.Equal(t, "unknown.key", T(German, "unknown.key"))
+}
+
+func TestMessage(t *testing.T) {
+	err := fmt.Errorf("invalid parameter 'teams': %w", Errorf("error.role_not_found", "1"))
+	assert.Equal(t, "invalid parameter 'teams': role 1 not found", Message(English, err))
+	assert.Equal(t, "invalid parameter 'teams': Rolle 1 nicht gefunden", Message(German, err))
+	assert.Equal(t, "plain", Message(German, errors.New("plain")))
+}
+
+func TestParseLanguage(t *testing.T) {
+	lang, err := ParseLanguage("de")
+	require.NoError(t, err)
+	assert.Equal(t, German, lang)
+
+	_, err = ParseLanguage("xx")
+	assert.Error(t, err)
+}
@@ -0,0 +1,237 @@
+package msgtemplate
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"text/template"
+	"text/template/parse"
+	"unicode/utf8"
+
+	"github.com/jxs13/league-discord-bot/internal/i18n"
+)
+
+// MaxLength is the maximum length of a template, which leaves enough room for the
+// generated mentions within the 2000 characters of a Discord message.
+const MaxLength = 1500
+
+// MaxMessageLength is the maximum length of a rendered message, which is the limit of a Discord message.
+const MaxMessageLength = 2000
+
+// allowedFuncs are the built-in template functions whose cost is bounded by the size of the template.
+// Functions like printf are excluded, as their output size is controlled by the template, e.g. %0999999999d.
+var allowedFuncs = []string{"and", "or", "not", "eq", "ne", "lt", "le", "gt", "ge", "len"}
+
+type Kind string
+
+const (
+	KindReminder            Kind = "reminder"
+	KindParticipationClosed Kind = "participation_closed"
+	KindParticipationFailed Kind = "participation_failed"
+	KindMatchRescheduled    Kind = "match_rescheduled"
+	KindMatchCancelled      Kind = "match_cancelled"
+	KindResultDisputed      Kind = "result_disputed"
+	KindAnnouncementHeader  Kind = "announcement_header"
+	KindAnnouncementMatch   Kind = "announcement_match"
+)
+
+// Kinds contains all message kinds in the order in which they are presented to users.
+var Kinds = []Kind{
+	KindReminder,
+	KindParticipationClosed,
+	KindParticipationFailed,
+	KindMatchRescheduled,
+	KindMatchCancelled,
+	KindResultDisputed,
+	KindAnnouncementHeader,
+	KindAnnouncementMatch,
+}
+
+// Data contains the values which can be used as placeholders in templates.
+// Not every value is available for every message kind, unavailable values are empty.
+type Data struct {
+	// Channel is the mention of the match channel.
+	Channel string
+	// ChannelName is the name of the match channel formatted as inline code.
+	ChannelName string
+	// Teams are the team mentions, or the team names in announcements.
+	Teams string
+	// Team is the mention of the team which triggered the message, e.g. by disputing a result.
+	Team string
+	// Moderators are the moderator mentions, or the moderator names in announcements.
+	Moderators string
+	// Streamers are the streamer mentions including their stream urls.
+	Streamers string
+	// Substitutes lists the substitutes of every team.
+	Substitutes string
+	// Roster is the default layout of the teams, lineups, moderators and streamers.
+	Roster string
+
+	ScheduledAt           string
+	PreviouslyScheduledAt string
+	TimeUntil             string
+	StartingNow           bool
+	Reason                string
+	IntervalStart         string
+	IntervalEnd           string
+}
+
+// sample is used to validate templates and to preview them.
+var sample = Data{
+	Channel:               "#match-channel",
+	ChannelName:           "`match-channel`",
+	Teams:                 "@Team A @Team B",
+	Team:                  "@Team A",
+	Moderators:            "@Moderator",
+	Streamers:             "@Streamer (https://twitch.tv/streamer)",
+	Substitutes:           "Substitutes:\n @Team A\n1. @Substitute\n",
+	Roster:                "Teams: @Team A @Team B\nModerator: @Moderator\nStreamer:   @Streamer (https://twitch.tv/streamer)\n",
+	ScheduledAt:           "Monday, 1 January 2024 20:00",
+	PreviouslyScheduledAt: "Sunday, 31 December 2023 20:00",
+	TimeUntil:             "1 hour",
+	StartingNow:           false,
+	Reason:                "team did not show up",
+	IntervalStart:         "Monday, 1 January 2024",
+	IntervalEnd:           "Monday, 8 January 2024",
+}
+
+// Sample returns the example data which is used for previews.
+func Sample() Data {
+	return sample
+}
+
+// ParseKind returns the message kind with the given name.
+func ParseKind(s string) (Kind, error) {
+	k := Kind(s)
+	if !slices.Contains(Kinds, k) {
+		return "", fmt.Errorf("unknown message kind: %s", s)
+	}
+	return k, nil
+}
+
+// Default returns the built-in template of the message kind in the given language.
+func Default(lang i18n.Language, kind Kind) string {
+	return i18n.T(lang, "template."+string(kind))
+}
+
+// Validate checks that the template can be parsed, only uses known placeholders and
+// that its message does not exceed the maximum message length.
+func Validate(text string) error {
+	if strings.TrimSpace(text) == "" {
+		return i18n.Errorf("error.template_empty")
+	}
+	if len(text) > MaxLength {
+		return i18n.Errorf("error.template_length", MaxLength)
+	}
+
+	_, err := Execute(text, sample)
+	return err
+}
+
+// Execute renders the template with the given data.
+// Loops, nested templates and functions with unbounded output are rejected, so that user
+// provided templates cannot block the bot, and messages longer than MaxMessageLength fail.
+func Execute(text string, data Data) (string, error) {
+	t, err := template.New("message").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", i18n.Errorf("error.template_invalid", err)
+	}
+
+	if len(t.Templates()) > 1 {
+		return "", i18n.Errorf("error.template_action", "define")
+	}
+	err = checkNode(t.Root)
+	if err != nil {
+		return "", err
+	}
+
+	w := &limitWriter{limit: MaxMessageLength}
+	err = t.Execute(w, data)
+	if err != nil {
+		if errors.Is(err, errMessageLength) {
+			return "", i18n.Errorf("error.template_message_length", MaxMessageLength)
+		}
+		return "", i18n.Errorf("error.template_invalid", err)
+	}
+	return w.sb.String(), nil
+}
+
+// checkNode rejects the actions which repeat or nest templates as well as unknown functions.
+func checkNode(node parse.Node) error {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return nil
+		}
+		for _, c := range n.Nodes {
+			err := checkNode(c)
+			if err != nil {
+				return err
+			}
+		}
+	case *parse.ActionNode:
+		return checkNode(n.Pipe)
+	case *parse.IfNode:
+		return checkBranch(n.BranchNode)
+	case *parse.WithNode:
+		return checkBranch(n.BranchNode)
+	case *parse.RangeNode:
+		return i18n.Errorf("error.template_action", "range")
+	case *parse.TemplateNode:
+		return i18n.Errorf("error.template_action", "template")
+	case *parse.PipeNode:
+		if n == nil {
+			return nil
+		}
+		for _, c := range n.Cmds {
+			err := checkNode(c)
+			if err != nil {
+				return err
+			}
+		}
+	case *parse.CommandNode:
+		for _, arg := range n.Args {
+			err := checkNode(arg)
+			if err != nil {
+				return err
+			}
+		}
+	case *parse.ChainNode:
+		return checkNode(n.Node)
+	case *parse.IdentifierNode:
+		if !slices.Contains(allowedFuncs, n.Ident) {
+			return i18n.Errorf("error.template_action", n.Ident)
+		}
+	}
+	return nil
+}
+
+func checkBranch(n parse.BranchNode) error {
+	err := checkNode(n.Pipe)
+	if err != nil {
+		return err
+	}
+	err = checkNode(n.List)
+	if err != nil {
+		return err
+	}
+	return checkNode(n.ElseList)
+}
+
+var errMessageLength = errors.New("message too long")
+
+// limitWriter fails as soon as more than limit characters are written.
+type limitWriter struct {
+	sb    strings.Builder
+	n     int
+	limit int
+}
+
+func (w *limitWriter) Write(p []byte) (int, error) {
+	w.n += utf8.RuneCount(p)
+	if w.n > w.limit {
+		return 0, errMessageLength
+	}
+	return w.sb.Write(p)
+}
@@ -0,0 +1,69 @@
+package msgtemplate
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/jxs13/league-discord-bot/internal/i18n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultsAreValid(t *testing.T) {
+	for _, lang := range i18n.Languages() {
+		for _, k := range Kinds {
+			assert.NoError(t, Validate(Default(lang, k)), "%s: %s", lang, k)
+		}
+	}
+}
+
+func TestExecuteReminder(t *testing.T) {
+	data := Data{TimeUntil: "15 minutes", Roster: "Teams: <@&1>\n"}
+	got, err := Execute(Default(i18n.English, KindReminder), data)
+	require.NoError(t, err)
+	assert.Equal(t, "The match is starting in about 15 minutes. \nTeams: <@&1>\n", got)
+
+	data.StartingNow = true
+	got, err = Execute(Default(i18n.English, KindReminder), data)
+	require.NoError(t, err)
+	assert.Equal(t, "The match is starting now!\nTeams: <@&1>\n", got)
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Validate("{{.Teams}} play at {{.ScheduledAt}} in {{.Channel}}"))
+	assert.Error(t, Validate(""))
+	assert.Error(t, Validate("{{.Unknown}}"))
+	assert.Error(t, Validate("{{.Teams"))
+	assert.Error(t, Validate(string(make([]byte, MaxLength+1))))
+}
+
+func TestValidateRejectsUnboundedTemplates(t *testing.T) {
+	assert.NoError(t, Validate(`{{if and .StartingNow (eq .Reason "")}}now{{else}}{{len .Teams}}{{end}}`))
+	assert.NoError(t, Validate("{{with .Reason}}Reason: {{.}}{{end}}"))
+	assert.Error(t, Validate("{{range 1000000000}}x{{end}}"))
+	assert.Error(t, Validate(`{{define "a"}}{{template "a"}}{{end}}{{template "a"}}`))
+	assert.Error(t, Validate(`{{block "a" .}}x{{end}}`))
+	assert.Error(t, Validate(`{{printf "%0999999999d" 1}}`))
+	assert.Error(t, Validate("{{.Roster | print}}"))
+}
+
+func TestExecuteMessageLength(t *testing.T) {
+	text := "{{.Reason}}{{.Reason}}"
+
+	got, err := Execute(text, Data{Reason: strings.Repeat("ä", MaxMessageLength/2)})
+	require.NoError(t, err)
+	assert.Equal(t, MaxMessageLength, utf8.RuneCountInString(got))
+
+	_, err = Execute(text, Data{Reason: strings.Repeat("a", MaxMessageLength/2+1)})
+	assert.Error(t, err)
+}
+
+func TestParseKind(t *testing.T) {
+	k, err := ParseKind("reminder")
+	require.NoError(t, err)
+	assert.Equal(t, KindReminder, k)
+
+	_, err = ParseKind("unknown")
+	assert.Error(t, err)
+}
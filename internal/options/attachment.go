@@ -0,0 +1,26 @@
+package options
+
+import (
+	"fmt"
+
+	"github.com/diamondburned/arikawa/v3/discord"
+
+	"github.com/jxs13/league-discord-bot/internal/i18n"
+)
+
+func OptionalAttachment(name string, data *discord.CommandInteraction) (_ discord.Attachment, ok bool, err error) {
+	o := data.Options.Find(name)
+	if o.Type == 0 {
+		return discord.Attachment{}, false, nil
+	}
+	s, err := o.SnowflakeValue()
+	if err != nil {
+		return discord.Attachment{}, false, fmt.Errorf("invalid attachment parameter %q: %w", name, err)
+	}
+
+	a, found := data.Resolved.Attachments[discord.AttachmentID(s)]
+	if !found {
+		return discord.Attachment{}, false, i18n.Errorf("error.attachment_unresolved", name)
+	}
+	return a, true, nil
+}
@@ -44,3 +44,16 @@ func BoolInt64Option(name string, options discord.CommandInteractionOptions) (in
 
 	return 0, true, nil
 }
+
+func BoolOption(name string, options discord.CommandInteractionOptions) (bool, bool, error) {
+	s := options.Find(name).String()
+	if s == "" {
+		return false, false, nil
+	}
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		return false, false, fmt.Errorf("invalid %q expected format: true, false: %w", name, err)
+	}
+
+	return b, true, nil
+}
@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/diamondburned/arikawa/v3/discord"
+
+	"github.com/jxs13/league-discord-bot/internal/i18n"
 )
 
 func Duration(name string, min, max time.Duration, options discord.CommandInteractionOptions) (time.Duration, error) {
@@ -15,9 +17,9 @@ func Duration(name string, min, max time.Duration, options discord.CommandIntera
 	}
 
 	if d < min {
-		return 0, fmt.Errorf("%q must be at least %s: %s", name, min, d)
+		return 0, i18n.Errorf("error.duration_min", name, min, d)
 	} else if d > max {
-		return 0, fmt.Errorf("%q must be at most %s: %s", name, max, d)
+		return 0, i18n.Errorf("error.duration_max", name, max, d)
 	}
 
 	return d, nil
@@ -35,9 +37,9 @@ func DurationOption(name string, min, max time.Duration, options discord.Command
 	}
 
 	if d < min {
-		return 0, false, fmt.Errorf("%q must be at least %s: %s", name, min, d)
+		return 0, false, i18n.Errorf("error.duration_min", name, min, d)
 	} else if d > max {
-		return 0, false, fmt.Errorf("%q must be at most %s: %s", name, max, d)
+		return 0, false, i18n.Errorf("error.duration_max", name, max, d)
 	}
 
 	return d, true, nil
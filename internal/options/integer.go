@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"github.com/diamondburned/arikawa/v3/discord"
+
+	"github.com/jxs13/league-discord-bot/internal/i18n"
 )
 
 func IntegerOption(name string, options discord.CommandInteractionOptions) (int64, error) {
@@ -20,7 +22,7 @@ func NotNegativeInteger(name string, options discord.CommandInteractionOptions)
 		return 0, err
 	}
 	if i < 0 {
-		return 0, fmt.Errorf("invalid integer parameter %q: must be non-negative", name)
+		return 0, i18n.Errorf("error.integer_negative", name)
 	}
 	return i, nil
 }
@@ -31,7 +33,7 @@ func MinInteger(name string, options discord.CommandInteractionOptions, min int6
 		return 0, err
 	}
 	if i < min {
-		return 0, fmt.Errorf("invalid integer parameter %q: must be at least %d", name, min)
+		return 0, i18n.Errorf("error.integer_min", name, min)
 	}
 	return i, nil
 }
@@ -42,10 +44,22 @@ func MinMaxInteger(name string, options discord.CommandInteractionOptions, min,
 		return 0, err
 	}
 	if i < min {
-		return 0, fmt.Errorf("invalid integer parameter %q: must be at least %d", name, min)
+		return 0, i18n.Errorf("error.integer_min", name, min)
 	}
 	if i > max {
-		return 0, fmt.Errorf("invalid integer parameter %q: must be at most %d", name, max)
+		return 0, i18n.Errorf("error.integer_max", name, max)
 	}
 	return i, nil
 }
+
+func OptionalInteger(name string, options discord.CommandInteractionOptions) (_ int64, ok bool, err error) {
+	o := options.Find(name)
+	if o.Type == 0 {
+		return 0, false, nil
+	}
+	i, err := o.IntValue()
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid integer parameter %q: %w", name, err)
+	}
+	return i, true, nil
+}
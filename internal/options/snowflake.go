@@ -49,3 +49,27 @@ func OptionalUserID(name string, options discord.CommandInteractionOptions) (_ d
 	}
 	return discord.UserID(s), true, nil
 }
+
+func OptionalRoleID(name string, options discord.CommandInteractionOptions) (_ discord.RoleID, ok bool, err error) {
+	o := options.Find(name)
+	if o.Type == 0 {
+		return 0, false, nil
+	}
+	s, err := o.SnowflakeValue()
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid role parameter %q: %w", name, err)
+	}
+	return discord.RoleID(s), true, nil
+}
+
+func OptionalChannelID(name string, options discord.CommandInteractionOptions) (_ discord.ChannelID, ok bool, err error) {
+	o := options.Find(name)
+	if o.Type == 0 {
+		return 0, false, nil
+	}
+	s, err := o.SnowflakeValue()
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid channel parameter %q: %w", name, err)
+	}
+	return discord.ChannelID(s), true, nil
+}
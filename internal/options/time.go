@@ -6,6 +6,7 @@ import (
 
 	"github.com/diamondburned/arikawa/v3/discord"
 	"github.com/jxs13/league-discord-bot/internal/format"
+	"github.com/jxs13/league-discord-bot/internal/i18n"
 	"github.com/jxs13/league-discord-bot/internal/parse"
 )
 
@@ -31,7 +32,7 @@ func FutureTimeInLocation(datetimeName, locationName string, offset time.Duratio
 	}
 
 	if time.Until(t) < offset.Abs() {
-		return time.Time{}, fmt.Errorf("invalid parameter %q: must be at least %s in the future", datetimeName, offset)
+		return time.Time{}, i18n.Errorf("error.time_future", datetimeName, offset)
 	}
 	return t, nil
 }
@@ -43,7 +44,7 @@ func TimeBetweenInLocation(datetimeName, locationName string, min, max time.Time
 	}
 
 	if t.Before(min) || t.After(max) {
-		return time.Time{}, fmt.Errorf("invalid parameter %q: must be between %s and %s, is %s",
+		return time.Time{}, i18n.Errorf("error.time_range",
 			datetimeName,
 			format.DiscordLongDateTime(min),
 			format.DiscordLongDateTime(max),
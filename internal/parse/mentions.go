@@ -0,0 +1,72 @@
+package parse
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/diamondburned/arikawa/v3/discord"
+
+	"github.com/jxs13/league-discord-bot/internal/i18n"
+)
+
+var (
+	roleMentionRegex = regexp.MustCompile(`<@&(\d+)>`)
+	userMentionRegex = regexp.MustCompile(`<@!?(\d+)>`)
+)
+
+// RoleMentions parses a whitespace or comma separated list of role mentions, e.g. "@team1 @team2".
+// Duplicates are removed while the order is preserved.
+func RoleMentions(input string) ([]discord.RoleID, error) {
+	ids, err := mentions(input, roleMentionRegex, "role")
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]discord.RoleID, 0, len(ids))
+	for _, id := range ids {
+		rid := discord.RoleID(id)
+		if !slices.Contains(result, rid) {
+			result = append(result, rid)
+		}
+	}
+	return result, nil
+}
+
+// UserMentions parses a whitespace or comma separated list of user mentions, e.g. "@user1 @user2".
+// Duplicates are removed while the order is preserved.
+func UserMentions(input string) ([]discord.UserID, error) {
+	ids, err := mentions(input, userMentionRegex, "user")
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]discord.UserID, 0, len(ids))
+	for _, id := range ids {
+		uid := discord.UserID(id)
+		if !slices.Contains(result, uid) {
+			result = append(result, uid)
+		}
+	}
+	return result, nil
+}
+
+func mentions(input string, re *regexp.Regexp, kind string) ([]discord.Snowflake, error) {
+	matches := re.FindAllStringSubmatchIndex(input, -1)
+
+	// everything between the mentions must be a separator
+	rest := re.ReplaceAllString(input, "")
+	if strings.Trim(rest, " ,\t\n") != "" {
+		return nil, i18n.Errorf("error.mention_list", kind, input, kind)
+	}
+
+	result := make([]discord.Snowflake, 0, len(matches))
+	for _, m := range matches {
+		s, err := Snowflake(input[m[2]:m[3]])
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, s)
+	}
+	return result, nil
+}
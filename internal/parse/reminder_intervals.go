@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"slices"
 	"time"
+
+	"github.com/jxs13/league-discord-bot/internal/i18n"
 )
 
 const (
@@ -17,7 +19,7 @@ func ReminderIntervals(input string) ([]time.Duration, error) {
 	}
 
 	if len(list) > MaxReminerIntervals {
-		return nil, fmt.Errorf("reminder intervals list cannot contain more than %d values", MaxReminerIntervals)
+		return nil, i18n.Errorf("error.reminder_intervals_count", MaxReminerIntervals)
 	}
 
 	slices.Sort(list)
@@ -26,7 +28,7 @@ func ReminderIntervals(input string) ([]time.Duration, error) {
 
 	for _, d := range list {
 		if d < time.Second {
-			return nil, fmt.Errorf("reminder intervals must be at least 1 second: %s", d)
+			return nil, i18n.Errorf("error.reminder_interval_min", d)
 		}
 	}
 
@@ -3,15 +3,18 @@ package parse
 import (
 	"fmt"
 	"time"
+
+	"github.com/jxs13/league-discord-bot/internal/i18n"
 )
 
 const (
 	LayoutDateTime = "2006-01-02 15:04"
+	LayoutDate     = "2006-01-02"
 )
 
 func Time(in string) (time.Time, error) {
 	if in == "" {
-		return time.Time{}, fmt.Errorf("empty time string")
+		return time.Time{}, i18n.Errorf("error.time_empty")
 	}
 
 	t, err := time.Parse(LayoutDateTime, in)
@@ -44,3 +47,17 @@ func TimeInLocation(datetime string, loc *time.Location) (time.Time, error) {
 
 	return t, nil
 }
+
+func DateInLocation(date string, loc *time.Location) (time.Time, error) {
+	t, err := time.ParseInLocation(LayoutDate, date, loc)
+	if err != nil {
+		return time.Time{},
+			fmt.Errorf("invalid date: `%s`: expected the following format: `%s`: %w",
+				date,
+				LayoutDate,
+				err,
+			)
+	}
+
+	return t, nil
+}
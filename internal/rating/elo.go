@@ -0,0 +1,96 @@
+package rating
+
+import (
+	"math"
+)
+
+const (
+	// DefaultRating is the initial rating of a team without any rated match.
+	DefaultRating = 1500.0
+	// DefaultK is the maximum rating change of a two team match.
+	DefaultK = 32.0
+)
+
+// TeamResult is the final score of a single team in a single match.
+// Results of the same match are expected to be consecutive and matches in chronological order.
+type TeamResult struct {
+	MatchID int64
+	TeamID  string
+	Score   int64
+	At      int64
+}
+
+// Change is the rating change of a single team caused by a single match.
+type Change struct {
+	MatchID int64
+	TeamID  string
+	Before  float64
+	After   float64
+	At      int64
+}
+
+// Expected returns the expected score of a team with rating a against a team with rating b.
+func Expected(a, b float64) float64 {
+	return 1 / (1 + math.Pow(10, (b-a)/400))
+}
+
+// Replay computes the ratings of all teams by applying the matches in the order of the results.
+// Matches with more than two teams are treated as pairwise matches between all of the teams,
+// the rating change is scaled down by the number of opponents.
+// Matches with less than two teams are ignored.
+func Replay(results []TeamResult, k float64) (ratings map[string]float64, history []Change) {
+	ratings = make(map[string]float64)
+	history = make([]Change, 0, len(results))
+
+	for start := 0; start < len(results); {
+		end := start + 1
+		for end < len(results) && results[end].MatchID == results[start].MatchID {
+			end++
+		}
+		teams := results[start:end]
+		start = end
+
+		if len(teams) < 2 {
+			continue
+		}
+
+		before := make([]float64, len(teams))
+		for i, t := range teams {
+			r, ok := ratings[t.TeamID]
+			if !ok {
+				r = DefaultRating
+			}
+			before[i] = r
+		}
+
+		scale := k / float64(len(teams)-1)
+		for i, t := range teams {
+			var delta float64
+			for j, o := range teams {
+				if i == j {
+					continue
+				}
+
+				actual := 0.5
+				if t.Score > o.Score {
+					actual = 1
+				} else if t.Score < o.Score {
+					actual = 0
+				}
+				delta += scale * (actual - Expected(before[i], before[j]))
+			}
+
+			after := before[i] + delta
+			ratings[t.TeamID] = after
+			history = append(history, Change{
+				MatchID: t.MatchID,
+				TeamID:  t.TeamID,
+				Before:  before[i],
+				After:   after,
+				At:      t.At,
+			})
+		}
+	}
+
+	return ratings, history
+}
@@ -0,0 +1,49 @@
+package rating
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpected(t *testing.T) {
+	assert.InDelta(t, 0.5, Expected(1500, 1500), 1e-9)
+	assert.InDelta(t, 0.909, Expected(1800, 1400), 1e-3)
+	assert.InDelta(t, 1, Expected(1800, 1400)+Expected(1400, 1800), 1e-9)
+}
+
+func TestReplay(t *testing.T) {
+	results := []TeamResult{
+		{MatchID: 1, TeamID: "a", Score: 3, At: 1},
+		{MatchID: 1, TeamID: "b", Score: 1, At: 1},
+		{MatchID: 2, TeamID: "a", Score: 2, At: 2},
+		{MatchID: 2, TeamID: "b", Score: 2, At: 2},
+		// incomplete match
+		{MatchID: 3, TeamID: "c", Score: 5, At: 3},
+	}
+
+	ratings, history := Replay(results, DefaultK)
+	assert.Len(t, ratings, 2)
+	assert.Len(t, history, 4)
+
+	assert.InDelta(t, 1516, history[0].After, 1e-9)
+	assert.InDelta(t, 1484, history[1].After, 1e-9)
+
+	// the favorite loses rating in a draw
+	assert.Less(t, ratings["a"], 1516.0)
+	assert.Greater(t, ratings["b"], 1484.0)
+	assert.InDelta(t, 2*DefaultRating, ratings["a"]+ratings["b"], 1e-9)
+}
+
+func TestReplayMultipleTeams(t *testing.T) {
+	results := []TeamResult{
+		{MatchID: 1, TeamID: "a", Score: 3},
+		{MatchID: 1, TeamID: "b", Score: 2},
+		{MatchID: 1, TeamID: "c", Score: 1},
+	}
+
+	ratings, _ := Replay(results, DefaultK)
+	assert.InDelta(t, 1516, ratings["a"], 1e-9)
+	assert.InDelta(t, 1500, ratings["b"], 1e-9)
+	assert.InDelta(t, 1484, ratings["c"], 1e-9)
+}
@@ -0,0 +1,55 @@
+package season
+
+// RoundRobin returns the rounds of a round-robin tournament in which every team plays every other team once.
+// In a double round-robin the second half of the rounds repeats the first half with swapped home and away teams.
+// With an odd number of teams, one team has a bye in every round.
+func RoundRobin[T any](teams []T, double bool) [][][2]T {
+	n := len(teams)
+	if n < 2 {
+		return nil
+	}
+
+	// the index -1 represents the bye of an odd number of teams
+	idx := make([]int, 0, n+1)
+	for i := range teams {
+		idx = append(idx, i)
+	}
+	if n%2 == 1 {
+		idx = append(idx, -1)
+	}
+	size := len(idx)
+
+	rounds := make([][][2]T, 0, size-1)
+	for r := 0; r < size-1; r++ {
+		round := make([][2]T, 0, size/2)
+		for i := 0; i < size/2; i++ {
+			home, away := idx[i], idx[size-1-i]
+			if home < 0 || away < 0 {
+				continue
+			}
+
+			// alternate the fixed team's home games
+			if i == 0 && r%2 == 1 {
+				home, away = away, home
+			}
+			round = append(round, [2]T{teams[home], teams[away]})
+		}
+		rounds = append(rounds, round)
+
+		// circle method: the first team stays in place, all others rotate
+		last := idx[size-1]
+		copy(idx[2:], idx[1:size-1])
+		idx[1] = last
+	}
+
+	if double {
+		for _, round := range rounds[:len(rounds):len(rounds)] {
+			mirrored := make([][2]T, 0, len(round))
+			for _, p := range round {
+				mirrored = append(mirrored, [2]T{p[1], p[0]})
+			}
+			rounds = append(rounds, mirrored)
+		}
+	}
+	return rounds
+}
@@ -0,0 +1,86 @@
+package season
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoundRobin(t *testing.T) {
+	for _, n := range []int{2, 3, 4, 5, 10} {
+		teams := make([]int, 0, n)
+		for i := range n {
+			teams = append(teams, i)
+		}
+
+		rounds := RoundRobin(teams, false)
+		played := make(map[[2]int]int)
+		for _, round := range rounds {
+			inRound := make(map[int]bool)
+			for _, p := range round {
+				assert.NotEqual(t, p[0], p[1])
+				assert.False(t, inRound[p[0]], "team plays twice in one round")
+				assert.False(t, inRound[p[1]], "team plays twice in one round")
+				inRound[p[0]], inRound[p[1]] = true, true
+
+				a, b := min(p[0], p[1]), max(p[0], p[1])
+				played[[2]int{a, b}]++
+			}
+		}
+
+		assert.Len(t, played, n*(n-1)/2)
+		for pair, cnt := range played {
+			assert.Equal(t, 1, cnt, "pair %v", pair)
+		}
+	}
+}
+
+func TestDoubleRoundRobin(t *testing.T) {
+	rounds := RoundRobin([]string{"a", "b", "c", "d"}, true)
+	require.Len(t, rounds, 6)
+
+	for i := range 3 {
+		for j, p := range rounds[i] {
+			assert.Equal(t, [2]string{p[1], p[0]}, rounds[i+3][j])
+		}
+	}
+}
+
+func TestParseSlots(t *testing.T) {
+	slots, err := ParseSlots("sun 20:30, Saturday 18:00,sat 18:00")
+	require.NoError(t, err)
+	assert.Equal(t, []Slot{
+		{Weekday: time.Sunday, Hour: 20, Minute: 30},
+		{Weekday: time.Saturday, Hour: 18, Minute: 0},
+	}, slots)
+
+	_, err = ParseSlots("someday 18:00")
+	assert.Error(t, err)
+
+	_, err = ParseSlots("sat 25:00")
+	assert.Error(t, err)
+
+	_, err = ParseSlots(" , ")
+	assert.Error(t, err)
+}
+
+func TestTimes(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	slots := []Slot{
+		{Weekday: time.Saturday, Hour: 18},
+		{Weekday: time.Sunday, Hour: 20, Minute: 30},
+	}
+
+	// Saturday, 2025-10-25, daylight saving time ends on the following day
+	start := time.Date(2025, 10, 25, 19, 0, 0, 0, loc)
+	times := Times(slots, start, 3)
+	assert.Equal(t, []time.Time{
+		time.Date(2025, 10, 26, 20, 30, 0, 0, loc),
+		time.Date(2025, 11, 1, 18, 0, 0, 0, loc),
+		time.Date(2025, 11, 2, 20, 30, 0, 0, loc),
+	}, times)
+}
@@ -0,0 +1,109 @@
+package season
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// Slot is a weekly recurring point in time at which a match can take place.
+type Slot struct {
+	Weekday time.Weekday
+	Hour    int
+	Minute  int
+}
+
+func (s Slot) String() string {
+	return fmt.Sprintf("%s %02d:%02d", s.Weekday.String()[:3], s.Hour, s.Minute)
+}
+
+// ParseSlots parses a comma separated list of weekly slots, e.g. "sat 18:00, sun 20:30".
+func ParseSlots(input string) ([]Slot, error) {
+	parts := strings.Split(input, ",")
+	slots := make([]Slot, 0, len(parts))
+	for _, part := range parts {
+		fields := strings.Fields(strings.ToLower(part))
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("invalid slot %q: expected format: weekday hh:mm, e.g. sat 18:00", strings.TrimSpace(part))
+		}
+
+		name := fields[0]
+		if len(name) > 3 {
+			name = name[:3]
+		}
+		wd, ok := weekdays[name]
+		if !ok {
+			return nil, fmt.Errorf("invalid weekday in slot %q: expected one of mon, tue, wed, thu, fri, sat, sun", strings.TrimSpace(part))
+		}
+
+		t, err := time.Parse("15:04", fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid time in slot %q: expected format hh:mm: %w", strings.TrimSpace(part), err)
+		}
+
+		slots = append(slots, Slot{Weekday: wd, Hour: t.Hour(), Minute: t.Minute()})
+	}
+
+	if len(slots) == 0 {
+		return nil, fmt.Errorf("at least one slot is required, e.g. sat 18:00")
+	}
+
+	slices.SortFunc(slots, compareSlots)
+	return slices.Compact(slots), nil
+}
+
+// Times returns the next n points in time of the weekly slots, starting at the given point in time.
+// The slots are interpreted in the location of start.
+func Times(slots []Slot, start time.Time, n int) []time.Time {
+	if len(slots) == 0 || n <= 0 {
+		return nil
+	}
+
+	sorted := slices.Clone(slots)
+	slices.SortFunc(sorted, compareSlots)
+
+	result := make([]time.Time, 0, n)
+	year, month, day := start.Date()
+	for d := 0; len(result) < n; d++ {
+		// AddDate keeps the wall clock time across daylight saving time changes
+		date := time.Date(year, month, day, 0, 0, 0, 0, start.Location()).AddDate(0, 0, d)
+		for _, s := range sorted {
+			if s.Weekday != date.Weekday() {
+				continue
+			}
+
+			t := time.Date(date.Year(), date.Month(), date.Day(), s.Hour, s.Minute, 0, 0, start.Location())
+			if t.Before(start) {
+				continue
+			}
+			result = append(result, t)
+			if len(result) == n {
+				break
+			}
+		}
+	}
+	return result
+}
+
+func compareSlots(a, b Slot) int {
+	return cmp.Or(
+		cmp.Compare(a.Weekday, b.Weekday),
+		cmp.Compare(a.Hour, b.Hour),
+		cmp.Compare(a.Minute, b.Minute),
+	)
+}
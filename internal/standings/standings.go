@@ -0,0 +1,121 @@
+package standings
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Points are awarded per match depending on its outcome.
+type Points struct {
+	Win  int64
+	Draw int64
+	Loss int64
+}
+
+// TeamResult is the final score of a single team in a single match.
+type TeamResult struct {
+	MatchID int64
+	TeamID  string
+	Score   int64
+}
+
+type Row struct {
+	TeamID       string
+	Played       int64
+	Wins         int64
+	Draws        int64
+	Losses       int64
+	ScoreFor     int64
+	ScoreAgainst int64
+	Points       int64
+}
+
+func (r Row) ScoreDifference() int64 {
+	return r.ScoreFor - r.ScoreAgainst
+}
+
+// Compute aggregates the results of all matches into a sorted standings table.
+// The teams with the highest score of a match win it, multiple teams with the highest score draw.
+// The score against a team is the highest score of its opponents in that match.
+// Matches with less than two teams are ignored.
+func Compute(results []TeamResult, points Points) []Row {
+	matches := make(map[int64][]TeamResult)
+	order := make([]int64, 0)
+	for _, r := range results {
+		if _, ok := matches[r.MatchID]; !ok {
+			order = append(order, r.MatchID)
+		}
+		matches[r.MatchID] = append(matches[r.MatchID], r)
+	}
+
+	rows := make(map[string]*Row)
+	for _, matchID := range order {
+		teams := matches[matchID]
+		if len(teams) < 2 {
+			continue
+		}
+
+		best := slices.MaxFunc(teams, func(a, b TeamResult) int {
+			return cmp.Compare(a.Score, b.Score)
+		}).Score
+
+		winners := 0
+		for _, t := range teams {
+			if t.Score == best {
+				winners++
+			}
+		}
+
+		for _, t := range teams {
+			row, ok := rows[t.TeamID]
+			if !ok {
+				row = &Row{TeamID: t.TeamID}
+				rows[t.TeamID] = row
+			}
+
+			var against int64
+			first := true
+			for _, o := range teams {
+				if o.TeamID == t.TeamID {
+					continue
+				}
+				if first || o.Score > against {
+					against = o.Score
+					first = false
+				}
+			}
+
+			row.Played++
+			row.ScoreFor += t.Score
+			row.ScoreAgainst += against
+
+			switch {
+			case t.Score < best:
+				row.Losses++
+				row.Points += points.Loss
+			case winners > 1:
+				row.Draws++
+				row.Points += points.Draw
+			default:
+				row.Wins++
+				row.Points += points.Win
+			}
+		}
+	}
+
+	table := make([]Row, 0, len(rows))
+	for _, row := range rows {
+		table = append(table, *row)
+	}
+
+	slices.SortFunc(table, func(a, b Row) int {
+		return cmp.Or(
+			cmp.Compare(b.Points, a.Points),
+			cmp.Compare(b.ScoreDifference(), a.ScoreDifference()),
+			cmp.Compare(b.ScoreFor, a.ScoreFor),
+			cmp.Compare(b.Wins, a.Wins),
+			cmp.Compare(a.TeamID, b.TeamID),
+		)
+	})
+	return table
+}
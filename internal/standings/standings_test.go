@@ -0,0 +1,44 @@
+package standings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompute(t *testing.T) {
+	points := Points{Win: 3, Draw: 1, Loss: 0}
+	results := []TeamResult{
+		{MatchID: 1, TeamID: "a", Score: 3},
+		{MatchID: 1, TeamID: "b", Score: 1},
+		{MatchID: 2, TeamID: "b", Score: 2},
+		{MatchID: 2, TeamID: "c", Score: 2},
+		{MatchID: 3, TeamID: "a", Score: 0},
+		{MatchID: 3, TeamID: "c", Score: 1},
+		// incomplete match
+		{MatchID: 4, TeamID: "a", Score: 10},
+	}
+
+	table := Compute(results, points)
+	assert.Equal(t, []Row{
+		{TeamID: "c", Played: 2, Wins: 1, Draws: 1, Losses: 0, ScoreFor: 3, ScoreAgainst: 2, Points: 4},
+		{TeamID: "a", Played: 2, Wins: 1, Draws: 0, Losses: 1, ScoreFor: 3, ScoreAgainst: 2, Points: 3},
+		{TeamID: "b", Played: 2, Wins: 0, Draws: 1, Losses: 1, ScoreFor: 3, ScoreAgainst: 5, Points: 1},
+	}, table)
+}
+
+func TestComputeMultipleTeams(t *testing.T) {
+	points := Points{Win: 2, Draw: 1, Loss: -1}
+	results := []TeamResult{
+		{MatchID: 1, TeamID: "a", Score: 5},
+		{MatchID: 1, TeamID: "b", Score: 5},
+		{MatchID: 1, TeamID: "c", Score: 1},
+	}
+
+	table := Compute(results, points)
+	assert.Equal(t, []Row{
+		{TeamID: "a", Played: 1, Draws: 1, ScoreFor: 5, ScoreAgainst: 5, Points: 1},
+		{TeamID: "b", Played: 1, Draws: 1, ScoreFor: 5, ScoreAgainst: 5, Points: 1},
+		{TeamID: "c", Played: 1, Losses: 1, ScoreFor: 1, ScoreAgainst: 5, Points: -1},
+	}, table)
+}
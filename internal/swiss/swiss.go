@@ -0,0 +1,174 @@
+package swiss
+
+import (
+	"cmp"
+	"slices"
+)
+
+const (
+	PointsWin  = 1.0
+	PointsDraw = 0.5
+	PointsBye  = 1.0
+)
+
+// maxPairingSteps limits the search for pairings without rematches.
+// Once exceeded, rematches are allowed.
+const maxPairingSteps = 100_000
+
+// Match is a match between two teams of a previous round.
+// Matches without a final result still count for the rematch avoidance.
+type Match struct {
+	Round    int
+	Teams    [2]string
+	Scores   [2]int64
+	Finished bool
+}
+
+type Row struct {
+	TeamID   string
+	Played   int
+	Wins     int
+	Draws    int
+	Losses   int
+	Byes     int
+	Points   float64
+	Buchholz float64
+}
+
+// Standings ranks all teams by their points and by the Buchholz score,
+// which is the sum of the points of all opponents a team played against.
+func Standings(teams []string, matches []Match, byes []string) []Row {
+	rows := make(map[string]*Row, len(teams))
+	for _, t := range teams {
+		rows[t] = &Row{TeamID: t}
+	}
+	get := func(t string) *Row {
+		r, ok := rows[t]
+		if !ok {
+			r = &Row{TeamID: t}
+			rows[t] = r
+		}
+		return r
+	}
+
+	for _, t := range byes {
+		r := get(t)
+		r.Byes++
+		r.Points += PointsBye
+	}
+
+	opponents := make(map[string][]string, len(teams))
+	for _, m := range matches {
+		if !m.Finished {
+			continue
+		}
+
+		a, b := get(m.Teams[0]), get(m.Teams[1])
+		a.Played++
+		b.Played++
+		opponents[a.TeamID] = append(opponents[a.TeamID], b.TeamID)
+		opponents[b.TeamID] = append(opponents[b.TeamID], a.TeamID)
+
+		switch {
+		case m.Scores[0] > m.Scores[1]:
+			a.Wins++
+			a.Points += PointsWin
+			b.Losses++
+		case m.Scores[0] < m.Scores[1]:
+			b.Wins++
+			b.Points += PointsWin
+			a.Losses++
+		default:
+			a.Draws++
+			b.Draws++
+			a.Points += PointsDraw
+			b.Points += PointsDraw
+		}
+	}
+
+	result := make([]Row, 0, len(rows))
+	for id, r := range rows {
+		for _, o := range opponents[id] {
+			r.Buchholz += rows[o].Points
+		}
+		result = append(result, *r)
+	}
+
+	slices.SortFunc(result, func(a, b Row) int {
+		return cmp.Or(
+			cmp.Compare(b.Points, a.Points),
+			cmp.Compare(b.Buchholz, a.Buchholz),
+			cmp.Compare(b.Wins, a.Wins),
+			cmp.Compare(a.TeamID, b.TeamID),
+		)
+	})
+	return result
+}
+
+// Pair pairs the teams of the given standings for the next round.
+// Teams are paired with teams of a similar record and rematches are avoided if possible.
+// In case of an odd number of teams, the lowest ranked team without a previous bye receives a bye.
+func Pair(standings []Row, matches []Match, byes []string) (pairs [][2]string, bye string) {
+	teams := make([]string, 0, len(standings))
+	for _, r := range standings {
+		teams = append(teams, r.TeamID)
+	}
+
+	if len(teams)%2 == 1 {
+		idx := len(teams) - 1
+		for i := len(teams) - 1; i >= 0; i-- {
+			if !slices.Contains(byes, teams[i]) {
+				idx = i
+				break
+			}
+		}
+		bye = teams[idx]
+		teams = slices.Delete(teams, idx, idx+1)
+	}
+
+	played := make(map[[2]string]bool, len(matches))
+	for _, m := range matches {
+		played[key(m.Teams[0], m.Teams[1])] = true
+	}
+
+	steps := 0
+	pairs, ok := pair(teams, played, &steps)
+	if !ok {
+		pairs, _ = pair(teams, nil, &steps)
+	}
+	return pairs, bye
+}
+
+func pair(teams []string, played map[[2]string]bool, steps *int) ([][2]string, bool) {
+	if len(teams) == 0 {
+		return [][2]string{}, true
+	}
+
+	first := teams[0]
+	for j := 1; j < len(teams); j++ {
+		*steps++
+		if played != nil && *steps > maxPairingSteps {
+			return nil, false
+		}
+		if played[key(first, teams[j])] {
+			continue
+		}
+
+		rest := make([]string, 0, len(teams)-2)
+		rest = append(rest, teams[1:j]...)
+		rest = append(rest, teams[j+1:]...)
+
+		pairs, ok := pair(rest, played, steps)
+		if ok {
+			return append([][2]string{{first, teams[j]}}, pairs...), true
+		}
+	}
+	return nil, false
+}
+
+func key(a, b string) [2]string {
+	if a > b {
+		a, b = b, a
+	}
+	return [2]string{a, b}
+}
@@ -0,0 +1,62 @@
+package swiss
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStandings(t *testing.T) {
+	teams := []string{"a", "b", "c", "d", "e"}
+	matches := []Match{
+		{Round: 1, Teams: [2]string{"a", "b"}, Scores: [2]int64{3, 1}, Finished: true},
+		{Round: 1, Teams: [2]string{"c", "d"}, Scores: [2]int64{2, 2}, Finished: true},
+		{Round: 2, Teams: [2]string{"a", "c"}, Scores: [2]int64{0, 1}, Finished: true},
+		// not finished yet
+		{Round: 2, Teams: [2]string{"e", "b"}},
+	}
+
+	rows := Standings(teams, matches, []string{"e"})
+	assert.Equal(t, []Row{
+		{TeamID: "c", Played: 2, Wins: 1, Draws: 1, Points: 1.5, Buchholz: 1.5},
+		{TeamID: "a", Played: 2, Wins: 1, Losses: 1, Points: 1, Buchholz: 1.5},
+		{TeamID: "e", Byes: 1, Points: 1},
+		{TeamID: "d", Played: 1, Draws: 1, Points: 0.5, Buchholz: 1.5},
+		{TeamID: "b", Played: 1, Losses: 1, Buchholz: 1},
+	}, rows)
+}
+
+func TestPairFirstRound(t *testing.T) {
+	rows := Standings([]string{"a", "b", "c", "d", "e"}, nil, nil)
+
+	pairs, bye := Pair(rows, nil, nil)
+	assert.Equal(t, "e", bye)
+	assert.Equal(t, [][2]string{{"a", "b"}, {"c", "d"}}, pairs)
+}
+
+func TestPairAvoidsRematchesAndRepeatedByes(t *testing.T) {
+	teams := []string{"a", "b", "c", "d", "e"}
+	matches := []Match{
+		{Round: 1, Teams: [2]string{"a", "b"}, Scores: [2]int64{1, 0}, Finished: true},
+		{Round: 1, Teams: [2]string{"c", "d"}, Scores: [2]int64{1, 0}, Finished: true},
+	}
+	byes := []string{"e"}
+
+	rows := Standings(teams, matches, byes)
+	pairs, bye := Pair(rows, matches, byes)
+
+	// d is the lowest ranked team without a bye
+	assert.Equal(t, "d", bye)
+	assert.ElementsMatch(t, [][2]string{{"a", "c"}, {"e", "b"}}, pairs)
+}
+
+func TestPairFallsBackToRematches(t *testing.T) {
+	matches := []Match{
+		{Round: 1, Teams: [2]string{"a", "b"}, Scores: [2]int64{1, 0}, Finished: true},
+	}
+
+	rows := Standings([]string{"a", "b"}, matches, nil)
+	pairs, bye := Pair(rows, matches, nil)
+	assert.Empty(t, bye)
+	assert.Equal(t, [][2]string{{"a", "b"}}, pairs)
+}
@@ -0,0 +1,2 @@
+ALTER TABLE matches DROP COLUMN cancel_reason;
+ALTER TABLE matches DROP COLUMN cancelled_at;
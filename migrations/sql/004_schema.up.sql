@@ -0,0 +1,2 @@
+ALTER TABLE matches ADD COLUMN cancelled_at INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE matches ADD COLUMN cancel_reason TEXT NOT NULL DEFAULT '';
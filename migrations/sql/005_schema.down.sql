@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS team_results;
+DROP TABLE IF EXISTS results;
@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS results (
+    guild_id        TEXT NOT NULL REFERENCES guild_config(guild_id) ON DELETE CASCADE,
+    channel_id      TEXT PRIMARY KEY NOT NULL,
+    channel_name    TEXT NOT NULL,
+    scheduled_at    INTEGER NOT NULL,
+    reported_at     INTEGER NOT NULL,
+    reported_by     TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_results_guild_id ON results (guild_id);
+
+CREATE TABLE IF NOT EXISTS team_results (
+    channel_id      TEXT NOT NULL REFERENCES results(channel_id) ON DELETE CASCADE,
+    role_id         TEXT NOT NULL,
+    score           INTEGER NOT NULL DEFAULT 0,
+    time            INTEGER NOT NULL DEFAULT 0,
+    screenshot      BLOB,
+    screenshot_name TEXT NOT NULL DEFAULT '',
+    demo            BLOB,
+    demo_name       TEXT NOT NULL DEFAULT '',
+    reported_at     INTEGER NOT NULL,
+    reported_by     TEXT NOT NULL,
+    PRIMARY KEY(channel_id, role_id)
+);
+CREATE INDEX IF NOT EXISTS idx_team_results_channel_id_role_id ON team_results (channel_id, role_id);
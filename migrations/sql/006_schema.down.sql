@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS result_confirmations;
+
+ALTER TABLE results DROP COLUMN finalized_by;
+ALTER TABLE results DROP COLUMN finalized_at;
+ALTER TABLE results DROP COLUMN message_id;
+ALTER TABLE results DROP COLUMN status;
@@ -0,0 +1,13 @@
+ALTER TABLE results ADD COLUMN status TEXT NOT NULL DEFAULT 'PENDING';
+ALTER TABLE results ADD COLUMN message_id TEXT NOT NULL DEFAULT '';
+ALTER TABLE results ADD COLUMN finalized_at INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE results ADD COLUMN finalized_by TEXT NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS result_confirmations (
+    channel_id      TEXT NOT NULL REFERENCES results(channel_id) ON DELETE CASCADE,
+    role_id         TEXT NOT NULL,
+    user_id         TEXT NOT NULL,
+    confirmed_at    INTEGER NOT NULL,
+    PRIMARY KEY(channel_id, role_id)
+);
+CREATE INDEX IF NOT EXISTS idx_result_confirmations_channel_id_role_id ON result_confirmations (channel_id, role_id);
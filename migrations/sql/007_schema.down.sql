@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS standings_messages;
+
+ALTER TABLE guild_config DROP COLUMN points_loss;
+ALTER TABLE guild_config DROP COLUMN points_draw;
+ALTER TABLE guild_config DROP COLUMN points_win;
@@ -0,0 +1,9 @@
+ALTER TABLE guild_config ADD COLUMN points_win INTEGER NOT NULL DEFAULT 3;
+ALTER TABLE guild_config ADD COLUMN points_draw INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE guild_config ADD COLUMN points_loss INTEGER NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS standings_messages (
+    guild_id    TEXT PRIMARY KEY NOT NULL REFERENCES guild_config(guild_id) ON DELETE CASCADE,
+    channel_id  TEXT NOT NULL,
+    message_id  TEXT NOT NULL DEFAULT ''
+);
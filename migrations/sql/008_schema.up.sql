@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS team_ratings (
+    guild_id    TEXT NOT NULL REFERENCES guild_config(guild_id) ON DELETE CASCADE,
+    role_id     TEXT NOT NULL,
+    rating      REAL NOT NULL,
+    matches     INTEGER NOT NULL DEFAULT 0,
+    updated_at  INTEGER NOT NULL,
+    PRIMARY KEY(guild_id, role_id)
+);
+
+CREATE TABLE IF NOT EXISTS rating_history (
+    guild_id        TEXT NOT NULL REFERENCES guild_config(guild_id) ON DELETE CASCADE,
+    role_id         TEXT NOT NULL,
+    channel_id      TEXT NOT NULL,
+    rating_before   REAL NOT NULL,
+    rating_after    REAL NOT NULL,
+    rated_at        INTEGER NOT NULL,
+    PRIMARY KEY(channel_id, role_id)
+);
+CREATE INDEX IF NOT EXISTS idx_rating_history_guild_id_role_id_rated_at ON rating_history (guild_id, role_id, rated_at);
@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS seasons (
+    season_id               INTEGER PRIMARY KEY AUTOINCREMENT,
+    guild_id                TEXT NOT NULL REFERENCES guild_config(guild_id) ON DELETE CASCADE,
+    participants_per_team   INTEGER NOT NULL DEFAULT 0,
+    confirmed               INTEGER NOT NULL DEFAULT 0,
+    created_at              INTEGER NOT NULL,
+    created_by              TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_seasons_guild_id_created_by ON seasons (guild_id, created_by);
+
+CREATE TABLE IF NOT EXISTS fixtures (
+    fixture_id      INTEGER PRIMARY KEY AUTOINCREMENT,
+    season_id       INTEGER NOT NULL REFERENCES seasons(season_id) ON DELETE CASCADE,
+    round           INTEGER NOT NULL,
+    scheduled_at    INTEGER NOT NULL,
+    moderator_id    TEXT NOT NULL,
+    channel_id      TEXT NOT NULL DEFAULT ''
+);
+CREATE INDEX IF NOT EXISTS idx_fixtures_season_id ON fixtures (season_id);
+CREATE INDEX IF NOT EXISTS idx_fixtures_scheduled_at ON fixtures (scheduled_at);
+
+CREATE TABLE IF NOT EXISTS fixture_teams (
+    fixture_id  INTEGER NOT NULL REFERENCES fixtures(fixture_id) ON DELETE CASCADE,
+    role_id     TEXT NOT NULL,
+    position    INTEGER NOT NULL,
+    PRIMARY KEY(fixture_id, role_id)
+);
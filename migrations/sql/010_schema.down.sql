@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS bracket_slots;
+DROP TABLE IF EXISTS brackets;
@@ -0,0 +1,36 @@
+CREATE TABLE IF NOT EXISTS brackets (
+    bracket_id      INTEGER PRIMARY KEY AUTOINCREMENT,
+    guild_id        TEXT NOT NULL REFERENCES guild_config(guild_id) ON DELETE CASCADE,
+    season_id       INTEGER NOT NULL REFERENCES seasons(season_id) ON DELETE CASCADE,
+    name            TEXT NOT NULL,
+    kind            TEXT NOT NULL,
+    slots           TEXT NOT NULL,
+    location        TEXT NOT NULL,
+    moderator_ids   TEXT NOT NULL,
+    moderator_turn  INTEGER NOT NULL DEFAULT 0,
+    channel_id      TEXT NOT NULL DEFAULT '',
+    created_at      INTEGER NOT NULL,
+    created_by      TEXT NOT NULL,
+    UNIQUE(guild_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS bracket_slots (
+    bracket_id  INTEGER NOT NULL REFERENCES brackets(bracket_id) ON DELETE CASCADE,
+    slot_index  INTEGER NOT NULL,
+    section     TEXT NOT NULL,
+    round       INTEGER NOT NULL,
+    position    INTEGER NOT NULL,
+    team_1      TEXT NOT NULL DEFAULT '',
+    team_2      TEXT NOT NULL DEFAULT '',
+    bye_1       INTEGER NOT NULL DEFAULT 0,
+    bye_2       INTEGER NOT NULL DEFAULT 0,
+    winner_to   INTEGER NOT NULL,
+    winner_side INTEGER NOT NULL,
+    loser_to    INTEGER NOT NULL,
+    loser_side  INTEGER NOT NULL,
+    done        INTEGER NOT NULL DEFAULT 0,
+    winner      TEXT NOT NULL DEFAULT '',
+    fixture_id  INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY(bracket_id, slot_index)
+);
+CREATE INDEX IF NOT EXISTS idx_bracket_slots_fixture_id ON bracket_slots (fixture_id);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS swiss_byes;
+DROP TABLE IF EXISTS swiss_teams;
+DROP TABLE IF EXISTS swiss_tournaments;
@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS swiss_tournaments (
+    swiss_id        INTEGER PRIMARY KEY AUTOINCREMENT,
+    guild_id        TEXT NOT NULL REFERENCES guild_config(guild_id) ON DELETE CASCADE,
+    season_id       INTEGER NOT NULL REFERENCES seasons(season_id) ON DELETE CASCADE,
+    name            TEXT NOT NULL,
+    slots           TEXT NOT NULL,
+    location        TEXT NOT NULL,
+    moderator_ids   TEXT NOT NULL,
+    moderator_turn  INTEGER NOT NULL DEFAULT 0,
+    current_round   INTEGER NOT NULL DEFAULT 0,
+    created_at      INTEGER NOT NULL,
+    created_by      TEXT NOT NULL,
+    UNIQUE(guild_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS swiss_teams (
+    swiss_id    INTEGER NOT NULL REFERENCES swiss_tournaments(swiss_id) ON DELETE CASCADE,
+    role_id     TEXT NOT NULL,
+    PRIMARY KEY(swiss_id, role_id)
+);
+
+CREATE TABLE IF NOT EXISTS swiss_byes (
+    swiss_id    INTEGER NOT NULL REFERENCES swiss_tournaments(swiss_id) ON DELETE CASCADE,
+    round       INTEGER NOT NULL,
+    role_id     TEXT NOT NULL,
+    PRIMARY KEY(swiss_id, round)
+);
@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS registered_teams (
+    guild_id    TEXT NOT NULL REFERENCES guild_config(guild_id) ON DELETE CASCADE,
+    role_id     TEXT NOT NULL,
+    name        TEXT NOT NULL,
+    tag         TEXT NOT NULL DEFAULT '',
+    logo_url    TEXT NOT NULL DEFAULT '',
+    created_at  INTEGER NOT NULL,
+    created_by  TEXT NOT NULL,
+    updated_at  INTEGER NOT NULL,
+    updated_by  TEXT NOT NULL,
+    PRIMARY KEY(guild_id, role_id),
+    UNIQUE(guild_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS team_members (
+    guild_id    TEXT NOT NULL,
+    role_id     TEXT NOT NULL,
+    user_id     TEXT NOT NULL,
+    captain     INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY(guild_id, role_id, user_id),
+    FOREIGN KEY(guild_id, role_id) REFERENCES registered_teams(guild_id, role_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_team_members_guild_id_user_id ON team_members (guild_id, user_id);
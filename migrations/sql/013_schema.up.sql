@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS participants (
+    channel_id  TEXT NOT NULL,
+    role_id     TEXT NOT NULL,
+    user_id     TEXT NOT NULL,
+    joined_at   INTEGER NOT NULL,
+    PRIMARY KEY(channel_id, user_id),
+    FOREIGN KEY(channel_id, role_id) REFERENCES teams(channel_id, role_id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_participants_channel_id_role_id ON participants (channel_id, role_id);
@@ -0,0 +1 @@
+ALTER TABLE participants ADD COLUMN reaction INTEGER NOT NULL DEFAULT 0;
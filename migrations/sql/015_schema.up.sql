@@ -0,0 +1 @@
+ALTER TABLE participants ADD COLUMN substitute INTEGER NOT NULL DEFAULT 0;
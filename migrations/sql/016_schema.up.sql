@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS message_templates (
+    guild_id    TEXT NOT NULL REFERENCES guild_config(guild_id) ON DELETE CASCADE,
+    kind        TEXT NOT NULL,
+    template    TEXT NOT NULL,
+    updated_at  INTEGER NOT NULL,
+    updated_by  TEXT NOT NULL,
+    PRIMARY KEY(guild_id, kind)
+);
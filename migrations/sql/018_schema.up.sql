@@ -0,0 +1 @@
+ALTER TABLE guild_config ADD COLUMN output_mode TEXT NOT NULL DEFAULT 'text';
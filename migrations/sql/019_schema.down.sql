@@ -0,0 +1,38 @@
+CREATE TABLE IF NOT EXISTS announcements_old (
+    guild_id                TEXT PRIMARY KEY NOT NULL REFERENCES guild_config(guild_id) ON DELETE CASCADE,
+    starts_at               INTEGER NOT NULL,
+    ends_at                 INTEGER NOT NULL,
+    channel_id              TEXT NOT NULL,
+    interval                INTEGER NOT NULL DEFAULT 604800,
+    last_announced_at       INTEGER NOT NULL DEFAULT 0,
+    custom_text_before      TEXT NOT NULL DEFAULT '',
+    custom_text_after       TEXT NOT NULL DEFAULT ''
+);
+
+-- only one announcement per guild can be kept, the default announcement is preferred
+INSERT OR IGNORE INTO announcements_old (
+    guild_id,
+    starts_at,
+    ends_at,
+    channel_id,
+    interval,
+    last_announced_at,
+    custom_text_before,
+    custom_text_after
+)
+SELECT
+    guild_id,
+    starts_at,
+    ends_at,
+    channel_id,
+    interval,
+    last_announced_at,
+    custom_text_before,
+    custom_text_after
+FROM announcements
+ORDER BY guild_id, name != 'default', name;
+
+DROP TABLE announcements;
+ALTER TABLE announcements_old RENAME TO announcements;
+
+CREATE INDEX IF NOT EXISTS idx_announcements_starts_at_end_at ON announcements (starts_at, ends_at);
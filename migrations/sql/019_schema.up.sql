@@ -0,0 +1,40 @@
+CREATE TABLE IF NOT EXISTS announcements_new (
+    guild_id                TEXT NOT NULL REFERENCES guild_config(guild_id) ON DELETE CASCADE,
+    name                    TEXT NOT NULL,
+    starts_at               INTEGER NOT NULL,
+    ends_at                 INTEGER NOT NULL,
+    channel_id              TEXT NOT NULL,
+    interval                INTEGER NOT NULL DEFAULT 604800,
+    last_announced_at       INTEGER NOT NULL DEFAULT 0,
+    custom_text_before      TEXT NOT NULL DEFAULT '',
+    custom_text_after       TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY (guild_id, name)
+);
+
+INSERT INTO announcements_new (
+    guild_id,
+    name,
+    starts_at,
+    ends_at,
+    channel_id,
+    interval,
+    last_announced_at,
+    custom_text_before,
+    custom_text_after
+)
+SELECT
+    guild_id,
+    'default',
+    starts_at,
+    ends_at,
+    channel_id,
+    interval,
+    last_announced_at,
+    custom_text_before,
+    custom_text_after
+FROM announcements;
+
+DROP TABLE announcements;
+ALTER TABLE announcements_new RENAME TO announcements;
+
+CREATE INDEX IF NOT EXISTS idx_announcements_starts_at_end_at ON announcements (starts_at, ends_at);
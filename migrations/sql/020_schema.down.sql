@@ -0,0 +1,10 @@
+-- calendar schedules cannot be represented by a fixed interval, the time until their next announcement is used instead
+UPDATE announcements
+SET
+    interval = max(60, next_announced_at - last_announced_at),
+    last_announced_at = next_announced_at - max(60, next_announced_at - last_announced_at)
+WHERE schedule != '';
+
+ALTER TABLE announcements DROP COLUMN next_announced_at;
+ALTER TABLE announcements DROP COLUMN location;
+ALTER TABLE announcements DROP COLUMN schedule;
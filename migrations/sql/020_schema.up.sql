@@ -0,0 +1,6 @@
+ALTER TABLE announcements ADD COLUMN schedule TEXT NOT NULL DEFAULT '';
+ALTER TABLE announcements ADD COLUMN location TEXT NOT NULL DEFAULT '';
+ALTER TABLE announcements ADD COLUMN next_announced_at INTEGER NOT NULL DEFAULT 0;
+
+UPDATE announcements
+SET next_announced_at = last_announced_at + interval;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS schedule_board_messages;
+DROP TABLE IF EXISTS schedule_boards;
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS schedule_boards (
+    guild_id    TEXT PRIMARY KEY NOT NULL REFERENCES guild_config(guild_id) ON DELETE CASCADE,
+    channel_id  TEXT NOT NULL,
+    days        INTEGER NOT NULL DEFAULT 7
+);
+
+CREATE TABLE IF NOT EXISTS schedule_board_messages (
+    guild_id    TEXT NOT NULL REFERENCES guild_config(guild_id) ON DELETE CASCADE,
+    position    INTEGER NOT NULL,
+    message_id  TEXT NOT NULL,
+    PRIMARY KEY (guild_id, position)
+);
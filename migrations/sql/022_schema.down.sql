@@ -0,0 +1,8 @@
+ALTER TABLE standings_messages DROP COLUMN division;
+ALTER TABLE announcements DROP COLUMN division;
+ALTER TABLE results DROP COLUMN division;
+ALTER TABLE matches DROP COLUMN division;
+
+DROP TABLE IF EXISTS division_teams;
+DROP INDEX IF EXISTS idx_divisions_category_id;
+DROP TABLE IF EXISTS divisions;
@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS divisions (
+    guild_id                TEXT NOT NULL REFERENCES guild_config(guild_id) ON DELETE CASCADE,
+    name                    TEXT NOT NULL,
+    category_id             TEXT NOT NULL,
+    channel_access_offset   INTEGER NOT NULL,
+    requirements_offset     INTEGER NOT NULL,
+    channel_delete_offset   INTEGER NOT NULL,
+    notification_offsets    TEXT NOT NULL,
+    PRIMARY KEY (guild_id, name)
+);
+CREATE INDEX IF NOT EXISTS idx_divisions_category_id ON divisions (category_id);
+
+-- a team is a member of at most one division
+CREATE TABLE IF NOT EXISTS division_teams (
+    guild_id    TEXT NOT NULL,
+    role_id     TEXT NOT NULL,
+    division    TEXT NOT NULL,
+    PRIMARY KEY (guild_id, role_id),
+    FOREIGN KEY (guild_id, division) REFERENCES divisions(guild_id, name) ON DELETE CASCADE
+);
+
+-- an empty division refers to the configuration of the guild
+ALTER TABLE matches ADD COLUMN division TEXT NOT NULL DEFAULT '';
+ALTER TABLE results ADD COLUMN division TEXT NOT NULL DEFAULT '';
+
+-- an empty division does not filter by division
+ALTER TABLE announcements ADD COLUMN division TEXT NOT NULL DEFAULT '';
+ALTER TABLE standings_messages ADD COLUMN division TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS overflow_categories (
+    category_id TEXT PRIMARY KEY NOT NULL,
+    guild_id    TEXT NOT NULL REFERENCES guild_config(guild_id) ON DELETE CASCADE,
+    division    TEXT NOT NULL DEFAULT '',
+    number      INTEGER NOT NULL,
+    UNIQUE (guild_id, division, number)
+);
@@ -0,0 +1,168 @@
+-- matches without a channel cannot be identified anymore and are dropped
+CREATE TABLE IF NOT EXISTS matches_old (
+    guild_id                            TEXT NOT NULL REFERENCES guild_config(guild_id) ON DELETE CASCADE,
+    channel_id                          TEXT PRIMARY KEY NOT NULL,
+    channel_accessible                  INTEGER NOT NULL DEFAULT 0,
+    channel_accessible_at               INTEGER NOT NULL,
+    channel_delete_at                   INTEGER NOT NULL,
+    message_id                          TEXT NOT NULL,
+    scheduled_at                        INTEGER NOT NULL,
+    created_at                          INTEGER NOT NULL,
+    created_by                          TEXT NOT NULL,
+    updated_at                          INTEGER NOT NULL DEFAULT (unixepoch('now')),
+    updated_by                          TEXT NOT NULL,
+    event_id                            TEXT NOT NULL DEFAULT '',
+    cancelled_at                        INTEGER NOT NULL DEFAULT 0,
+    cancel_reason                       TEXT NOT NULL DEFAULT '',
+    division                            TEXT NOT NULL DEFAULT ''
+);
+
+INSERT INTO matches_old (
+    guild_id,
+    channel_id,
+    channel_accessible,
+    channel_accessible_at,
+    channel_delete_at,
+    message_id,
+    scheduled_at,
+    created_at,
+    created_by,
+    updated_at,
+    updated_by,
+    event_id,
+    cancelled_at,
+    cancel_reason,
+    division
+)
+SELECT
+    guild_id,
+    channel_id,
+    channel_accessible,
+    channel_accessible_at,
+    channel_delete_at,
+    message_id,
+    scheduled_at,
+    created_at,
+    created_by,
+    updated_at,
+    updated_by,
+    event_id,
+    cancelled_at,
+    cancel_reason,
+    division
+FROM matches
+WHERE channel_id != '';
+
+CREATE TABLE IF NOT EXISTS participation_requirements_old (
+    channel_id            TEXT PRIMARY KEY NOT NULL REFERENCES matches_old(channel_id) ON DELETE CASCADE,
+    participants_per_team INTEGER NOT NULL,
+    deadline_at           INTEGER NOT NULL,
+    entry_closed          INTEGER NOT NULL DEFAULT 0
+);
+
+INSERT INTO participation_requirements_old (channel_id, participants_per_team, deadline_at, entry_closed)
+SELECT m.channel_id, pr.participants_per_team, pr.deadline_at, pr.entry_closed
+FROM participation_requirements AS pr
+JOIN matches AS m ON m.match_id = pr.match_id
+WHERE m.channel_id != '';
+
+CREATE TABLE IF NOT EXISTS teams_old (
+    channel_id                  TEXT NOT NULL REFERENCES matches_old(channel_id) ON DELETE CASCADE,
+    role_id                     TEXT NOT NULL,
+    confirmed_participants      INTEGER NOT NULL DEFAULT 0,
+    score                       INTEGER NOT NULL DEFAULT 0,
+    time                        INTEGER NOT NULL DEFAULT 0,
+    screenshot                  BLOB,
+    demo                        BLOB,
+    PRIMARY KEY(channel_id, role_id)
+);
+
+INSERT INTO teams_old (channel_id, role_id, confirmed_participants, score, time, screenshot, demo)
+SELECT m.channel_id, t.role_id, t.confirmed_participants, t.score, t.time, t.screenshot, t.demo
+FROM teams AS t
+JOIN matches AS m ON m.match_id = t.match_id
+WHERE m.channel_id != '';
+
+CREATE TABLE IF NOT EXISTS moderators_old (
+    channel_id      TEXT NOT NULL REFERENCES matches_old(channel_id) ON DELETE CASCADE,
+    user_id         TEXT NOT NULL,
+    PRIMARY KEY(channel_id, user_id)
+);
+
+INSERT INTO moderators_old (channel_id, user_id)
+SELECT m.channel_id, mo.user_id
+FROM moderators AS mo
+JOIN matches AS m ON m.match_id = mo.match_id
+WHERE m.channel_id != '';
+
+CREATE TABLE IF NOT EXISTS streamers_old (
+    channel_id      TEXT NOT NULL REFERENCES matches_old(channel_id) ON DELETE CASCADE,
+    user_id         TEXT NOT NULL,
+    url             TEXT NOT NULL,
+    PRIMARY KEY(channel_id, user_id)
+);
+
+INSERT INTO streamers_old (channel_id, user_id, url)
+SELECT m.channel_id, s.user_id, s.url
+FROM streamers AS s
+JOIN matches AS m ON m.match_id = s.match_id
+WHERE m.channel_id != '';
+
+CREATE TABLE IF NOT EXISTS notifications_old (
+    channel_id      TEXT NOT NULL REFERENCES matches_old(channel_id) ON DELETE CASCADE,
+    notify_at       INTEGER NOT NULL,
+    custom_text     TEXT NOT NULL DEFAULT '',
+    created_at      INTEGER NOT NULL DEFAULT (unixepoch('now')),
+    created_by      TEXT NOT NULL,
+    updated_at      INTEGER NOT NULL DEFAULT (unixepoch('now')),
+    updated_by      TEXT NOT NULL,
+    PRIMARY KEY(channel_id, notify_at)
+);
+
+INSERT INTO notifications_old (channel_id, notify_at, custom_text, created_at, created_by, updated_at, updated_by)
+SELECT m.channel_id, n.notify_at, n.custom_text, n.created_at, n.created_by, n.updated_at, n.updated_by
+FROM notifications AS n
+JOIN matches AS m ON m.match_id = n.match_id
+WHERE m.channel_id != '';
+
+CREATE TABLE IF NOT EXISTS participants_old (
+    channel_id  TEXT NOT NULL,
+    role_id     TEXT NOT NULL,
+    user_id     TEXT NOT NULL,
+    joined_at   INTEGER NOT NULL,
+    reaction    INTEGER NOT NULL DEFAULT 0,
+    substitute  INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY(channel_id, user_id),
+    FOREIGN KEY(channel_id, role_id) REFERENCES teams_old(channel_id, role_id) ON DELETE CASCADE
+);
+
+INSERT INTO participants_old (channel_id, role_id, user_id, joined_at, reaction, substitute)
+SELECT m.channel_id, p.role_id, p.user_id, p.joined_at, p.reaction, p.substitute
+FROM participants AS p
+JOIN matches AS m ON m.match_id = p.match_id
+WHERE m.channel_id != '';
+
+DROP TABLE participants;
+DROP TABLE teams;
+DROP TABLE moderators;
+DROP TABLE streamers;
+DROP TABLE notifications;
+DROP TABLE participation_requirements;
+DROP TABLE matches;
+
+ALTER TABLE matches_old RENAME TO matches;
+ALTER TABLE participation_requirements_old RENAME TO participation_requirements;
+ALTER TABLE teams_old RENAME TO teams;
+ALTER TABLE moderators_old RENAME TO moderators;
+ALTER TABLE streamers_old RENAME TO streamers;
+ALTER TABLE notifications_old RENAME TO notifications;
+ALTER TABLE participants_old RENAME TO participants;
+
+CREATE INDEX IF NOT EXISTS idx_matches_guild_id ON matches (guild_id);
+CREATE INDEX IF NOT EXISTS idx_matches_channel_id ON matches (channel_id);
+CREATE INDEX IF NOT EXISTS idx_participation_requirements_channel_id ON participation_requirements (channel_id);
+CREATE INDEX IF NOT EXISTS idx_teams_channel_id_role_id ON teams (channel_id, role_id);
+CREATE INDEX IF NOT EXISTS idx_moderators_channel_id_user_id ON moderators (channel_id, user_id);
+CREATE INDEX IF NOT EXISTS idx_streamers_channel_id_user_id ON streamers (channel_id, user_id);
+CREATE INDEX IF NOT EXISTS idx_notifications_channel_id_notify_at ON notifications (channel_id, notify_at);
+CREATE INDEX IF NOT EXISTS idx_participants_channel_id_role_id ON participants (channel_id, role_id);
@@ -0,0 +1,183 @@
+-- matches are identified by their own id, so that they can exist before their channel is created
+CREATE TABLE IF NOT EXISTS matches_new (
+    match_id                            INTEGER PRIMARY KEY AUTOINCREMENT,
+    guild_id                            TEXT NOT NULL REFERENCES guild_config(guild_id) ON DELETE CASCADE,
+    number                              INTEGER NOT NULL DEFAULT 0,
+    channel_id                          TEXT NOT NULL DEFAULT '',
+    channel_accessible                  INTEGER NOT NULL DEFAULT 0,
+    channel_accessible_at               INTEGER NOT NULL,
+    channel_delete_at                   INTEGER NOT NULL,
+    message_id                          TEXT NOT NULL DEFAULT '',
+    scheduled_at                        INTEGER NOT NULL,
+    created_at                          INTEGER NOT NULL,
+    created_by                          TEXT NOT NULL,
+    updated_at                          INTEGER NOT NULL DEFAULT (unixepoch('now')),
+    updated_by                          TEXT NOT NULL,
+    event_id                            TEXT NOT NULL DEFAULT '',
+    cancelled_at                        INTEGER NOT NULL DEFAULT 0,
+    cancel_reason                       TEXT NOT NULL DEFAULT '',
+    division                            TEXT NOT NULL DEFAULT ''
+);
+
+INSERT INTO matches_new (
+    guild_id,
+    channel_id,
+    channel_accessible,
+    channel_accessible_at,
+    channel_delete_at,
+    message_id,
+    scheduled_at,
+    created_at,
+    created_by,
+    updated_at,
+    updated_by,
+    event_id,
+    cancelled_at,
+    cancel_reason,
+    division
+)
+SELECT
+    guild_id,
+    channel_id,
+    channel_accessible,
+    channel_accessible_at,
+    channel_delete_at,
+    message_id,
+    scheduled_at,
+    created_at,
+    created_by,
+    updated_at,
+    updated_by,
+    event_id,
+    cancelled_at,
+    cancel_reason,
+    division
+FROM matches
+ORDER BY created_at, channel_id;
+
+CREATE TABLE IF NOT EXISTS participation_requirements_new (
+    match_id              INTEGER PRIMARY KEY NOT NULL REFERENCES matches_new(match_id) ON DELETE CASCADE,
+    participants_per_team INTEGER NOT NULL,
+    deadline_at           INTEGER NOT NULL,
+    entry_closed          INTEGER NOT NULL DEFAULT 0
+);
+
+INSERT INTO participation_requirements_new (match_id, participants_per_team, deadline_at, entry_closed)
+SELECT m.match_id, pr.participants_per_team, pr.deadline_at, pr.entry_closed
+FROM participation_requirements AS pr
+JOIN matches_new AS m ON m.channel_id = pr.channel_id;
+
+CREATE TABLE IF NOT EXISTS teams_new (
+    match_id                    INTEGER NOT NULL REFERENCES matches_new(match_id) ON DELETE CASCADE,
+    role_id                     TEXT NOT NULL,
+    confirmed_participants      INTEGER NOT NULL DEFAULT 0,
+    score                       INTEGER NOT NULL DEFAULT 0,
+    time                        INTEGER NOT NULL DEFAULT 0,
+    screenshot                  BLOB,
+    demo                        BLOB,
+    PRIMARY KEY(match_id, role_id)
+);
+
+INSERT INTO teams_new (match_id, role_id, confirmed_participants, score, time, screenshot, demo)
+SELECT m.match_id, t.role_id, t.confirmed_participants, t.score, t.time, t.screenshot, t.demo
+FROM teams AS t
+JOIN matches_new AS m ON m.channel_id = t.channel_id;
+
+CREATE TABLE IF NOT EXISTS moderators_new (
+    match_id        INTEGER NOT NULL REFERENCES matches_new(match_id) ON DELETE CASCADE,
+    user_id         TEXT NOT NULL,
+    PRIMARY KEY(match_id, user_id)
+);
+
+INSERT INTO moderators_new (match_id, user_id)
+SELECT m.match_id, mo.user_id
+FROM moderators AS mo
+JOIN matches_new AS m ON m.channel_id = mo.channel_id;
+
+CREATE TABLE IF NOT EXISTS streamers_new (
+    match_id        INTEGER NOT NULL REFERENCES matches_new(match_id) ON DELETE CASCADE,
+    user_id         TEXT NOT NULL,
+    url             TEXT NOT NULL,
+    PRIMARY KEY(match_id, user_id)
+);
+
+INSERT INTO streamers_new (match_id, user_id, url)
+SELECT m.match_id, s.user_id, s.url
+FROM streamers AS s
+JOIN matches_new AS m ON m.channel_id = s.channel_id;
+
+CREATE TABLE IF NOT EXISTS notifications_new (
+    match_id        INTEGER NOT NULL REFERENCES matches_new(match_id) ON DELETE CASCADE,
+    notify_at       INTEGER NOT NULL,
+    custom_text     TEXT NOT NULL DEFAULT '',
+    created_at      INTEGER NOT NULL DEFAULT (unixepoch('now')),
+    created_by      TEXT NOT NULL,
+    updated_at      INTEGER NOT NULL DEFAULT (unixepoch('now')),
+    updated_by      TEXT NOT NULL,
+    PRIMARY KEY(match_id, notify_at)
+);
+
+INSERT INTO notifications_new (match_id, notify_at, custom_text, created_at, created_by, updated_at, updated_by)
+SELECT m.match_id, n.notify_at, n.custom_text, n.created_at, n.created_by, n.updated_at, n.updated_by
+FROM notifications AS n
+JOIN matches_new AS m ON m.channel_id = n.channel_id;
+
+CREATE TABLE IF NOT EXISTS participants_new (
+    match_id    INTEGER NOT NULL,
+    role_id     TEXT NOT NULL,
+    user_id     TEXT NOT NULL,
+    joined_at   INTEGER NOT NULL,
+    reaction    INTEGER NOT NULL DEFAULT 0,
+    substitute  INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY(match_id, user_id),
+    FOREIGN KEY(match_id, role_id) REFERENCES teams_new(match_id, role_id) ON DELETE CASCADE
+);
+
+INSERT INTO participants_new (match_id, role_id, user_id, joined_at, reaction, substitute)
+SELECT m.match_id, p.role_id, p.user_id, p.joined_at, p.reaction, p.substitute
+FROM participants AS p
+JOIN matches_new AS m ON m.channel_id = p.channel_id;
+
+-- children are dropped before their parents, so that no cascading deletes are triggered
+DROP TABLE participants;
+DROP TABLE teams;
+DROP TABLE moderators;
+DROP TABLE streamers;
+DROP TABLE notifications;
+DROP TABLE participation_requirements;
+DROP TABLE matches;
+
+-- renaming also updates the references of the new child tables
+ALTER TABLE matches_new RENAME TO matches;
+ALTER TABLE participation_requirements_new RENAME TO participation_requirements;
+ALTER TABLE teams_new RENAME TO teams;
+ALTER TABLE moderators_new RENAME TO moderators;
+ALTER TABLE streamers_new RENAME TO streamers;
+ALTER TABLE notifications_new RENAME TO notifications;
+ALTER TABLE participants_new RENAME TO participants;
+
+-- the per guild match counter numbers the channels, e.g. match-12,
+-- existing matches are numbered backwards from the current counter in the order of their creation
+UPDATE matches
+SET number = (
+    SELECT COUNT(*)
+    FROM matches AS m
+    WHERE m.guild_id = matches.guild_id
+    AND m.match_id <= matches.match_id
+) + (
+    SELECT g.match_counter
+    FROM guild_config AS g
+    WHERE g.guild_id = matches.guild_id
+) - (
+    SELECT COUNT(*)
+    FROM matches AS m
+    WHERE m.guild_id = matches.guild_id
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_matches_guild_id_number ON matches (guild_id, number);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_matches_channel_id ON matches (channel_id) WHERE channel_id != '';
+CREATE INDEX IF NOT EXISTS idx_teams_match_id_role_id ON teams (match_id, role_id);
+CREATE INDEX IF NOT EXISTS idx_moderators_match_id_user_id ON moderators (match_id, user_id);
+CREATE INDEX IF NOT EXISTS idx_streamers_match_id_user_id ON streamers (match_id, user_id);
+CREATE INDEX IF NOT EXISTS idx_notifications_match_id_notify_at ON notifications (match_id, notify_at);
+CREATE INDEX IF NOT EXISTS idx_participants_match_id_role_id ON participants (match_id, role_id);
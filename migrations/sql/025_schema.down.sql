@@ -0,0 +1,157 @@
+-- results and fixtures of matches without a channel keep a placeholder instead of the deleted channel
+ALTER TABLE fixtures ADD COLUMN channel_id TEXT NOT NULL DEFAULT '';
+
+UPDATE fixtures
+SET channel_id = COALESCE((
+    SELECT CASE WHEN m.channel_id != '' THEN m.channel_id ELSE 'match-' || m.match_id END
+    FROM matches AS m
+    WHERE m.match_id = fixtures.match_id
+), '')
+WHERE match_id != 0;
+
+ALTER TABLE fixtures DROP COLUMN match_id;
+
+CREATE TABLE IF NOT EXISTS results_old (
+    guild_id        TEXT NOT NULL REFERENCES guild_config(guild_id) ON DELETE CASCADE,
+    channel_id      TEXT PRIMARY KEY NOT NULL,
+    channel_name    TEXT NOT NULL,
+    scheduled_at    INTEGER NOT NULL,
+    reported_at     INTEGER NOT NULL,
+    reported_by     TEXT NOT NULL,
+    status          TEXT NOT NULL DEFAULT 'PENDING',
+    message_id      TEXT NOT NULL DEFAULT '',
+    finalized_at    INTEGER NOT NULL DEFAULT 0,
+    finalized_by    TEXT NOT NULL DEFAULT '',
+    division        TEXT NOT NULL DEFAULT ''
+);
+
+INSERT INTO results_old (
+    guild_id,
+    channel_id,
+    channel_name,
+    scheduled_at,
+    reported_at,
+    reported_by,
+    status,
+    message_id,
+    finalized_at,
+    finalized_by,
+    division
+)
+SELECT
+    r.guild_id,
+    CASE WHEN m.channel_id != '' THEN m.channel_id ELSE 'match-' || m.match_id END,
+    r.channel_name,
+    r.scheduled_at,
+    r.reported_at,
+    r.reported_by,
+    r.status,
+    r.message_id,
+    r.finalized_at,
+    r.finalized_by,
+    r.division
+FROM results AS r
+JOIN matches AS m ON m.match_id = r.match_id;
+
+CREATE TABLE IF NOT EXISTS team_results_old (
+    channel_id      TEXT NOT NULL REFERENCES results_old(channel_id) ON DELETE CASCADE,
+    role_id         TEXT NOT NULL,
+    score           INTEGER NOT NULL DEFAULT 0,
+    time            INTEGER NOT NULL DEFAULT 0,
+    screenshot      BLOB,
+    screenshot_name TEXT NOT NULL DEFAULT '',
+    demo            BLOB,
+    demo_name       TEXT NOT NULL DEFAULT '',
+    reported_at     INTEGER NOT NULL,
+    reported_by     TEXT NOT NULL,
+    PRIMARY KEY(channel_id, role_id)
+);
+
+INSERT INTO team_results_old (
+    channel_id,
+    role_id,
+    score,
+    time,
+    screenshot,
+    screenshot_name,
+    demo,
+    demo_name,
+    reported_at,
+    reported_by
+)
+SELECT
+    CASE WHEN m.channel_id != '' THEN m.channel_id ELSE 'match-' || m.match_id END,
+    t.role_id,
+    t.score,
+    t.time,
+    t.screenshot,
+    t.screenshot_name,
+    t.demo,
+    t.demo_name,
+    t.reported_at,
+    t.reported_by
+FROM team_results AS t
+JOIN matches AS m ON m.match_id = t.match_id;
+
+CREATE TABLE IF NOT EXISTS result_confirmations_old (
+    channel_id      TEXT NOT NULL REFERENCES results_old(channel_id) ON DELETE CASCADE,
+    role_id         TEXT NOT NULL,
+    user_id         TEXT NOT NULL,
+    confirmed_at    INTEGER NOT NULL,
+    PRIMARY KEY(channel_id, role_id)
+);
+
+INSERT INTO result_confirmations_old (channel_id, role_id, user_id, confirmed_at)
+SELECT
+    CASE WHEN m.channel_id != '' THEN m.channel_id ELSE 'match-' || m.match_id END,
+    c.role_id,
+    c.user_id,
+    c.confirmed_at
+FROM result_confirmations AS c
+JOIN matches AS m ON m.match_id = c.match_id;
+
+CREATE TABLE IF NOT EXISTS rating_history_old (
+    guild_id        TEXT NOT NULL REFERENCES guild_config(guild_id) ON DELETE CASCADE,
+    role_id         TEXT NOT NULL,
+    channel_id      TEXT NOT NULL,
+    rating_before   REAL NOT NULL,
+    rating_after    REAL NOT NULL,
+    rated_at        INTEGER NOT NULL,
+    PRIMARY KEY(channel_id, role_id)
+);
+
+INSERT INTO rating_history_old (guild_id, role_id, channel_id, rating_before, rating_after, rated_at)
+SELECT
+    h.guild_id,
+    h.role_id,
+    CASE WHEN m.channel_id != '' THEN m.channel_id ELSE 'match-' || m.match_id END,
+    h.rating_before,
+    h.rating_after,
+    h.rated_at
+FROM rating_history AS h
+JOIN matches AS m ON m.match_id = h.match_id;
+
+-- children are dropped before their parents, so that no cascading deletes are triggered
+DROP TABLE result_confirmations;
+DROP TABLE team_results;
+DROP TABLE results;
+DROP TABLE rating_history;
+
+ALTER TABLE results_old RENAME TO results;
+ALTER TABLE team_results_old RENAME TO team_results;
+ALTER TABLE result_confirmations_old RENAME TO result_confirmations;
+ALTER TABLE rating_history_old RENAME TO rating_history;
+
+CREATE INDEX IF NOT EXISTS idx_results_guild_id ON results (guild_id);
+CREATE INDEX IF NOT EXISTS idx_team_results_channel_id_role_id ON team_results (channel_id, role_id);
+CREATE INDEX IF NOT EXISTS idx_result_confirmations_channel_id_role_id ON result_confirmations (channel_id, role_id);
+CREATE INDEX IF NOT EXISTS idx_rating_history_guild_id_role_id_rated_at ON rating_history (guild_id, role_id, rated_at);
+
+-- matches whose channel was deleted did not exist before, their results are kept separately
+DELETE FROM matches
+WHERE channel_deleted = 1;
+
+DROP INDEX IF EXISTS idx_matches_guild_id_number;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_matches_guild_id_number ON matches (guild_id, number);
+
+ALTER TABLE matches DROP COLUMN channel_deleted;
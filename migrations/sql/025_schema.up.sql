@@ -0,0 +1,228 @@
+-- matches are kept after their channel was deleted, so that their results and stats remain available
+ALTER TABLE matches ADD COLUMN channel_deleted INTEGER NOT NULL DEFAULT 0;
+
+-- matches that were restored from their results or fixtures have no number
+DROP INDEX IF EXISTS idx_matches_guild_id_number;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_matches_guild_id_number ON matches (guild_id, number) WHERE number > 0;
+
+-- results and fixtures outlived the matches of their deleted channels,
+-- which are restored as matches without a channel
+INSERT INTO matches (
+    guild_id,
+    number,
+    channel_id,
+    channel_accessible,
+    channel_accessible_at,
+    channel_delete_at,
+    scheduled_at,
+    created_at,
+    created_by,
+    updated_at,
+    updated_by,
+    division,
+    channel_deleted
+)
+SELECT
+    r.guild_id,
+    0,
+    r.channel_id,
+    1,
+    r.scheduled_at,
+    r.scheduled_at,
+    r.scheduled_at,
+    r.reported_at,
+    r.reported_by,
+    r.reported_at,
+    r.reported_by,
+    r.division,
+    1
+FROM results AS r
+WHERE NOT EXISTS (
+    SELECT 1
+    FROM matches AS m
+    WHERE m.channel_id = r.channel_id
+)
+ORDER BY r.scheduled_at, r.channel_id;
+
+INSERT INTO matches (
+    guild_id,
+    number,
+    channel_id,
+    channel_accessible,
+    channel_accessible_at,
+    channel_delete_at,
+    scheduled_at,
+    created_at,
+    created_by,
+    updated_at,
+    updated_by,
+    channel_deleted
+)
+SELECT
+    s.guild_id,
+    0,
+    f.channel_id,
+    1,
+    f.scheduled_at,
+    f.scheduled_at,
+    f.scheduled_at,
+    s.created_at,
+    s.created_by,
+    s.created_at,
+    s.created_by,
+    1
+FROM fixtures AS f
+JOIN seasons AS s
+ON f.season_id = s.season_id
+WHERE f.channel_id != ''
+AND NOT EXISTS (
+    SELECT 1
+    FROM matches AS m
+    WHERE m.channel_id = f.channel_id
+)
+ORDER BY f.scheduled_at, f.fixture_id;
+
+CREATE TABLE IF NOT EXISTS results_new (
+    match_id        INTEGER PRIMARY KEY NOT NULL REFERENCES matches(match_id) ON DELETE CASCADE,
+    guild_id        TEXT NOT NULL REFERENCES guild_config(guild_id) ON DELETE CASCADE,
+    channel_name    TEXT NOT NULL,
+    scheduled_at    INTEGER NOT NULL,
+    reported_at     INTEGER NOT NULL,
+    reported_by     TEXT NOT NULL,
+    status          TEXT NOT NULL DEFAULT 'PENDING',
+    message_id      TEXT NOT NULL DEFAULT '',
+    finalized_at    INTEGER NOT NULL DEFAULT 0,
+    finalized_by    TEXT NOT NULL DEFAULT '',
+    division        TEXT NOT NULL DEFAULT ''
+);
+
+INSERT INTO results_new (
+    match_id,
+    guild_id,
+    channel_name,
+    scheduled_at,
+    reported_at,
+    reported_by,
+    status,
+    message_id,
+    finalized_at,
+    finalized_by,
+    division
+)
+SELECT
+    m.match_id,
+    r.guild_id,
+    r.channel_name,
+    r.scheduled_at,
+    r.reported_at,
+    r.reported_by,
+    r.status,
+    r.message_id,
+    r.finalized_at,
+    r.finalized_by,
+    r.division
+FROM results AS r
+JOIN matches AS m ON m.channel_id = r.channel_id;
+
+CREATE TABLE IF NOT EXISTS team_results_new (
+    match_id        INTEGER NOT NULL REFERENCES results_new(match_id) ON DELETE CASCADE,
+    role_id         TEXT NOT NULL,
+    score           INTEGER NOT NULL DEFAULT 0,
+    time            INTEGER NOT NULL DEFAULT 0,
+    screenshot      BLOB,
+    screenshot_name TEXT NOT NULL DEFAULT '',
+    demo            BLOB,
+    demo_name       TEXT NOT NULL DEFAULT '',
+    reported_at     INTEGER NOT NULL,
+    reported_by     TEXT NOT NULL,
+    PRIMARY KEY(match_id, role_id)
+);
+
+INSERT INTO team_results_new (
+    match_id,
+    role_id,
+    score,
+    time,
+    screenshot,
+    screenshot_name,
+    demo,
+    demo_name,
+    reported_at,
+    reported_by
+)
+SELECT
+    m.match_id,
+    t.role_id,
+    t.score,
+    t.time,
+    t.screenshot,
+    t.screenshot_name,
+    t.demo,
+    t.demo_name,
+    t.reported_at,
+    t.reported_by
+FROM team_results AS t
+JOIN matches AS m ON m.channel_id = t.channel_id;
+
+CREATE TABLE IF NOT EXISTS result_confirmations_new (
+    match_id        INTEGER NOT NULL REFERENCES results_new(match_id) ON DELETE CASCADE,
+    role_id         TEXT NOT NULL,
+    user_id         TEXT NOT NULL,
+    confirmed_at    INTEGER NOT NULL,
+    PRIMARY KEY(match_id, role_id)
+);
+
+INSERT INTO result_confirmations_new (match_id, role_id, user_id, confirmed_at)
+SELECT m.match_id, c.role_id, c.user_id, c.confirmed_at
+FROM result_confirmations AS c
+JOIN matches AS m ON m.channel_id = c.channel_id;
+
+CREATE TABLE IF NOT EXISTS rating_history_new (
+    guild_id        TEXT NOT NULL REFERENCES guild_config(guild_id) ON DELETE CASCADE,
+    role_id         TEXT NOT NULL,
+    match_id        INTEGER NOT NULL,
+    rating_before   REAL NOT NULL,
+    rating_after    REAL NOT NULL,
+    rated_at        INTEGER NOT NULL,
+    PRIMARY KEY(match_id, role_id)
+);
+
+INSERT INTO rating_history_new (guild_id, role_id, match_id, rating_before, rating_after, rated_at)
+SELECT h.guild_id, h.role_id, m.match_id, h.rating_before, h.rating_after, h.rated_at
+FROM rating_history AS h
+JOIN matches AS m ON m.channel_id = h.channel_id;
+
+-- children are dropped before their parents, so that no cascading deletes are triggered
+DROP TABLE result_confirmations;
+DROP TABLE team_results;
+DROP TABLE results;
+DROP TABLE rating_history;
+
+-- renaming also updates the references of the new child tables
+ALTER TABLE results_new RENAME TO results;
+ALTER TABLE team_results_new RENAME TO team_results;
+ALTER TABLE result_confirmations_new RENAME TO result_confirmations;
+ALTER TABLE rating_history_new RENAME TO rating_history;
+
+CREATE INDEX IF NOT EXISTS idx_results_guild_id ON results (guild_id);
+CREATE INDEX IF NOT EXISTS idx_team_results_match_id_role_id ON team_results (match_id, role_id);
+CREATE INDEX IF NOT EXISTS idx_result_confirmations_match_id_role_id ON result_confirmations (match_id, role_id);
+CREATE INDEX IF NOT EXISTS idx_rating_history_guild_id_role_id_rated_at ON rating_history (guild_id, role_id, rated_at);
+
+-- fixtures reference their match, 0 until the match is created
+ALTER TABLE fixtures ADD COLUMN match_id INTEGER NOT NULL DEFAULT 0;
+
+UPDATE fixtures
+SET match_id = COALESCE((
+    SELECT m.match_id
+    FROM matches AS m
+    WHERE m.channel_id = fixtures.channel_id
+), 0)
+WHERE channel_id != '';
+
+ALTER TABLE fixtures DROP COLUMN channel_id;
+
+-- the deleted channels of the restored matches are not referenced anymore
+UPDATE matches
+SET channel_id = ''
+WHERE channel_deleted = 1;
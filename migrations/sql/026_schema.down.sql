@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_reschedule_proposals_message_id;
+DROP TABLE IF EXISTS reschedule_proposals;
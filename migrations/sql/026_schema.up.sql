@@ -0,0 +1,12 @@
+-- captains propose a new point in time, which the opposing captains or a moderator accept
+CREATE TABLE IF NOT EXISTS reschedule_proposals (
+    match_id        INTEGER PRIMARY KEY NOT NULL REFERENCES matches(match_id) ON DELETE CASCADE,
+    role_id         TEXT NOT NULL,
+    scheduled_at    INTEGER NOT NULL,
+    channel_id      TEXT NOT NULL,
+    message_id      TEXT NOT NULL DEFAULT '',
+    proposed_at     INTEGER NOT NULL,
+    proposed_by     TEXT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_reschedule_proposals_message_id ON reschedule_proposals (message_id);
@@ -0,0 +1,17 @@
+DROP INDEX IF EXISTS idx_results_message_channel_id_message_id;
+ALTER TABLE results DROP COLUMN message_channel_id;
+
+ALTER TABLE teams ADD COLUMN score INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE teams ADD COLUMN time INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE teams ADD COLUMN screenshot BLOB;
+ALTER TABLE teams ADD COLUMN demo BLOB;
+
+UPDATE teams
+SET
+    score = tr.score,
+    time = tr.time,
+    screenshot = tr.screenshot,
+    demo = tr.demo
+FROM team_results AS tr
+WHERE tr.match_id = teams.match_id
+AND tr.role_id = teams.role_id;
@@ -0,0 +1,78 @@
+-- results are only stored in results and team_results,
+-- the result columns of teams were only written by reports before the result confirmation existed
+INSERT INTO results (
+    match_id,
+    guild_id,
+    channel_name,
+    scheduled_at,
+    reported_at,
+    reported_by,
+    division
+)
+SELECT
+    m.match_id,
+    m.guild_id,
+    '',
+    m.scheduled_at,
+    m.updated_at,
+    m.updated_by,
+    m.division
+FROM matches AS m
+WHERE NOT EXISTS (
+    SELECT 1
+    FROM results AS r
+    WHERE r.match_id = m.match_id
+)
+AND EXISTS (
+    SELECT 1
+    FROM teams AS t
+    WHERE t.match_id = m.match_id
+    AND (t.score != 0 OR t.time != 0 OR t.screenshot IS NOT NULL OR t.demo IS NOT NULL)
+);
+
+INSERT INTO team_results (
+    match_id,
+    role_id,
+    score,
+    time,
+    screenshot,
+    demo,
+    reported_at,
+    reported_by
+)
+SELECT
+    t.match_id,
+    t.role_id,
+    t.score,
+    t.time,
+    t.screenshot,
+    t.demo,
+    r.reported_at,
+    r.reported_by
+FROM teams AS t
+JOIN results AS r ON r.match_id = t.match_id
+WHERE (t.score != 0 OR t.time != 0 OR t.screenshot IS NOT NULL OR t.demo IS NOT NULL)
+AND NOT EXISTS (
+    SELECT 1
+    FROM team_results AS tr
+    WHERE tr.match_id = t.match_id
+    AND tr.role_id = t.role_id
+);
+
+ALTER TABLE teams DROP COLUMN score;
+ALTER TABLE teams DROP COLUMN time;
+ALTER TABLE teams DROP COLUMN screenshot;
+ALTER TABLE teams DROP COLUMN demo;
+
+-- result summaries of matches without a channel are posted to the channel of the report
+ALTER TABLE results ADD COLUMN message_channel_id TEXT NOT NULL DEFAULT '';
+
+UPDATE results
+SET message_channel_id = (
+    SELECT m.channel_id
+    FROM matches AS m
+    WHERE m.match_id = results.match_id
+)
+WHERE message_id != '';
+
+CREATE INDEX IF NOT EXISTS idx_results_message_channel_id_message_id ON results (message_channel_id, message_id);
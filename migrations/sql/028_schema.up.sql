@@ -0,0 +1,7 @@
+-- generated notifications are recreated when a match is rescheduled, custom ones are kept
+ALTER TABLE notifications ADD COLUMN generated INTEGER NOT NULL DEFAULT 0;
+
+-- notifications without a custom text were generated before the flag existed
+UPDATE notifications
+SET generated = 1
+WHERE custom_text = '';
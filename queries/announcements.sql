@@ -3,71 +3,119 @@
 -- name: AddAnnouncement :exec
 INSERT OR REPLACE INTO announcements (
     guild_id,
+    name,
     starts_at,
     ends_at,
     channel_id,
     interval,
     last_announced_at,
     custom_text_before,
-    custom_text_after
+    custom_text_after,
+    schedule,
+    location,
+    next_announced_at,
+    division
 ) VALUES (
     :guild_id,
+    :name,
     :starts_at,
     :ends_at,
     :channel_id,
     :interval,
     :last_announced_at,
     :custom_text_before,
-    :custom_text_after
+    :custom_text_after,
+    :schedule,
+    :location,
+    :next_announced_at,
+    :division
 );
 
 -- name: GetAnnouncement :one
 SELECT
     guild_id,
+    name,
     starts_at,
     ends_at,
     channel_id,
     interval,
     last_announced_at,
     custom_text_before,
-    custom_text_after
+    custom_text_after,
+    schedule,
+    location,
+    next_announced_at,
+    division
+FROM announcements
+WHERE guild_id = :guild_id
+AND name = :name;
+
+-- name: ListGuildAnnouncements :many
+SELECT
+    guild_id,
+    name,
+    starts_at,
+    ends_at,
+    channel_id,
+    interval,
+    last_announced_at,
+    custom_text_before,
+    custom_text_after,
+    schedule,
+    location,
+    next_announced_at,
+    division
+FROM announcements
+WHERE guild_id = :guild_id
+ORDER BY name;
+
+-- name: ListGuildAnnouncementNames :many
+SELECT name
+FROM announcements
+WHERE guild_id = :guild_id
+ORDER BY name;
+
+-- name: CountGuildAnnouncements :one
+SELECT COUNT(*)
 FROM announcements
 WHERE guild_id = :guild_id;
 
 -- name: DeleteAnnouncement :exec
 DELETE FROM announcements
-WHERE guild_id = :guild_id;
+WHERE guild_id = :guild_id
+AND name = :name;
 
 -- name: ListNowDueAnnouncements :many
 SELECT
     p.guild_id,
+    p.name,
     p.starts_at,
     p.ends_at,
     p.channel_id,
     p.interval,
     p.last_announced_at,
     p.custom_text_before,
-    p.custom_text_after
+    p.custom_text_after,
+    p.schedule,
+    p.location,
+    p.next_announced_at,
+    p.division
 FROM guild_config AS g
 JOIN announcements AS p
 ON g.guild_id = p.guild_id
 WHERE g.enabled = 1
 AND p.starts_at <= unixepoch('now')
-AND p.ends_at >= unixepoch('now')
-AND (p.last_announced_at + p.interval) <= unixepoch('now')
-ORDER BY (p.last_announced_at + p.interval) ASC;
-
+AND p.ends_at >= p.next_announced_at
+AND p.next_announced_at <= unixepoch('now')
+ORDER BY p.next_announced_at ASC, p.guild_id, p.name;
 
 -- name: ContinueAnnouncement :exec
 UPDATE announcements
-SET last_announced_at = (last_announced_at + interval)
-WHERE guild_id = :guild_id;
-
-
--- name: ContinueAnnouncements :exec
-UPDATE announcements
-SET last_announced_at = last_announced_at + interval
-WHERE guild_id IN (sqlc.slice('guild_id'));
+SET
+    last_announced_at = next_announced_at,
+    next_announced_at = :next_announced_at
+WHERE guild_id = :guild_id
+AND name = :name;
 
 -- name: CountAnnouncements :one
 SELECT COUNT(*)
@@ -75,16 +123,24 @@ FROM announcements;
 
 -- name: NextAnnouncement :one
 SELECT
-    guild_id,
-    starts_at,
-    ends_at,
-    channel_id,
-    interval,
-    last_announced_at,
-    custom_text_before,
-    custom_text_after
-FROM announcements
-ORDER BY last_announced_at
+    p.guild_id,
+    p.name,
+    p.starts_at,
+    p.ends_at,
+    p.channel_id,
+    p.interval,
+    p.last_announced_at,
+    p.custom_text_before,
+    p.custom_text_after,
+    p.schedule,
+    p.location,
+    p.next_announced_at,
+    p.division
+FROM guild_config AS g
+JOIN announcements AS p
+ON g.guild_id = p.guild_id
+WHERE g.enabled = 1
+AND p.ends_at >= p.next_announced_at
+ORDER BY p.next_announced_at ASC
 LIMIT 1;
 
-
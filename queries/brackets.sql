@@ -0,0 +1,131 @@
+-- name: AddBracket :one
+INSERT INTO brackets (
+    guild_id,
+    season_id,
+    name,
+    kind,
+    slots,
+    location,
+    moderator_ids,
+    channel_id,
+    created_at,
+    created_by
+) VALUES (
+    :guild_id,
+    :season_id,
+    :name,
+    :kind,
+    :slots,
+    :location,
+    :moderator_ids,
+    :channel_id,
+    :created_at,
+    :created_by
+) RETURNING bracket_id;
+
+-- name: GetBracketByName :one
+SELECT
+    bracket_id,
+    guild_id,
+    season_id,
+    name,
+    kind,
+    slots,
+    location,
+    moderator_ids,
+    moderator_turn,
+    channel_id,
+    created_at,
+    created_by
+FROM brackets
+WHERE guild_id = :guild_id
+AND name = :name;
+
+-- name: GetBracketOfFixture :one
+SELECT
+    b.bracket_id,
+    b.guild_id,
+    b.season_id,
+    b.name,
+    b.kind,
+    b.slots,
+    b.location,
+    b.moderator_ids,
+    b.moderator_turn,
+    b.channel_id,
+    b.created_at,
+    b.created_by
+FROM brackets AS b
+JOIN bracket_slots AS s
+ON b.bracket_id = s.bracket_id
+WHERE s.fixture_id = :fixture_id;
+
+-- name: ListGuildBracketNames :many
+SELECT name
+FROM brackets
+WHERE guild_id = :guild_id
+ORDER BY name;
+
+-- name: UpdateBracketModeratorTurn :exec
+UPDATE brackets
+SET moderator_turn = :moderator_turn
+WHERE bracket_id = :bracket_id;
+
+-- name: AddBracketSlot :exec
+INSERT OR REPLACE INTO bracket_slots (
+    bracket_id,
+    slot_index,
+    section,
+    round,
+    position,
+    team_1,
+    team_2,
+    bye_1,
+    bye_2,
+    winner_to,
+    winner_side,
+    loser_to,
+    loser_side,
+    done,
+    winner,
+    fixture_id
+) VALUES (
+    :bracket_id,
+    :slot_index,
+    :section,
+    :round,
+    :position,
+    :team_1,
+    :team_2,
+    :bye_1,
+    :bye_2,
+    :winner_to,
+    :winner_side,
+    :loser_to,
+    :loser_side,
+    :done,
+    :winner,
+    :fixture_id
+);
+
+-- name: ListBracketSlots :many
+SELECT
+    bracket_id,
+    slot_index,
+    section,
+    round,
+    position,
+    team_1,
+    team_2,
+    bye_1,
+    bye_2,
+    winner_to,
+    winner_side,
+    loser_to,
+    loser_side,
+    done,
+    winner,
+    fixture_id
+FROM bracket_slots
+WHERE bracket_id = :bracket_id
+ORDER BY slot_index;
@@ -0,0 +1,119 @@
+-- name: AddDivision :exec
+INSERT INTO divisions (
+    guild_id,
+    name,
+    category_id,
+    channel_access_offset,
+    requirements_offset,
+    channel_delete_offset,
+    notification_offsets
+) VALUES (
+    :guild_id,
+    :name,
+    :category_id,
+    :channel_access_offset,
+    :requirements_offset,
+    :channel_delete_offset,
+    :notification_offsets
+) ON CONFLICT (guild_id, name) DO UPDATE SET
+    channel_access_offset = excluded.channel_access_offset,
+    requirements_offset = excluded.requirements_offset,
+    channel_delete_offset = excluded.channel_delete_offset,
+    notification_offsets = excluded.notification_offsets;
+
+-- name: UpdateDivisionCategoryId :exec
+UPDATE divisions
+SET
+    category_id = :category_id
+WHERE guild_id = :guild_id
+AND name = :name;
+
+-- name: GetDivision :one
+SELECT
+    guild_id,
+    name,
+    category_id,
+    channel_access_offset,
+    requirements_offset,
+    channel_delete_offset,
+    notification_offsets
+FROM divisions
+WHERE guild_id = :guild_id
+AND name = :name;
+
+-- name: GetDivisionByCategory :one
+SELECT
+    guild_id,
+    name,
+    category_id,
+    channel_access_offset,
+    requirements_offset,
+    channel_delete_offset,
+    notification_offsets
+FROM divisions
+WHERE category_id = :category_id;
+
+-- name: ListGuildDivisions :many
+SELECT
+    guild_id,
+    name,
+    category_id,
+    channel_access_offset,
+    requirements_offset,
+    channel_delete_offset,
+    notification_offsets
+FROM divisions
+WHERE guild_id = :guild_id
+ORDER BY name;
+
+-- name: ListGuildDivisionNames :many
+SELECT name
+FROM divisions
+WHERE guild_id = :guild_id
+ORDER BY name;
+
+-- name: CountGuildDivisions :one
+SELECT COUNT(*)
+FROM divisions
+WHERE guild_id = :guild_id;
+
+-- name: DeleteDivision :exec
+DELETE FROM divisions
+WHERE guild_id = :guild_id
+AND name = :name;
+
+-- name: CountDivisionMatches :one
+SELECT COUNT(*)
+FROM matches
+WHERE guild_id = :guild_id
+AND division = :division
+AND channel_deleted = 0;
+
+-- name: AddDivisionTeam :exec
+INSERT OR REPLACE INTO division_teams (
+    guild_id,
+    role_id,
+    division
+) VALUES (
+    :guild_id,
+    :role_id,
+    :division
+);
+
+-- name: DeleteDivisionTeam :exec
+DELETE FROM division_teams
+WHERE guild_id = :guild_id
+AND role_id = :role_id;
+
+-- name: GetTeamDivision :one
+SELECT division
+FROM division_teams
+WHERE guild_id = :guild_id
+AND role_id = :role_id;
+
+-- name: ListDivisionTeamRoleIDs :many
+SELECT role_id
+FROM division_teams
+WHERE guild_id = :guild_id
+AND division = :division
+ORDER BY role_id;
@@ -28,7 +28,12 @@ SET
     event_creation_enabled = :event_creation_enabled,
     channel_delete_offset = :channel_delete_offset,
     requirements_offset = :requirements_offset,
-    notification_offsets = :notification_offsets
+    notification_offsets = :notification_offsets,
+    points_win = :points_win,
+    points_draw = :points_draw,
+    points_loss = :points_loss,
+    language = :language,
+    output_mode = :output_mode
 WHERE guild_id = :guild_id;
 
 -- name: UpdateCategoryId :exec
@@ -52,7 +57,12 @@ SELECT
     event_creation_enabled,
     channel_delete_offset,
     requirements_offset,
-    notification_offsets
+    notification_offsets,
+    points_win,
+    points_draw,
+    points_loss,
+    language,
+    output_mode
 FROM guild_config
 WHERE guild_id = :guild_id;
 
@@ -66,6 +76,16 @@ SET match_counter = match_counter + 1
 WHERE guild_id = :guild_id
 RETURNING match_counter;
 
+-- name: GetGuildLanguage :one
+SELECT language
+FROM guild_config
+WHERE guild_id = :guild_id;
+
+-- name: GetGuildOutputMode :one
+SELECT output_mode
+FROM guild_config
+WHERE guild_id = :guild_id;
+
 -- name: IsGuildEnabled :one
 SELECT enabled
 FROM guild_config
@@ -1,6 +1,7 @@
--- name: AddMatch :exec
+-- name: AddMatch :one
 INSERT INTO matches (
     guild_id,
+    number,
     channel_id,
     channel_accessible_at,
     channel_accessible,
@@ -11,9 +12,11 @@ INSERT INTO matches (
     created_at,
     created_by,
     updated_at,
-    updated_by
+    updated_by,
+    division
 ) VALUES (
     :guild_id,
+    :number,
     :channel_id,
     :channel_accessible_at,
     :channel_accessible,
@@ -24,32 +27,45 @@ INSERT INTO matches (
     :created_at,
     :created_by,
     :updated_at,
-    :updated_by
-);
+    :updated_by,
+    :division
+) RETURNING match_id;
 
 -- name: DeleteGuildMatches :exec
 DELETE FROM matches WHERE guild_id = :guild_id;
 
 -- name: DeleteMatch :exec
-DELETE FROM matches WHERE channel_id = :channel_id;
+DELETE FROM matches WHERE match_id = :match_id;
 
--- name: DeleteMatchList :exec
-DELETE FROM matches WHERE channel_id IN (sqlc.slice('channel_id'));
+-- name: ArchiveMatchList :exec
+UPDATE matches
+SET
+    channel_id = '',
+    message_id = '',
+    channel_accessible = 1,
+    channel_deleted = 1
+WHERE match_id IN (sqlc.slice('match_id'));
 
 -- name: ListGuildMatches :many
 SELECT
+    match_id,
     guild_id,
+    number,
     channel_id,
-    channel_accessible_at,
     channel_accessible,
+    channel_accessible_at,
     channel_delete_at,
     message_id,
-    event_id,
     scheduled_at,
     created_at,
     created_by,
     updated_at,
-    updated_by
+    updated_by,
+    event_id,
+    cancelled_at,
+    cancel_reason,
+    division,
+    channel_deleted
 FROM matches
 WHERE guild_id = :guild_id
 ORDER BY scheduled_at ASC;
@@ -64,39 +80,100 @@ SET
     scheduled_at = :scheduled_at,
     updated_at = :updated_at,
     updated_by = :updated_by
-WHERE channel_id = :channel_id;
+WHERE match_id = :match_id;
 
 
 -- name: GetMatch :one
 SELECT
+    match_id,
     guild_id,
+    number,
     channel_id,
+    channel_accessible,
     channel_accessible_at,
+    channel_delete_at,
+    message_id,
+    scheduled_at,
+    created_at,
+    created_by,
+    updated_at,
+    updated_by,
+    event_id,
+    cancelled_at,
+    cancel_reason,
+    division,
+    channel_deleted
+FROM matches
+WHERE match_id = :match_id;
+
+-- name: GetMatchByChannel :one
+SELECT
+    match_id,
+    guild_id,
+    number,
+    channel_id,
     channel_accessible,
+    channel_accessible_at,
     channel_delete_at,
     message_id,
+    scheduled_at,
+    created_at,
+    created_by,
+    updated_at,
+    updated_by,
     event_id,
+    cancelled_at,
+    cancel_reason,
+    division,
+    channel_deleted
+FROM matches
+WHERE channel_id = :channel_id
+AND channel_id != '';
+
+-- name: GetMatchByNumber :one
+SELECT
+    match_id,
+    guild_id,
+    number,
+    channel_id,
+    channel_accessible,
+    channel_accessible_at,
+    channel_delete_at,
+    message_id,
     scheduled_at,
     created_at,
     created_by,
     updated_at,
-    updated_by
+    updated_by,
+    event_id,
+    cancelled_at,
+    cancel_reason,
+    division,
+    channel_deleted
 FROM matches
-WHERE channel_id = :channel_id;
+WHERE guild_id = :guild_id
+AND number = :number;
 
 -- name: ListNowAccessibleChannels :many
 SELECT
+    match_id,
     guild_id,
+    number,
     channel_id,
-    channel_accessible_at,
     channel_accessible,
+    channel_accessible_at,
     channel_delete_at,
     message_id,
     scheduled_at,
     created_at,
     created_by,
     updated_at,
-    updated_by
+    updated_by,
+    event_id,
+    cancelled_at,
+    cancel_reason,
+    division,
+    channel_deleted
 FROM matches
 WHERE matches.channel_accessible = 0
 AND matches.channel_accessible_at <= unixepoch('now')
@@ -104,75 +181,113 @@ ORDER BY channel_accessible_at ASC;
 
 -- name: NextAccessibleChannel :one
 SELECT
+    match_id,
     guild_id,
+    number,
     channel_id,
-    channel_accessible_at,
     channel_accessible,
+    channel_accessible_at,
     channel_delete_at,
     message_id,
     scheduled_at,
     created_at,
     created_by,
     updated_at,
-    updated_by
+    updated_by,
+    event_id,
+    cancelled_at,
+    cancel_reason,
+    division,
+    channel_deleted
 FROM matches
 WHERE matches.channel_accessible = 0
 ORDER BY channel_accessible_at ASC
 LIMIT 1;
 
+-- name: UpdateMatchChannel :exec
+UPDATE matches
+SET
+    channel_id = :channel_id,
+    message_id = :message_id
+WHERE match_id = :match_id;
+
+-- name: ResetMatchChannel :exec
+UPDATE matches
+SET
+    channel_id = '',
+    message_id = '',
+    event_id = '',
+    channel_accessible = 0
+WHERE match_id = :match_id;
+
 -- name: UpdateMatchChannelAccessibility :exec
 UPDATE matches
 SET
     channel_accessible = :channel_accessible
-WHERE channel_id = :channel_id;
+WHERE match_id = :match_id;
 
 -- name: UpdateMatchEventID :exec
 UPDATE matches
 SET
     event_id = :event_id
-WHERE channel_id = :channel_id;
+WHERE match_id = :match_id;
 
 
 -- name: ListNowDeletableChannels :many
 SELECT
+    match_id,
     guild_id,
+    number,
     channel_id,
-    channel_accessible_at,
     channel_accessible,
+    channel_accessible_at,
     channel_delete_at,
     message_id,
-    event_id,
     scheduled_at,
     created_at,
     created_by,
     updated_at,
-    updated_by
+    updated_by,
+    event_id,
+    cancelled_at,
+    cancel_reason,
+    division,
+    channel_deleted
 FROM matches
-WHERE matches.channel_delete_at <= unixepoch('now')
+WHERE matches.channel_deleted = 0
+AND matches.channel_delete_at <= unixepoch('now')
 ORDER BY channel_delete_at ASC;
 
 -- name: NextDeletableChannel :one
 SELECT
+    match_id,
     guild_id,
+    number,
     channel_id,
-    channel_accessible_at,
     channel_accessible,
+    channel_accessible_at,
     channel_delete_at,
     message_id,
-    event_id,
     scheduled_at,
     created_at,
     created_by,
     updated_at,
-    updated_by
+    updated_by,
+    event_id,
+    cancelled_at,
+    cancel_reason,
+    division,
+    channel_deleted
 FROM matches
+WHERE matches.channel_deleted = 0
 ORDER BY channel_delete_at ASC
 LIMIT 1;
 
 -- name: CountMatches :one
 SELECT COUNT(*) AS count
 FROM matches
-WHERE guild_id = :guild_id;
+WHERE guild_id = :guild_id
+AND channel_id != '';
 
 -- name: CountAllMatches :one
 SELECT COUNT(*) AS count
@@ -188,20 +303,39 @@ AND event_id = :event_id;
 
 -- name: ListGuildMatchesScheduledBetween :many
 SELECT
+    match_id,
     guild_id,
+    number,
     channel_id,
-    channel_accessible_at,
     channel_accessible,
+    channel_accessible_at,
     channel_delete_at,
     message_id,
-    event_id,
     scheduled_at,
     created_at,
     created_by,
     updated_at,
-    updated_by
+    updated_by,
+    event_id,
+    cancelled_at,
+    cancel_reason,
+    division,
+    channel_deleted
 FROM matches
 WHERE scheduled_at BETWEEN :minAt AND :maxAt
 AND guild_id = :guild_id
+AND cancelled_at = 0
+AND channel_deleted = 0
 ORDER BY scheduled_at ASC;
 
+
+
+-- name: CancelMatch :exec
+UPDATE matches
+SET
+    cancelled_at = :cancelled_at,
+    cancel_reason = :cancel_reason,
+    channel_delete_at = :channel_delete_at,
+    updated_at = :updated_at,
+    updated_by = :updated_by
+WHERE match_id = :match_id;
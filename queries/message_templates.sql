@@ -0,0 +1,45 @@
+-- name: SetMessageTemplate :exec
+INSERT INTO message_templates (
+    guild_id,
+    kind,
+    template,
+    updated_at,
+    updated_by
+) VALUES (
+    :guild_id,
+    :kind,
+    :template,
+    :updated_at,
+    :updated_by
+)
+ON CONFLICT (guild_id, kind) DO UPDATE SET
+    template = excluded.template,
+    updated_at = excluded.updated_at,
+    updated_by = excluded.updated_by;
+
+-- name: GetMessageTemplate :one
+SELECT
+    guild_id,
+    kind,
+    template,
+    updated_at,
+    updated_by
+FROM message_templates
+WHERE guild_id = :guild_id
+AND kind = :kind;
+
+-- name: DeleteMessageTemplate :exec
+DELETE FROM message_templates
+WHERE guild_id = :guild_id
+AND kind = :kind;
+
+-- name: ListMessageTemplates :many
+SELECT
+    guild_id,
+    kind,
+    template,
+    updated_at,
+    updated_by
+FROM message_templates
+WHERE guild_id = :guild_id
+ORDER BY kind;
@@ -1,33 +1,39 @@
 
 -- name: AddMatchModerator :exec
 INSERT INTO moderators (
-    channel_id,
+    match_id,
     user_id
 ) VALUES (
-    :channel_id,
+    :match_id,
     :user_id
 );
 
 -- name: DeleteMatchModerator :exec
 DELETE FROM moderators
-WHERE channel_id = :channel_id
+WHERE match_id = :match_id
 AND user_id = :user_id;
 
 -- name: DeleteMatchModerators :exec
 DELETE FROM moderators
-WHERE channel_id = :channel_id
+WHERE match_id = :match_id
 AND user_id IN (sqlc.slice('user_id'));
 
 -- name: DeleteAllMatchModerators :exec
 DELETE FROM moderators
-WHERE channel_id = :channel_id;
+WHERE match_id = :match_id;
 
 -- name: ListMatchModerators :many
 SELECT
-    channel_id,
+    match_id,
     user_id
 FROM moderators
-WHERE channel_id = :channel_id
+WHERE match_id = :match_id
 ORDER BY user_id;
 
 
+
+-- name: IsMatchModerator :one
+SELECT COUNT(*) > 0
+FROM moderators
+WHERE match_id = :match_id
+AND user_id = :user_id;
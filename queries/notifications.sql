@@ -1,24 +1,26 @@
 -- name: GetNotificationByOffset :one
-SELECT channel_id, notify_at, custom_text
+SELECT match_id, notify_at, custom_text
 FROM notifications
-WHERE channel_id = :channel_id
+WHERE match_id = :match_id
 ORDER BY notify_at
 LIMIT 1
 OFFSET :offset;
 
 -- name: AddNotification :exec
 INSERT INTO notifications (
-    channel_id,
+    match_id,
     notify_at,
     custom_text,
+    generated,
     created_by,
     created_at,
     updated_by,
     updated_at
 ) VALUES (
-    :channel_id,
+    :match_id,
     :notify_at,
     :custom_text,
+    :generated,
     :created_by,
     :created_at,
     :updated_by,
@@ -28,52 +30,72 @@ INSERT INTO notifications (
 
 -- name: DeleteNotification :exec
 DELETE FROM notifications
-WHERE channel_id = :channel_id
+WHERE match_id = :match_id
 AND notify_at = :notify_at;
 
 -- name: ListNotifications :many
-SELECT channel_id, notify_at, custom_text
+SELECT match_id, notify_at, custom_text
 FROM notifications
-WHERE channel_id = :channel_id
+WHERE match_id = :match_id
 ORDER BY notify_at ASC;
 
 -- name: CountNotifications :one
 SELECT COUNT(*)
 FROM notifications
-WHERE channel_id = :channel_id;
+WHERE match_id = :match_id;
 
 -- name: ListNowDueNotifications :many
 SELECT
-    channel_id,
+    match_id,
     notify_at,
     custom_text,
     created_at,
     created_by,
     updated_at,
-    updated_by
+    updated_by,
+    generated
 FROM notifications
 WHERE notify_at <= unixepoch('now')
+-- notifications are kept pending until the match channel exists
+AND match_id IN (
+    SELECT match_id
+    FROM matches
+    WHERE channel_id != ''
+    OR channel_deleted = 1
+)
 ORDER BY notify_at ASC;
 
 -- name: NextNotification :one
 SELECT
-    channel_id,
+    match_id,
     notify_at,
     custom_text,
     created_at,
     created_by,
     updated_at,
-    updated_by
+    updated_by,
+    generated
 FROM notifications
+WHERE match_id IN (
+    SELECT match_id
+    FROM matches
+    WHERE channel_id != ''
+    OR channel_deleted = 1
+)
 ORDER BY notify_at ASC
 LIMIT 1;
 
 -- name: DeleteMatchNotifications :exec
 DELETE FROM notifications
-WHERE channel_id = :channel_id;
+WHERE match_id = :match_id;
 
 -- name: CountAllNotifications :one
 SELECT COUNT(*)
 FROM notifications;
 
 
+
+-- name: DeleteMatchGeneratedNotifications :exec
+DELETE FROM notifications
+WHERE match_id = :match_id
+AND generated = 1;
@@ -0,0 +1,41 @@
+-- name: AddOverflowCategory :exec
+INSERT INTO overflow_categories (
+    category_id,
+    guild_id,
+    division,
+    number
+) VALUES (
+    :category_id,
+    :guild_id,
+    :division,
+    :number
+);
+
+-- name: GetOverflowCategory :one
+SELECT
+    category_id,
+    guild_id,
+    division,
+    number
+FROM overflow_categories
+WHERE category_id = :category_id;
+
+-- name: ListOverflowCategories :many
+SELECT
+    category_id,
+    guild_id,
+    division,
+    number
+FROM overflow_categories
+WHERE guild_id = :guild_id
+AND division = :division
+ORDER BY number;
+
+-- name: DeleteOverflowCategory :exec
+DELETE FROM overflow_categories
+WHERE category_id = :category_id;
+
+-- name: DeleteDivisionOverflowCategories :exec
+DELETE FROM overflow_categories
+WHERE guild_id = :guild_id
+AND division = :division;
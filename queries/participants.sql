@@ -0,0 +1,80 @@
+-- name: AddParticipant :exec
+INSERT INTO participants (
+    match_id,
+    role_id,
+    user_id,
+    joined_at,
+    reaction,
+    substitute
+) VALUES (
+    :match_id,
+    :role_id,
+    :user_id,
+    :joined_at,
+    :reaction,
+    :substitute
+);
+
+-- name: RemoveParticipant :exec
+DELETE FROM participants
+WHERE match_id = :match_id
+AND user_id = :user_id;
+
+-- name: GetParticipant :one
+SELECT
+    match_id,
+    role_id,
+    user_id,
+    joined_at,
+    reaction,
+    substitute
+FROM participants
+WHERE match_id = :match_id
+AND user_id = :user_id;
+
+-- name: ListParticipants :many
+SELECT
+    match_id,
+    role_id,
+    user_id,
+    joined_at,
+    reaction,
+    substitute
+FROM participants
+WHERE match_id = :match_id
+ORDER BY joined_at, user_id;
+
+-- name: CountTeamStarters :one
+SELECT COUNT(*)
+FROM participants
+WHERE match_id = :match_id
+AND role_id = :role_id
+AND substitute = 0;
+
+-- name: CountTeamSubstitutes :one
+SELECT COUNT(*)
+FROM participants
+WHERE match_id = :match_id
+AND role_id = :role_id
+AND substitute = 1;
+
+-- name: GetFirstTeamSubstitute :one
+SELECT
+    match_id,
+    role_id,
+    user_id,
+    joined_at,
+    reaction,
+    substitute
+FROM participants
+WHERE match_id = :match_id
+AND role_id = :role_id
+AND substitute = 1
+ORDER BY joined_at, user_id
+LIMIT 1;
+
+-- name: PromoteSubstitute :exec
+UPDATE participants
+SET substitute = 0
+WHERE match_id = :match_id
+AND user_id = :user_id;
@@ -1,11 +1,11 @@
 -- name: AddParticipationRequirements :exec
 INSERT INTO participation_requirements (
-    channel_id,
+    match_id,
     participants_per_team,
     deadline_at,
     entry_closed
 ) VALUES (
-    :channel_id,
+    :match_id,
     :participants_per_team,
     :deadline_at,
     :entry_closed
@@ -13,16 +13,27 @@ INSERT INTO participation_requirements (
 
 -- name: GetParticipationRequirements :one
 SELECT
-    channel_id,
+    match_id,
     participants_per_team,
     deadline_at,
     entry_closed
 FROM participation_requirements
-WHERE channel_id = :channel_id;
+WHERE match_id = :match_id;
+
+-- name: GetParticipationRequirementsByChannel :one
+SELECT
+    pr.match_id,
+    pr.participants_per_team,
+    pr.deadline_at,
+    pr.entry_closed
+FROM participation_requirements pr
+JOIN matches m ON m.match_id = pr.match_id
+WHERE m.channel_id = :channel_id
+AND m.channel_id != '';
 
 -- name: DeleteParticipationRequirements :exec
 DELETE FROM participation_requirements
-WHERE channel_id = :channel_id;
+WHERE match_id = :match_id;
 
 -- name: UpdateParticipationRequirements :exec
 UPDATE participation_requirements
@@ -30,35 +41,69 @@ SET
     participants_per_team = :participants_per_team,
     deadline_at = :deadline_at,
     entry_closed = :entry_closed
-WHERE channel_id = :channel_id;
+WHERE match_id = :match_id;
 
 
 -- name: CloseParticipationEntry :exec
 UPDATE participation_requirements
 SET
     entry_closed = 1
-WHERE channel_id = :channel_id;
+WHERE match_id = :match_id;
 
 
 -- name: ListNowDueParticipationRequirements :many
 SELECT
-    channel_id,
+    match_id,
     participants_per_team,
     deadline_at,
     entry_closed
 FROM participation_requirements
 WHERE participation_requirements.deadline_at <= unixepoch('now')
 AND participation_requirements.entry_closed = 0
+-- the deadline is deferred until the match channel exists
+AND participation_requirements.match_id IN (
+    SELECT match_id
+    FROM matches
+    WHERE channel_id != ''
+    OR channel_deleted = 1
+)
 ORDER BY deadline_at ASC;
 
 -- name: NextParticipationRequirement :one
 SELECT
-    channel_id,
+    match_id,
     participants_per_team,
     deadline_at,
     entry_closed
 FROM participation_requirements
 WHERE participation_requirements.entry_closed = 0
+AND participation_requirements.match_id IN (
+    SELECT match_id
+    FROM matches
+    WHERE channel_id != ''
+    OR channel_deleted = 1
+)
 ORDER BY deadline_at ASC
 LIMIT 1;
 
+
+-- name: ListOpenLegacyParticipationRequirements :many
+SELECT
+    pr.match_id,
+    pr.participants_per_team,
+    m.guild_id,
+    m.channel_id,
+    m.message_id
+FROM participation_requirements pr
+JOIN matches m ON m.match_id = pr.match_id
+WHERE pr.entry_closed = 0
+AND m.cancelled_at = 0
+AND m.message_id != ''
+-- only legacy match messages have participation reactions
+AND EXISTS (
+    SELECT 1
+    FROM participants p
+    WHERE p.match_id = pr.match_id
+    AND p.reaction = 1
+)
+ORDER BY pr.deadline_at ASC;
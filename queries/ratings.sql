@@ -0,0 +1,76 @@
+
+-- name: AddTeamRating :exec
+INSERT OR REPLACE INTO team_ratings (
+    guild_id,
+    role_id,
+    rating,
+    matches,
+    updated_at
+) VALUES (
+    :guild_id,
+    :role_id,
+    :rating,
+    :matches,
+    :updated_at
+);
+
+-- name: GetTeamRating :one
+SELECT
+    guild_id,
+    role_id,
+    rating,
+    matches,
+    updated_at
+FROM team_ratings
+WHERE guild_id = :guild_id
+AND role_id = :role_id;
+
+-- name: ListGuildTeamRatings :many
+SELECT
+    guild_id,
+    role_id,
+    rating,
+    matches,
+    updated_at
+FROM team_ratings
+WHERE guild_id = :guild_id
+ORDER BY rating DESC, role_id;
+
+-- name: DeleteGuildTeamRatings :exec
+DELETE FROM team_ratings
+WHERE guild_id = :guild_id;
+
+-- name: AddRatingHistory :exec
+INSERT OR REPLACE INTO rating_history (
+    guild_id,
+    role_id,
+    match_id,
+    rating_before,
+    rating_after,
+    rated_at
+) VALUES (
+    :guild_id,
+    :role_id,
+    :match_id,
+    :rating_before,
+    :rating_after,
+    :rated_at
+);
+
+-- name: ListTeamRatingHistory :many
+SELECT
+    guild_id,
+    role_id,
+    match_id,
+    rating_before,
+    rating_after,
+    rated_at
+FROM rating_history
+WHERE guild_id = :guild_id
+AND role_id = :role_id
+ORDER BY rated_at DESC, match_id DESC
+LIMIT :limit;
+
+-- name: DeleteGuildRatingHistory :exec
+DELETE FROM rating_history
+WHERE guild_id = :guild_id;
@@ -0,0 +1,51 @@
+-- name: AddRescheduleProposal :exec
+INSERT OR REPLACE INTO reschedule_proposals (
+    match_id,
+    role_id,
+    scheduled_at,
+    channel_id,
+    message_id,
+    proposed_at,
+    proposed_by
+) VALUES (
+    :match_id,
+    :role_id,
+    :scheduled_at,
+    :channel_id,
+    :message_id,
+    :proposed_at,
+    :proposed_by
+);
+
+-- name: GetRescheduleProposal :one
+SELECT
+    match_id,
+    role_id,
+    scheduled_at,
+    channel_id,
+    message_id,
+    proposed_at,
+    proposed_by
+FROM reschedule_proposals
+WHERE match_id = :match_id;
+
+-- name: GetRescheduleProposalByMessage :one
+SELECT
+    match_id,
+    role_id,
+    scheduled_at,
+    channel_id,
+    message_id,
+    proposed_at,
+    proposed_by
+FROM reschedule_proposals
+WHERE message_id = :message_id;
+
+-- name: UpdateRescheduleProposalMessage :exec
+UPDATE reschedule_proposals
+SET message_id = :message_id
+WHERE match_id = :match_id;
+
+-- name: DeleteRescheduleProposal :exec
+DELETE FROM reschedule_proposals
+WHERE match_id = :match_id;
@@ -0,0 +1,180 @@
+-- name: AddResult :exec
+INSERT INTO results (
+    match_id,
+    guild_id,
+    channel_name,
+    scheduled_at,
+    reported_at,
+    reported_by,
+    division
+) VALUES (
+    :match_id,
+    :guild_id,
+    :channel_name,
+    :scheduled_at,
+    :reported_at,
+    :reported_by,
+    :division
+) ON CONFLICT (match_id) DO UPDATE SET
+    channel_name = excluded.channel_name,
+    division = excluded.division,
+    scheduled_at = excluded.scheduled_at,
+    reported_at = excluded.reported_at,
+    reported_by = excluded.reported_by,
+    status = 'PENDING',
+    finalized_at = 0,
+    finalized_by = '';
+
+-- name: GetResult :one
+SELECT
+    match_id,
+    guild_id,
+    channel_name,
+    scheduled_at,
+    reported_at,
+    reported_by,
+    status,
+    message_id,
+    finalized_at,
+    finalized_by,
+    division,
+    message_channel_id
+FROM results
+WHERE match_id = :match_id;
+
+-- name: GetResultByMessage :one
+SELECT
+    match_id,
+    guild_id,
+    channel_name,
+    scheduled_at,
+    reported_at,
+    reported_by,
+    status,
+    message_id,
+    finalized_at,
+    finalized_by,
+    division,
+    message_channel_id
+FROM results
+WHERE message_channel_id = :message_channel_id
+AND message_id = :message_id;
+
+-- name: AddTeamResult :exec
+INSERT INTO team_results (
+    match_id,
+    role_id,
+    score,
+    time,
+    screenshot,
+    screenshot_name,
+    demo,
+    demo_name,
+    reported_at,
+    reported_by
+) VALUES (
+    :match_id,
+    :role_id,
+    :score,
+    :time,
+    :screenshot,
+    :screenshot_name,
+    :demo,
+    :demo_name,
+    :reported_at,
+    :reported_by
+) ON CONFLICT (match_id, role_id) DO UPDATE SET
+    score = excluded.score,
+    time = excluded.time,
+    screenshot = COALESCE(excluded.screenshot, team_results.screenshot),
+    screenshot_name = CASE WHEN excluded.screenshot IS NULL THEN team_results.screenshot_name ELSE excluded.screenshot_name END,
+    demo = COALESCE(excluded.demo, team_results.demo),
+    demo_name = CASE WHEN excluded.demo IS NULL THEN team_results.demo_name ELSE excluded.demo_name END,
+    reported_at = excluded.reported_at,
+    reported_by = excluded.reported_by;
+
+-- name: ListTeamResults :many
+SELECT
+    match_id,
+    role_id,
+    score,
+    time,
+    screenshot_name,
+    demo_name,
+    reported_at,
+    reported_by
+FROM team_results
+WHERE match_id = :match_id
+ORDER BY role_id;
+
+-- name: UpdateResultMessage :exec
+UPDATE results
+SET
+    message_channel_id = :message_channel_id,
+    message_id = :message_id
+WHERE match_id = :match_id;
+
+-- name: UpdateResultStatus :exec
+UPDATE results
+SET
+    status = :status,
+    finalized_at = :finalized_at,
+    finalized_by = :finalized_by
+WHERE match_id = :match_id;
+
+-- name: AddResultConfirmation :exec
+INSERT INTO result_confirmations (
+    match_id,
+    role_id,
+    user_id,
+    confirmed_at
+) VALUES (
+    :match_id,
+    :role_id,
+    :user_id,
+    :confirmed_at
+) ON CONFLICT (match_id, role_id) DO UPDATE SET
+    user_id = excluded.user_id,
+    confirmed_at = excluded.confirmed_at;
+
+-- name: CountResultConfirmations :one
+SELECT COUNT(*)
+FROM result_confirmations
+WHERE match_id = :match_id;
+
+-- name: DeleteResultConfirmations :exec
+DELETE FROM result_confirmations
+WHERE match_id = :match_id;
+
+-- name: ListGuildFinalTeamResults :many
+SELECT
+    t.match_id,
+    t.role_id,
+    t.score,
+    r.scheduled_at
+FROM results AS r
+JOIN team_results AS t
+ON r.match_id = t.match_id
+JOIN matches AS m
+ON r.match_id = m.match_id
+WHERE r.guild_id = :guild_id
+AND r.status = 'CONFIRMED'
+AND m.cancelled_at = 0
+ORDER BY r.scheduled_at, t.match_id, t.role_id;
+
+-- name: ListDivisionFinalTeamResults :many
+SELECT
+    t.match_id,
+    t.role_id,
+    t.score,
+    r.scheduled_at
+FROM results AS r
+JOIN team_results AS t
+ON r.match_id = t.match_id
+JOIN matches AS m
+ON r.match_id = m.match_id
+WHERE r.guild_id = :guild_id
+AND r.division = :division
+AND r.status = 'CONFIRMED'
+AND m.cancelled_at = 0
+ORDER BY r.scheduled_at, t.match_id, t.role_id;
@@ -0,0 +1,58 @@
+-- name: AddScheduleBoard :exec
+INSERT OR REPLACE INTO schedule_boards (
+    guild_id,
+    channel_id,
+    days
+) VALUES (
+    :guild_id,
+    :channel_id,
+    :days
+);
+
+-- name: GetScheduleBoard :one
+SELECT
+    guild_id,
+    channel_id,
+    days
+FROM schedule_boards
+WHERE guild_id = :guild_id;
+
+-- name: DeleteScheduleBoard :exec
+DELETE FROM schedule_boards
+WHERE guild_id = :guild_id;
+
+-- name: ListEnabledScheduleBoards :many
+SELECT
+    s.guild_id,
+    s.channel_id,
+    s.days
+FROM guild_config AS g
+JOIN schedule_boards AS s
+ON g.guild_id = s.guild_id
+WHERE g.enabled = 1
+ORDER BY s.guild_id;
+
+-- name: AddScheduleBoardMessage :exec
+INSERT OR REPLACE INTO schedule_board_messages (
+    guild_id,
+    position,
+    message_id
+) VALUES (
+    :guild_id,
+    :position,
+    :message_id
+);
+
+-- name: ListScheduleBoardMessages :many
+SELECT
+    guild_id,
+    position,
+    message_id
+FROM schedule_board_messages
+WHERE guild_id = :guild_id
+ORDER BY position ASC;
+
+-- name: DeleteScheduleBoardMessagesFrom :exec
+DELETE FROM schedule_board_messages
+WHERE guild_id = :guild_id
+AND position >= :position;
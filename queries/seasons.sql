@@ -0,0 +1,183 @@
+
+-- name: AddSeason :one
+INSERT INTO seasons (
+    guild_id,
+    participants_per_team,
+    confirmed,
+    created_at,
+    created_by
+) VALUES (
+    :guild_id,
+    :participants_per_team,
+    0,
+    :created_at,
+    :created_by
+) RETURNING season_id;
+
+-- name: GetSeasonDraft :one
+SELECT
+    season_id,
+    guild_id,
+    participants_per_team,
+    confirmed,
+    created_at,
+    created_by
+FROM seasons
+WHERE guild_id = :guild_id
+AND created_by = :created_by
+AND confirmed = 0
+ORDER BY season_id DESC
+LIMIT 1;
+
+-- name: DeleteSeasonDrafts :exec
+DELETE FROM seasons
+WHERE guild_id = :guild_id
+AND created_by = :created_by
+AND confirmed = 0;
+
+-- name: ConfirmSeason :exec
+UPDATE seasons
+SET confirmed = 1
+WHERE season_id = :season_id;
+
+-- name: AddFixture :one
+INSERT INTO fixtures (
+    season_id,
+    round,
+    scheduled_at,
+    moderator_id
+) VALUES (
+    :season_id,
+    :round,
+    :scheduled_at,
+    :moderator_id
+) RETURNING fixture_id;
+
+-- name: AddFixtureTeam :exec
+INSERT INTO fixture_teams (
+    fixture_id,
+    role_id,
+    position
+) VALUES (
+    :fixture_id,
+    :role_id,
+    :position
+);
+
+-- name: CountSeasonFixtures :one
+SELECT COUNT(*)
+FROM fixtures
+WHERE season_id = :season_id;
+
+-- name: ListFixtureTeams :many
+SELECT
+    fixture_id,
+    role_id,
+    position
+FROM fixture_teams
+WHERE fixture_id = :fixture_id
+ORDER BY position;
+
+-- name: UpdateFixtureMatch :exec
+UPDATE fixtures
+SET match_id = :match_id
+WHERE fixture_id = :fixture_id;
+
+-- name: ListSeasonFixturesWithoutMatch :many
+SELECT
+    f.fixture_id,
+    f.season_id,
+    f.round,
+    f.scheduled_at,
+    f.moderator_id,
+    s.guild_id,
+    s.participants_per_team,
+    s.created_by
+FROM fixtures AS f
+JOIN seasons AS s
+ON f.season_id = s.season_id
+WHERE f.season_id = :season_id
+AND f.match_id = 0
+ORDER BY f.scheduled_at, f.fixture_id;
+
+-- name: DeleteFixture :exec
+DELETE FROM fixtures
+WHERE fixture_id = :fixture_id;
+
+-- name: GetFixture :one
+SELECT
+    fixture_id,
+    season_id,
+    round,
+    scheduled_at,
+    moderator_id,
+    match_id
+FROM fixtures
+WHERE fixture_id = :fixture_id;
+
+-- name: GetFixtureByMatch :one
+SELECT
+    fixture_id,
+    season_id,
+    round,
+    scheduled_at,
+    moderator_id,
+    match_id
+FROM fixtures
+WHERE match_id = :match_id;
+
+-- name: DeleteSeason :exec
+DELETE FROM seasons
+WHERE season_id = :season_id;
+
+-- name: ListSeasonFixtures :many
+SELECT
+    fixture_id,
+    season_id,
+    round,
+    scheduled_at,
+    moderator_id,
+    match_id
+FROM fixtures
+WHERE season_id = :season_id
+ORDER BY scheduled_at, fixture_id;
+
+-- name: ListSeasonFixtureResults :many
+SELECT
+    f.fixture_id,
+    f.round,
+    f.match_id,
+    ft.role_id,
+    ft.position,
+    CAST(COALESCE(r.status = 'CONFIRMED', 0) AS INTEGER) AS finished,
+    CAST(COALESCE(tr.score, 0) AS INTEGER) AS score
+FROM fixtures AS f
+JOIN fixture_teams AS ft
+ON f.fixture_id = ft.fixture_id
+LEFT JOIN results AS r
+ON f.match_id != 0
+AND r.match_id = f.match_id
+LEFT JOIN team_results AS tr
+ON tr.match_id = r.match_id
+AND tr.role_id = ft.role_id
+WHERE f.season_id = :season_id
+ORDER BY f.round, f.fixture_id, ft.position;
+
+-- name: ListSeasonMatchIDsWithoutChannel :many
+SELECT m.match_id
+FROM fixtures AS f
+JOIN matches AS m
+ON f.match_id = m.match_id
+WHERE f.season_id = :season_id
+AND m.channel_id = ''
+AND m.channel_deleted = 0
+ORDER BY m.match_id;
+
+-- name: ListConfirmedSeasonsWithoutMatches :many
+SELECT DISTINCT f.season_id
+FROM fixtures AS f
+JOIN seasons AS s
+ON f.season_id = s.season_id
+WHERE s.confirmed = 1
+AND f.match_id = 0
+ORDER BY f.season_id;
@@ -0,0 +1,26 @@
+
+-- name: AddStandingsMessage :exec
+INSERT OR REPLACE INTO standings_messages (
+    guild_id,
+    channel_id,
+    message_id,
+    division
+) VALUES (
+    :guild_id,
+    :channel_id,
+    :message_id,
+    :division
+);
+
+-- name: GetStandingsMessage :one
+SELECT
+    guild_id,
+    channel_id,
+    message_id,
+    division
+FROM standings_messages
+WHERE guild_id = :guild_id;
+
+-- name: DeleteStandingsMessage :exec
+DELETE FROM standings_messages
+WHERE guild_id = :guild_id;
@@ -1,33 +1,33 @@
 -- name: AddMatchStreamer :exec
 INSERT INTO streamers (
-    channel_id,
+    match_id,
     user_id,
     url
 ) VALUES (
-    :channel_id,
+    :match_id,
     :user_id,
     :url
 );
 
 -- name: DeleteMatchStreamer :exec
 DELETE FROM streamers
-WHERE channel_id = :channel_id
+WHERE match_id = :match_id
 AND user_id = :user_id;
 
 -- name: DeleteMatchStreamers :exec
 DELETE FROM streamers
-WHERE channel_id = :channel_id
+WHERE match_id = :match_id
 AND user_id IN (sqlc.slice('user_id'));
 
 -- name: DeleteAllMatchStreamers :exec
 DELETE FROM streamers
-WHERE channel_id = :channel_id;
+WHERE match_id = :match_id;
 
 -- name: ListMatchStreamers :many
 SELECT
-    channel_id,
+    match_id,
     user_id,
     url
 FROM streamers
-WHERE channel_id = :channel_id
+WHERE match_id = :match_id
 ORDER BY user_id;
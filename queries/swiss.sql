@@ -0,0 +1,84 @@
+-- name: AddSwissTournament :one
+INSERT INTO swiss_tournaments (
+    guild_id,
+    season_id,
+    name,
+    slots,
+    location,
+    moderator_ids,
+    created_at,
+    created_by
+) VALUES (
+    :guild_id,
+    :season_id,
+    :name,
+    :slots,
+    :location,
+    :moderator_ids,
+    :created_at,
+    :created_by
+) RETURNING swiss_id;
+
+-- name: GetSwissTournamentByName :one
+SELECT
+    swiss_id,
+    guild_id,
+    season_id,
+    name,
+    slots,
+    location,
+    moderator_ids,
+    moderator_turn,
+    current_round,
+    created_at,
+    created_by
+FROM swiss_tournaments
+WHERE guild_id = :guild_id
+AND name = :name;
+
+-- name: ListGuildSwissTournamentNames :many
+SELECT name
+FROM swiss_tournaments
+WHERE guild_id = :guild_id
+ORDER BY name;
+
+-- name: UpdateSwissTournamentRound :exec
+UPDATE swiss_tournaments
+SET
+    current_round = :current_round,
+    moderator_turn = :moderator_turn
+WHERE swiss_id = :swiss_id;
+
+-- name: AddSwissTeam :exec
+INSERT OR IGNORE INTO swiss_teams (
+    swiss_id,
+    role_id
+) VALUES (
+    :swiss_id,
+    :role_id
+);
+
+-- name: ListSwissTeams :many
+SELECT role_id
+FROM swiss_teams
+WHERE swiss_id = :swiss_id
+ORDER BY role_id;
+
+-- name: AddSwissBye :exec
+INSERT INTO swiss_byes (
+    swiss_id,
+    round,
+    role_id
+) VALUES (
+    :swiss_id,
+    :round,
+    :role_id
+);
+
+-- name: ListSwissByes :many
+SELECT
+    round,
+    role_id
+FROM swiss_byes
+WHERE swiss_id = :swiss_id
+ORDER BY round;
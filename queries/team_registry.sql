@@ -0,0 +1,184 @@
+-- name: AddRegisteredTeam :exec
+INSERT INTO registered_teams (
+    guild_id,
+    role_id,
+    name,
+    tag,
+    logo_url,
+    created_at,
+    created_by,
+    updated_at,
+    updated_by
+) VALUES (
+    :guild_id,
+    :role_id,
+    :name,
+    :tag,
+    :logo_url,
+    :created_at,
+    :created_by,
+    :updated_at,
+    :updated_by
+);
+
+-- name: UpdateRegisteredTeam :exec
+UPDATE registered_teams
+SET
+    name = :name,
+    tag = :tag,
+    logo_url = :logo_url,
+    updated_at = :updated_at,
+    updated_by = :updated_by
+WHERE guild_id = :guild_id
+AND role_id = :role_id;
+
+-- name: DeleteRegisteredTeam :exec
+DELETE FROM registered_teams
+WHERE guild_id = :guild_id
+AND role_id = :role_id;
+
+-- name: GetRegisteredTeam :one
+SELECT
+    guild_id,
+    role_id,
+    name,
+    tag,
+    logo_url,
+    created_at,
+    created_by,
+    updated_at,
+    updated_by
+FROM registered_teams
+WHERE guild_id = :guild_id
+AND role_id = :role_id;
+
+-- name: GetRegisteredTeamByName :one
+SELECT
+    guild_id,
+    role_id,
+    name,
+    tag,
+    logo_url,
+    created_at,
+    created_by,
+    updated_at,
+    updated_by
+FROM registered_teams
+WHERE guild_id = :guild_id
+AND name = :name COLLATE NOCASE;
+
+-- name: ListRegisteredTeams :many
+SELECT
+    guild_id,
+    role_id,
+    name,
+    tag,
+    logo_url,
+    created_at,
+    created_by,
+    updated_at,
+    updated_by
+FROM registered_teams
+WHERE guild_id = :guild_id
+ORDER BY name;
+
+-- name: ListRegisteredTeamsByRoles :many
+SELECT
+    guild_id,
+    role_id,
+    name,
+    tag,
+    logo_url,
+    created_at,
+    created_by,
+    updated_at,
+    updated_by
+FROM registered_teams
+WHERE guild_id = :guild_id
+AND role_id IN (sqlc.slice(':role_ids'))
+ORDER BY name;
+
+-- name: AddTeamMember :exec
+INSERT OR IGNORE INTO team_members (
+    guild_id,
+    role_id,
+    user_id
+) VALUES (
+    :guild_id,
+    :role_id,
+    :user_id
+);
+
+-- name: AddTeamCaptain :exec
+INSERT INTO team_members (
+    guild_id,
+    role_id,
+    user_id,
+    captain
+) VALUES (
+    :guild_id,
+    :role_id,
+    :user_id,
+    1
+) ON CONFLICT (guild_id, role_id, user_id) DO UPDATE SET captain = 1;
+
+-- name: ResetTeamCaptains :exec
+UPDATE team_members
+SET captain = 0
+WHERE guild_id = :guild_id
+AND role_id = :role_id;
+
+-- name: RemoveTeamMember :exec
+DELETE FROM team_members
+WHERE guild_id = :guild_id
+AND role_id = :role_id
+AND user_id = :user_id;
+
+-- name: ListTeamMembers :many
+SELECT
+    guild_id,
+    role_id,
+    user_id,
+    captain
+FROM team_members
+WHERE guild_id = :guild_id
+AND role_id = :role_id
+ORDER BY captain DESC, user_id;
+
+-- name: IsTeamCaptain :one
+SELECT COUNT(*) > 0
+FROM team_members
+WHERE guild_id = :guild_id
+AND role_id = :role_id
+AND user_id = :user_id
+AND captain = 1;
+
+-- name: IsMatchCaptain :one
+SELECT COUNT(*) > 0
+FROM team_members tm
+JOIN matches m ON m.guild_id = tm.guild_id
+JOIN teams t ON t.match_id = m.match_id AND t.role_id = tm.role_id
+WHERE m.match_id = :match_id
+AND tm.user_id = :user_id
+AND tm.captain = 1;
+
+-- name: ListMatchCaptainRoles :many
+SELECT t.role_id
+FROM team_members tm
+JOIN matches m ON m.guild_id = tm.guild_id
+JOIN teams t ON t.match_id = m.match_id AND t.role_id = tm.role_id
+WHERE m.match_id = :match_id
+AND tm.user_id = :user_id
+AND tm.captain = 1
+ORDER BY t.role_id;
+
+-- name: ListMatchTeamCaptains :many
+SELECT
+    tm.role_id,
+    tm.user_id
+FROM team_members tm
+JOIN matches m ON m.guild_id = tm.guild_id
+JOIN teams t ON t.match_id = m.match_id AND t.role_id = tm.role_id
+WHERE m.match_id = :match_id
+AND tm.captain = 1
+ORDER BY tm.role_id, tm.user_id;
@@ -7,19 +7,23 @@ package sqlc
 
 import (
 	"context"
-	"strings"
 )
 
 const addAnnouncement = `-- name: AddAnnouncement :exec
 INSERT OR REPLACE INTO announcements (
     guild_id,
+    name,
     starts_at,
     ends_at,
     channel_id,
     interval,
     last_announced_at,
     custom_text_before,
-    custom_text_after
+    custom_text_after,
+    schedule,
+    location,
+    next_announced_at,
+    division
 ) VALUES (
     ?1,
     ?2,
@@ -28,12 +32,18 @@ INSERT OR REPLACE INTO announcements (
     ?5,
     ?6,
     ?7,
-    ?8
+    ?8,
+    ?9,
+    ?10,
+    ?11,
+    ?12,
+    ?13
 )
 `
 
 type AddAnnouncementParams struct {
 	GuildID          string `db:"guild_id"`
+	Name             string `db:"name"`
 	StartsAt         int64  `db:"starts_at"`
 	EndsAt           int64  `db:"ends_at"`
 	ChannelID        string `db:"channel_id"`
@@ -41,11 +51,16 @@ type AddAnnouncementParams struct {
 	LastAnnouncedAt  int64  `db:"last_announced_at"`
 	CustomTextBefore string `db:"custom_text_before"`
 	CustomTextAfter  string `db:"custom_text_after"`
+	Schedule         string `db:"schedule"`
+	Location         string `db:"location"`
+	NextAnnouncedAt  int64  `db:"next_announced_at"`
+	Division         string `db:"division"`
 }
 
 func (q *Queries) AddAnnouncement(ctx context.Context, arg AddAnnouncementParams) error {
 	_, err := q.exec(ctx, q.addAnnouncementStmt, addAnnouncement,
 		arg.GuildID,
+		arg.Name,
 		arg.StartsAt,
 		arg.EndsAt,
 		arg.ChannelID,
@@ -53,39 +68,31 @@ func (q *Queries) AddAnnouncement(ctx context.Context, arg AddAnnouncementParams
 		arg.LastAnnouncedAt,
 		arg.CustomTextBefore,
 		arg.CustomTextAfter,
+		arg.Schedule,
+		arg.Location,
+		arg.NextAnnouncedAt,
+		arg.Division,
 	)
 	return err
 }
 
 const continueAnnouncement = `-- name: ContinueAnnouncement :exec
 UPDATE announcements
-SET last_announced_at = (last_announced_at + interval)
-WHERE guild_id = ?1
+SET
+    last_announced_at = next_announced_at,
+    next_announced_at = ?1
+WHERE guild_id = ?2
+AND name = ?3
 `
 
-func (q *Queries) ContinueAnnouncement(ctx context.Context, guildID string) error {
-	_, err := q.exec(ctx, q.continueAnnouncementStmt, continueAnnouncement, guildID)
-	return err
+type ContinueAnnouncementParams struct {
+	NextAnnouncedAt int64  `db:"next_announced_at"`
+	GuildID         string `db:"guild_id"`
+	Name            string `db:"name"`
 }
 
-const continueAnnouncements = `-- name: ContinueAnnouncements :exec
-UPDATE announcements
-SET last_announced_at = last_announced_at + interval
-WHERE guild_id IN (/*SLICE:guild_id*/?)
-`
-
-func (q *Queries) ContinueAnnouncements(ctx context.Context, guildID []string) error {
-	query := continueAnnouncements
-	var queryParams []interface{}
-	if len(guildID) > 0 {
-		for _, v := range guildID {
-			queryParams = append(queryParams, v)
-		}
-		query = strings.Replace(query, "/*SLICE:guild_id*/?", strings.Repeat(",?", len(guildID))[1:], 1)
-	} else {
-		query = strings.Replace(query, "/*SLICE:guild_id*/?", "NULL", 1)
-	}
-	_, err := q.exec(ctx, nil, query, queryParams...)
+func (q *Queries) ContinueAnnouncement(ctx context.Context, arg ContinueAnnouncementParams) error {
+	_, err := q.exec(ctx, q.continueAnnouncementStmt, continueAnnouncement, arg.NextAnnouncedAt, arg.GuildID, arg.Name)
 	return err
 }
 
@@ -101,35 +108,66 @@ func (q *Queries) CountAnnouncements(ctx context.Context) (int64, error) {
 	return count, err
 }
 
+const countGuildAnnouncements = `-- name: CountGuildAnnouncements :one
+SELECT COUNT(*)
+FROM announcements
+WHERE guild_id = ?1
+`
+
+func (q *Queries) CountGuildAnnouncements(ctx context.Context, guildID string) (int64, error) {
+	row := q.queryRow(ctx, q.countGuildAnnouncementsStmt, countGuildAnnouncements, guildID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const deleteAnnouncement = `-- name: DeleteAnnouncement :exec
 DELETE FROM announcements
 WHERE guild_id = ?1
+AND name = ?2
 `
 
-func (q *Queries) DeleteAnnouncement(ctx context.Context, guildID string) error {
-	_, err := q.exec(ctx, q.deleteAnnouncementStmt, deleteAnnouncement, guildID)
+type DeleteAnnouncementParams struct {
+	GuildID string `db:"guild_id"`
+	Name    string `db:"name"`
+}
+
+func (q *Queries) DeleteAnnouncement(ctx context.Context, arg DeleteAnnouncementParams) error {
+	_, err := q.exec(ctx, q.deleteAnnouncementStmt, deleteAnnouncement, arg.GuildID, arg.Name)
 	return err
 }
 
 const getAnnouncement = `-- name: GetAnnouncement :one
 SELECT
     guild_id,
+    name,
     starts_at,
     ends_at,
     channel_id,
     interval,
     last_announced_at,
     custom_text_before,
-    custom_text_after
+    custom_text_after,
+    schedule,
+    location,
+    next_announced_at,
+    division
 FROM announcements
 WHERE guild_id = ?1
+AND name = ?2
 `
 
-func (q *Queries) GetAnnouncement(ctx context.Context, guildID string) (Announcement, error) {
-	row := q.queryRow(ctx, q.getAnnouncementStmt, getAnnouncement, guildID)
+type GetAnnouncementParams struct {
+	GuildID string `db:"guild_id"`
+	Name    string `db:"name"`
+}
+
+func (q *Queries) GetAnnouncement(ctx context.Context, arg GetAnnouncementParams) (Announcement, error) {
+	row := q.queryRow(ctx, q.getAnnouncementStmt, getAnnouncement, arg.GuildID, arg.Name)
 	var i Announcement
 	err := row.Scan(
 		&i.GuildID,
+		&i.Name,
 		&i.StartsAt,
 		&i.EndsAt,
 		&i.ChannelID,
@@ -137,28 +175,124 @@ func (q *Queries) GetAnnouncement(ctx context.Context, guildID string) (Announce
 		&i.LastAnnouncedAt,
 		&i.CustomTextBefore,
 		&i.CustomTextAfter,
+		&i.Schedule,
+		&i.Location,
+		&i.NextAnnouncedAt,
+		&i.Division,
 	)
 	return i, err
 }
 
+const listGuildAnnouncementNames = `-- name: ListGuildAnnouncementNames :many
+SELECT name
+FROM announcements
+WHERE guild_id = ?1
+ORDER BY name
+`
+
+func (q *Queries) ListGuildAnnouncementNames(ctx context.Context, guildID string) ([]string, error) {
+	rows, err := q.query(ctx, q.listGuildAnnouncementNamesStmt, listGuildAnnouncementNames, guildID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		items = append(items, name)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listGuildAnnouncements = `-- name: ListGuildAnnouncements :many
+SELECT
+    guild_id,
+    name,
+    starts_at,
+    ends_at,
+    channel_id,
+    interval,
+    last_announced_at,
+    custom_text_before,
+    custom_text_after,
+    schedule,
+    location,
+    next_announced_at,
+    division
+FROM announcements
+WHERE guild_id = ?1
+ORDER BY name
+`
+
+func (q *Queries) ListGuildAnnouncements(ctx context.Context, guildID string) ([]Announcement, error) {
+	rows, err := q.query(ctx, q.listGuildAnnouncementsStmt, listGuildAnnouncements, guildID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Announcement{}
+	for rows.Next() {
+		var i Announcement
+		if err := rows.Scan(
+			&i.GuildID,
+			&i.Name,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.ChannelID,
+			&i.Interval,
+			&i.LastAnnouncedAt,
+			&i.CustomTextBefore,
+			&i.CustomTextAfter,
+			&i.Schedule,
+			&i.Location,
+			&i.NextAnnouncedAt,
+			&i.Division,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listNowDueAnnouncements = `-- name: ListNowDueAnnouncements :many
 SELECT
     p.guild_id,
+    p.name,
     p.starts_at,
     p.ends_at,
     p.channel_id,
     p.interval,
     p.last_announced_at,
     p.custom_text_before,
-    p.custom_text_after
+    p.custom_text_after,
+    p.schedule,
+    p.location,
+    p.next_announced_at,
+    p.division
 FROM guild_config AS g
 JOIN announcements AS p
 ON g.guild_id = p.guild_id
 WHERE g.enabled = 1
 AND p.starts_at <= unixepoch('now')
-AND p.ends_at >= unixepoch('now')
-AND (p.last_announced_at + p.interval) <= unixepoch('now')
-ORDER BY (p.last_announced_at + p.interval) ASC
+AND p.ends_at >= p.next_announced_at
+AND p.next_announced_at <= unixepoch('now')
+ORDER BY p.next_announced_at ASC, p.guild_id, p.name
 `
 
 func (q *Queries) ListNowDueAnnouncements(ctx context.Context) ([]Announcement, error) {
@@ -172,6 +306,7 @@ func (q *Queries) ListNowDueAnnouncements(ctx context.Context) ([]Announcement,
 		var i Announcement
 		if err := rows.Scan(
 			&i.GuildID,
+			&i.Name,
 			&i.StartsAt,
 			&i.EndsAt,
 			&i.ChannelID,
@@ -179,6 +314,10 @@ func (q *Queries) ListNowDueAnnouncements(ctx context.Context) ([]Announcement,
 			&i.LastAnnouncedAt,
 			&i.CustomTextBefore,
 			&i.CustomTextAfter,
+			&i.Schedule,
+			&i.Location,
+			&i.NextAnnouncedAt,
+			&i.Division,
 		); err != nil {
 			return nil, err
 		}
@@ -195,16 +334,25 @@ func (q *Queries) ListNowDueAnnouncements(ctx context.Context) ([]Announcement,
 
 const nextAnnouncement = `-- name: NextAnnouncement :one
 SELECT
-    guild_id,
-    starts_at,
-    ends_at,
-    channel_id,
-    interval,
-    last_announced_at,
-    custom_text_before,
-    custom_text_after
-FROM announcements
-ORDER BY last_announced_at
+    p.guild_id,
+    p.name,
+    p.starts_at,
+    p.ends_at,
+    p.channel_id,
+    p.interval,
+    p.last_announced_at,
+    p.custom_text_before,
+    p.custom_text_after,
+    p.schedule,
+    p.location,
+    p.next_announced_at,
+    p.division
+FROM guild_config AS g
+JOIN announcements AS p
+ON g.guild_id = p.guild_id
+WHERE g.enabled = 1
+AND p.ends_at >= p.next_announced_at
+ORDER BY p.next_announced_at ASC
 LIMIT 1
 `
 
@@ -213,6 +361,7 @@ func (q *Queries) NextAnnouncement(ctx context.Context) (Announcement, error) {
 	var i Announcement
 	err := row.Scan(
 		&i.GuildID,
+		&i.Name,
 		&i.StartsAt,
 		&i.EndsAt,
 		&i.ChannelID,
@@ -220,6 +369,10 @@ func (q *Queries) NextAnnouncement(ctx context.Context) (Announcement, error) {
 		&i.LastAnnouncedAt,
 		&i.CustomTextBefore,
 		&i.CustomTextAfter,
+		&i.Schedule,
+		&i.Location,
+		&i.NextAnnouncedAt,
+		&i.Division,
 	)
 	return i, err
 }
@@ -0,0 +1,339 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: brackets.sql
+
+package sqlc
+
+import (
+	"context"
+)
+
+const addBracket = `-- name: AddBracket :one
+INSERT INTO brackets (
+    guild_id,
+    season_id,
+    name,
+    kind,
+    slots,
+    location,
+    moderator_ids,
+    channel_id,
+    created_at,
+    created_by
+) VALUES (
+    ?1,
+    ?2,
+    ?3,
+    ?4,
+    ?5,
+    ?6,
+    ?7,
+    ?8,
+    ?9,
+    ?10
+) RETURNING bracket_id
+`
+
+type AddBracketParams struct {
+	GuildID      string `db:"guild_id"`
+	SeasonID     int64  `db:"season_id"`
+	Name         string `db:"name"`
+	Kind         string `db:"kind"`
+	Slots        string `db:"slots"`
+	Location     string `db:"location"`
+	ModeratorIds string `db:"moderator_ids"`
+	ChannelID    string `db:"channel_id"`
+	CreatedAt    int64  `db:"created_at"`
+	CreatedBy    string `db:"created_by"`
+}
+
+func (q *Queries) AddBracket(ctx context.Context, arg AddBracketParams) (int64, error) {
+	row := q.queryRow(ctx, q.addBracketStmt, addBracket,
+		arg.GuildID,
+		arg.SeasonID,
+		arg.Name,
+		arg.Kind,
+		arg.Slots,
+		arg.Location,
+		arg.ModeratorIds,
+		arg.ChannelID,
+		arg.CreatedAt,
+		arg.CreatedBy,
+	)
+	var bracket_id int64
+	err := row.Scan(&bracket_id)
+	return bracket_id, err
+}
+
+const addBracketSlot = `-- name: AddBracketSlot :exec
+INSERT OR REPLACE INTO bracket_slots (
+    bracket_id,
+    slot_index,
+    section,
+    round,
+    position,
+    team_1,
+    team_2,
+    bye_1,
+    bye_2,
+    winner_to,
+    winner_side,
+    loser_to,
+    loser_side,
+    done,
+    winner,
+    fixture_id
+) VALUES (
+    ?1,
+    ?2,
+    ?3,
+    ?4,
+    ?5,
+    ?6,
+    ?7,
+    ?8,
+    ?9,
+    ?10,
+    ?11,
+    ?12,
+    ?13,
+    ?14,
+    ?15,
+    ?16
+)
+`
+
+type AddBracketSlotParams struct {
+	BracketID  int64  `db:"bracket_id"`
+	SlotIndex  int64  `db:"slot_index"`
+	Section    string `db:"section"`
+	Round      int64  `db:"round"`
+	Position   int64  `db:"position"`
+	Team1      string `db:"team_1"`
+	Team2      string `db:"team_2"`
+	Bye1       int64  `db:"bye_1"`
+	Bye2       int64  `db:"bye_2"`
+	WinnerTo   int64  `db:"winner_to"`
+	WinnerSide int64  `db:"winner_side"`
+	LoserTo    int64  `db:"loser_to"`
+	LoserSide  int64  `db:"loser_side"`
+	Done       int64  `db:"done"`
+	Winner     string `db:"winner"`
+	FixtureID  int64  `db:"fixture_id"`
+}
+
+func (q *Queries) AddBracketSlot(ctx context.Context, arg AddBracketSlotParams) error {
+	_, err := q.exec(ctx, q.addBracketSlotStmt, addBracketSlot,
+		arg.BracketID,
+		arg.SlotIndex,
+		arg.Section,
+		arg.Round,
+		arg.Position,
+		arg.Team1,
+		arg.Team2,
+		arg.Bye1,
+		arg.Bye2,
+		arg.WinnerTo,
+		arg.WinnerSide,
+		arg.LoserTo,
+		arg.LoserSide,
+		arg.Done,
+		arg.Winner,
+		arg.FixtureID,
+	)
+	return err
+}
+
+const getBracketByName = `-- name: GetBracketByName :one
+SELECT
+    bracket_id,
+    guild_id,
+    season_id,
+    name,
+    kind,
+    slots,
+    location,
+    moderator_ids,
+    moderator_turn,
+    channel_id,
+    created_at,
+    created_by
+FROM brackets
+WHERE guild_id = ?1
+AND name = ?2
+`
+
+type GetBracketByNameParams struct {
+	GuildID string `db:"guild_id"`
+	Name    string `db:"name"`
+}
+
+func (q *Queries) GetBracketByName(ctx context.Context, arg GetBracketByNameParams) (Bracket, error) {
+	row := q.queryRow(ctx, q.getBracketByNameStmt, getBracketByName, arg.GuildID, arg.Name)
+	var i Bracket
+	err := row.Scan(
+		&i.BracketID,
+		&i.GuildID,
+		&i.SeasonID,
+		&i.Name,
+		&i.Kind,
+		&i.Slots,
+		&i.Location,
+		&i.ModeratorIds,
+		&i.ModeratorTurn,
+		&i.ChannelID,
+		&i.CreatedAt,
+		&i.CreatedBy,
+	)
+	return i, err
+}
+
+const getBracketOfFixture = `-- name: GetBracketOfFixture :one
+SELECT
+    b.bracket_id,
+    b.guild_id,
+    b.season_id,
+    b.name,
+    b.kind,
+    b.slots,
+    b.location,
+    b.moderator_ids,
+    b.moderator_turn,
+    b.channel_id,
+    b.created_at,
+    b.created_by
+FROM brackets AS b
+JOIN bracket_slots AS s
+ON b.bracket_id = s.bracket_id
+WHERE s.fixture_id = ?1
+`
+
+func (q *Queries) GetBracketOfFixture(ctx context.Context, fixtureID int64) (Bracket, error) {
+	row := q.queryRow(ctx, q.getBracketOfFixtureStmt, getBracketOfFixture, fixtureID)
+	var i Bracket
+	err := row.Scan(
+		&i.BracketID,
+		&i.GuildID,
+		&i.SeasonID,
+		&i.Name,
+		&i.Kind,
+		&i.Slots,
+		&i.Location,
+		&i.ModeratorIds,
+		&i.ModeratorTurn,
+		&i.ChannelID,
+		&i.CreatedAt,
+		&i.CreatedBy,
+	)
+	return i, err
+}
+
+const listBracketSlots = `-- name: ListBracketSlots :many
+SELECT
+    bracket_id,
+    slot_index,
+    section,
+    round,
+    position,
+    team_1,
+    team_2,
+    bye_1,
+    bye_2,
+    winner_to,
+    winner_side,
+    loser_to,
+    loser_side,
+    done,
+    winner,
+    fixture_id
+FROM bracket_slots
+WHERE bracket_id = ?1
+ORDER BY slot_index
+`
+
+func (q *Queries) ListBracketSlots(ctx context.Context, bracketID int64) ([]BracketSlot, error) {
+	rows, err := q.query(ctx, q.listBracketSlotsStmt, listBracketSlots, bracketID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []BracketSlot{}
+	for rows.Next() {
+		var i BracketSlot
+		if err := rows.Scan(
+			&i.BracketID,
+			&i.SlotIndex,
+			&i.Section,
+			&i.Round,
+			&i.Position,
+			&i.Team1,
+			&i.Team2,
+			&i.Bye1,
+			&i.Bye2,
+			&i.WinnerTo,
+			&i.WinnerSide,
+			&i.LoserTo,
+			&i.LoserSide,
+			&i.Done,
+			&i.Winner,
+			&i.FixtureID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listGuildBracketNames = `-- name: ListGuildBracketNames :many
+SELECT name
+FROM brackets
+WHERE guild_id = ?1
+ORDER BY name
+`
+
+func (q *Queries) ListGuildBracketNames(ctx context.Context, guildID string) ([]string, error) {
+	rows, err := q.query(ctx, q.listGuildBracketNamesStmt, listGuildBracketNames, guildID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		items = append(items, name)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateBracketModeratorTurn = `-- name: UpdateBracketModeratorTurn :exec
+UPDATE brackets
+SET moderator_turn = ?1
+WHERE bracket_id = ?2
+`
+
+type UpdateBracketModeratorTurnParams struct {
+	ModeratorTurn int64 `db:"moderator_turn"`
+	BracketID     int64 `db:"bracket_id"`
+}
+
+func (q *Queries) UpdateBracketModeratorTurn(ctx context.Context, arg UpdateBracketModeratorTurnParams) error {
+	_, err := q.exec(ctx, q.updateBracketModeratorTurnStmt, updateBracketModeratorTurn, arg.ModeratorTurn, arg.BracketID)
+	return err
+}
@@ -27,6 +27,24 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.addAnnouncementStmt, err = db.PrepareContext(ctx, addAnnouncement); err != nil {
 		return nil, fmt.Errorf("error preparing query AddAnnouncement: %w", err)
 	}
+	if q.addBracketStmt, err = db.PrepareContext(ctx, addBracket); err != nil {
+		return nil, fmt.Errorf("error preparing query AddBracket: %w", err)
+	}
+	if q.addBracketSlotStmt, err = db.PrepareContext(ctx, addBracketSlot); err != nil {
+		return nil, fmt.Errorf("error preparing query AddBracketSlot: %w", err)
+	}
+	if q.addDivisionStmt, err = db.PrepareContext(ctx, addDivision); err != nil {
+		return nil, fmt.Errorf("error preparing query AddDivision: %w", err)
+	}
+	if q.addDivisionTeamStmt, err = db.PrepareContext(ctx, addDivisionTeam); err != nil {
+		return nil, fmt.Errorf("error preparing query AddDivisionTeam: %w", err)
+	}
+	if q.addFixtureStmt, err = db.PrepareContext(ctx, addFixture); err != nil {
+		return nil, fmt.Errorf("error preparing query AddFixture: %w", err)
+	}
+	if q.addFixtureTeamStmt, err = db.PrepareContext(ctx, addFixtureTeam); err != nil {
+		return nil, fmt.Errorf("error preparing query AddFixtureTeam: %w", err)
+	}
 	if q.addGuildConfigStmt, err = db.PrepareContext(ctx, addGuildConfig); err != nil {
 		return nil, fmt.Errorf("error preparing query AddGuildConfig: %w", err)
 	}
@@ -54,24 +72,81 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.addMatchTeamStmt, err = db.PrepareContext(ctx, addMatchTeam); err != nil {
 		return nil, fmt.Errorf("error preparing query AddMatchTeam: %w", err)
 	}
-	if q.addMatchTeamResultsStmt, err = db.PrepareContext(ctx, addMatchTeamResults); err != nil {
-		return nil, fmt.Errorf("error preparing query AddMatchTeamResults: %w", err)
-	}
 	if q.addNotificationStmt, err = db.PrepareContext(ctx, addNotification); err != nil {
 		return nil, fmt.Errorf("error preparing query AddNotification: %w", err)
 	}
+	if q.addOverflowCategoryStmt, err = db.PrepareContext(ctx, addOverflowCategory); err != nil {
+		return nil, fmt.Errorf("error preparing query AddOverflowCategory: %w", err)
+	}
+	if q.addParticipantStmt, err = db.PrepareContext(ctx, addParticipant); err != nil {
+		return nil, fmt.Errorf("error preparing query AddParticipant: %w", err)
+	}
 	if q.addParticipationRequirementsStmt, err = db.PrepareContext(ctx, addParticipationRequirements); err != nil {
 		return nil, fmt.Errorf("error preparing query AddParticipationRequirements: %w", err)
 	}
+	if q.addRatingHistoryStmt, err = db.PrepareContext(ctx, addRatingHistory); err != nil {
+		return nil, fmt.Errorf("error preparing query AddRatingHistory: %w", err)
+	}
+	if q.addRegisteredTeamStmt, err = db.PrepareContext(ctx, addRegisteredTeam); err != nil {
+		return nil, fmt.Errorf("error preparing query AddRegisteredTeam: %w", err)
+	}
+	if q.addRescheduleProposalStmt, err = db.PrepareContext(ctx, addRescheduleProposal); err != nil {
+		return nil, fmt.Errorf("error preparing query AddRescheduleProposal: %w", err)
+	}
+	if q.addResultStmt, err = db.PrepareContext(ctx, addResult); err != nil {
+		return nil, fmt.Errorf("error preparing query AddResult: %w", err)
+	}
+	if q.addResultConfirmationStmt, err = db.PrepareContext(ctx, addResultConfirmation); err != nil {
+		return nil, fmt.Errorf("error preparing query AddResultConfirmation: %w", err)
+	}
+	if q.addScheduleBoardStmt, err = db.PrepareContext(ctx, addScheduleBoard); err != nil {
+		return nil, fmt.Errorf("error preparing query AddScheduleBoard: %w", err)
+	}
+	if q.addScheduleBoardMessageStmt, err = db.PrepareContext(ctx, addScheduleBoardMessage); err != nil {
+		return nil, fmt.Errorf("error preparing query AddScheduleBoardMessage: %w", err)
+	}
+	if q.addSeasonStmt, err = db.PrepareContext(ctx, addSeason); err != nil {
+		return nil, fmt.Errorf("error preparing query AddSeason: %w", err)
+	}
+	if q.addStandingsMessageStmt, err = db.PrepareContext(ctx, addStandingsMessage); err != nil {
+		return nil, fmt.Errorf("error preparing query AddStandingsMessage: %w", err)
+	}
+	if q.addSwissByeStmt, err = db.PrepareContext(ctx, addSwissBye); err != nil {
+		return nil, fmt.Errorf("error preparing query AddSwissBye: %w", err)
+	}
+	if q.addSwissTeamStmt, err = db.PrepareContext(ctx, addSwissTeam); err != nil {
+		return nil, fmt.Errorf("error preparing query AddSwissTeam: %w", err)
+	}
+	if q.addSwissTournamentStmt, err = db.PrepareContext(ctx, addSwissTournament); err != nil {
+		return nil, fmt.Errorf("error preparing query AddSwissTournament: %w", err)
+	}
+	if q.addTeamCaptainStmt, err = db.PrepareContext(ctx, addTeamCaptain); err != nil {
+		return nil, fmt.Errorf("error preparing query AddTeamCaptain: %w", err)
+	}
+	if q.addTeamMemberStmt, err = db.PrepareContext(ctx, addTeamMember); err != nil {
+		return nil, fmt.Errorf("error preparing query AddTeamMember: %w", err)
+	}
+	if q.addTeamRatingStmt, err = db.PrepareContext(ctx, addTeamRating); err != nil {
+		return nil, fmt.Errorf("error preparing query AddTeamRating: %w", err)
+	}
+	if q.addTeamResultStmt, err = db.PrepareContext(ctx, addTeamResult); err != nil {
+		return nil, fmt.Errorf("error preparing query AddTeamResult: %w", err)
+	}
+	if q.archiveMatchListStmt, err = db.PrepareContext(ctx, archiveMatchList); err != nil {
+		return nil, fmt.Errorf("error preparing query ArchiveMatchList: %w", err)
+	}
+	if q.cancelMatchStmt, err = db.PrepareContext(ctx, cancelMatch); err != nil {
+		return nil, fmt.Errorf("error preparing query CancelMatch: %w", err)
+	}
 	if q.closeParticipationEntryStmt, err = db.PrepareContext(ctx, closeParticipationEntry); err != nil {
 		return nil, fmt.Errorf("error preparing query CloseParticipationEntry: %w", err)
 	}
+	if q.confirmSeasonStmt, err = db.PrepareContext(ctx, confirmSeason); err != nil {
+		return nil, fmt.Errorf("error preparing query ConfirmSeason: %w", err)
+	}
 	if q.continueAnnouncementStmt, err = db.PrepareContext(ctx, continueAnnouncement); err != nil {
 		return nil, fmt.Errorf("error preparing query ContinueAnnouncement: %w", err)
 	}
-	if q.continueAnnouncementsStmt, err = db.PrepareContext(ctx, continueAnnouncements); err != nil {
-		return nil, fmt.Errorf("error preparing query ContinueAnnouncements: %w", err)
-	}
 	if q.countAllMatchesStmt, err = db.PrepareContext(ctx, countAllMatches); err != nil {
 		return nil, fmt.Errorf("error preparing query CountAllMatches: %w", err)
 	}
@@ -84,18 +159,39 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.countDisabledGuildsStmt, err = db.PrepareContext(ctx, countDisabledGuilds); err != nil {
 		return nil, fmt.Errorf("error preparing query CountDisabledGuilds: %w", err)
 	}
+	if q.countDivisionMatchesStmt, err = db.PrepareContext(ctx, countDivisionMatches); err != nil {
+		return nil, fmt.Errorf("error preparing query CountDivisionMatches: %w", err)
+	}
 	if q.countEnabledEventCreationStmt, err = db.PrepareContext(ctx, countEnabledEventCreation); err != nil {
 		return nil, fmt.Errorf("error preparing query CountEnabledEventCreation: %w", err)
 	}
 	if q.countEnabledGuildsStmt, err = db.PrepareContext(ctx, countEnabledGuilds); err != nil {
 		return nil, fmt.Errorf("error preparing query CountEnabledGuilds: %w", err)
 	}
+	if q.countGuildAnnouncementsStmt, err = db.PrepareContext(ctx, countGuildAnnouncements); err != nil {
+		return nil, fmt.Errorf("error preparing query CountGuildAnnouncements: %w", err)
+	}
+	if q.countGuildDivisionsStmt, err = db.PrepareContext(ctx, countGuildDivisions); err != nil {
+		return nil, fmt.Errorf("error preparing query CountGuildDivisions: %w", err)
+	}
 	if q.countMatchesStmt, err = db.PrepareContext(ctx, countMatches); err != nil {
 		return nil, fmt.Errorf("error preparing query CountMatches: %w", err)
 	}
 	if q.countNotificationsStmt, err = db.PrepareContext(ctx, countNotifications); err != nil {
 		return nil, fmt.Errorf("error preparing query CountNotifications: %w", err)
 	}
+	if q.countResultConfirmationsStmt, err = db.PrepareContext(ctx, countResultConfirmations); err != nil {
+		return nil, fmt.Errorf("error preparing query CountResultConfirmations: %w", err)
+	}
+	if q.countSeasonFixturesStmt, err = db.PrepareContext(ctx, countSeasonFixtures); err != nil {
+		return nil, fmt.Errorf("error preparing query CountSeasonFixtures: %w", err)
+	}
+	if q.countTeamStartersStmt, err = db.PrepareContext(ctx, countTeamStarters); err != nil {
+		return nil, fmt.Errorf("error preparing query CountTeamStarters: %w", err)
+	}
+	if q.countTeamSubstitutesStmt, err = db.PrepareContext(ctx, countTeamSubstitutes); err != nil {
+		return nil, fmt.Errorf("error preparing query CountTeamSubstitutes: %w", err)
+	}
 	if q.decreaseMatchTeamConfirmedParticipantsStmt, err = db.PrepareContext(ctx, decreaseMatchTeamConfirmedParticipants); err != nil {
 		return nil, fmt.Errorf("error preparing query DecreaseMatchTeamConfirmedParticipants: %w", err)
 	}
@@ -111,17 +207,35 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.deleteAnnouncementStmt, err = db.PrepareContext(ctx, deleteAnnouncement); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteAnnouncement: %w", err)
 	}
+	if q.deleteDivisionStmt, err = db.PrepareContext(ctx, deleteDivision); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteDivision: %w", err)
+	}
+	if q.deleteDivisionOverflowCategoriesStmt, err = db.PrepareContext(ctx, deleteDivisionOverflowCategories); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteDivisionOverflowCategories: %w", err)
+	}
+	if q.deleteDivisionTeamStmt, err = db.PrepareContext(ctx, deleteDivisionTeam); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteDivisionTeam: %w", err)
+	}
+	if q.deleteFixtureStmt, err = db.PrepareContext(ctx, deleteFixture); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteFixture: %w", err)
+	}
 	if q.deleteGuildConfigStmt, err = db.PrepareContext(ctx, deleteGuildConfig); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteGuildConfig: %w", err)
 	}
 	if q.deleteGuildMatchesStmt, err = db.PrepareContext(ctx, deleteGuildMatches); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteGuildMatches: %w", err)
 	}
+	if q.deleteGuildRatingHistoryStmt, err = db.PrepareContext(ctx, deleteGuildRatingHistory); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteGuildRatingHistory: %w", err)
+	}
+	if q.deleteGuildTeamRatingsStmt, err = db.PrepareContext(ctx, deleteGuildTeamRatings); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteGuildTeamRatings: %w", err)
+	}
 	if q.deleteMatchStmt, err = db.PrepareContext(ctx, deleteMatch); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteMatch: %w", err)
 	}
-	if q.deleteMatchListStmt, err = db.PrepareContext(ctx, deleteMatchList); err != nil {
-		return nil, fmt.Errorf("error preparing query DeleteMatchList: %w", err)
+	if q.deleteMatchGeneratedNotificationsStmt, err = db.PrepareContext(ctx, deleteMatchGeneratedNotifications); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteMatchGeneratedNotifications: %w", err)
 	}
 	if q.deleteMatchModeratorStmt, err = db.PrepareContext(ctx, deleteMatchModerator); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteMatchModerator: %w", err)
@@ -141,24 +255,81 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.deleteMatchTeamStmt, err = db.PrepareContext(ctx, deleteMatchTeam); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteMatchTeam: %w", err)
 	}
+	if q.deleteMessageTemplateStmt, err = db.PrepareContext(ctx, deleteMessageTemplate); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteMessageTemplate: %w", err)
+	}
 	if q.deleteNotificationStmt, err = db.PrepareContext(ctx, deleteNotification); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteNotification: %w", err)
 	}
+	if q.deleteOverflowCategoryStmt, err = db.PrepareContext(ctx, deleteOverflowCategory); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteOverflowCategory: %w", err)
+	}
 	if q.deleteParticipationRequirementsStmt, err = db.PrepareContext(ctx, deleteParticipationRequirements); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteParticipationRequirements: %w", err)
 	}
+	if q.deleteRegisteredTeamStmt, err = db.PrepareContext(ctx, deleteRegisteredTeam); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteRegisteredTeam: %w", err)
+	}
+	if q.deleteRescheduleProposalStmt, err = db.PrepareContext(ctx, deleteRescheduleProposal); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteRescheduleProposal: %w", err)
+	}
+	if q.deleteResultConfirmationsStmt, err = db.PrepareContext(ctx, deleteResultConfirmations); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteResultConfirmations: %w", err)
+	}
+	if q.deleteScheduleBoardStmt, err = db.PrepareContext(ctx, deleteScheduleBoard); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteScheduleBoard: %w", err)
+	}
+	if q.deleteScheduleBoardMessagesFromStmt, err = db.PrepareContext(ctx, deleteScheduleBoardMessagesFrom); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteScheduleBoardMessagesFrom: %w", err)
+	}
+	if q.deleteSeasonStmt, err = db.PrepareContext(ctx, deleteSeason); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteSeason: %w", err)
+	}
+	if q.deleteSeasonDraftsStmt, err = db.PrepareContext(ctx, deleteSeasonDrafts); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteSeasonDrafts: %w", err)
+	}
+	if q.deleteStandingsMessageStmt, err = db.PrepareContext(ctx, deleteStandingsMessage); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteStandingsMessage: %w", err)
+	}
 	if q.disableGuildStmt, err = db.PrepareContext(ctx, disableGuild); err != nil {
 		return nil, fmt.Errorf("error preparing query DisableGuild: %w", err)
 	}
 	if q.getAnnouncementStmt, err = db.PrepareContext(ctx, getAnnouncement); err != nil {
 		return nil, fmt.Errorf("error preparing query GetAnnouncement: %w", err)
 	}
+	if q.getBracketByNameStmt, err = db.PrepareContext(ctx, getBracketByName); err != nil {
+		return nil, fmt.Errorf("error preparing query GetBracketByName: %w", err)
+	}
+	if q.getBracketOfFixtureStmt, err = db.PrepareContext(ctx, getBracketOfFixture); err != nil {
+		return nil, fmt.Errorf("error preparing query GetBracketOfFixture: %w", err)
+	}
+	if q.getDivisionStmt, err = db.PrepareContext(ctx, getDivision); err != nil {
+		return nil, fmt.Errorf("error preparing query GetDivision: %w", err)
+	}
+	if q.getDivisionByCategoryStmt, err = db.PrepareContext(ctx, getDivisionByCategory); err != nil {
+		return nil, fmt.Errorf("error preparing query GetDivisionByCategory: %w", err)
+	}
+	if q.getFirstTeamSubstituteStmt, err = db.PrepareContext(ctx, getFirstTeamSubstitute); err != nil {
+		return nil, fmt.Errorf("error preparing query GetFirstTeamSubstitute: %w", err)
+	}
+	if q.getFixtureStmt, err = db.PrepareContext(ctx, getFixture); err != nil {
+		return nil, fmt.Errorf("error preparing query GetFixture: %w", err)
+	}
+	if q.getFixtureByMatchStmt, err = db.PrepareContext(ctx, getFixtureByMatch); err != nil {
+		return nil, fmt.Errorf("error preparing query GetFixtureByMatch: %w", err)
+	}
 	if q.getGuildConfigStmt, err = db.PrepareContext(ctx, getGuildConfig); err != nil {
 		return nil, fmt.Errorf("error preparing query GetGuildConfig: %w", err)
 	}
 	if q.getGuildConfigByCategoryStmt, err = db.PrepareContext(ctx, getGuildConfigByCategory); err != nil {
 		return nil, fmt.Errorf("error preparing query GetGuildConfigByCategory: %w", err)
 	}
+	if q.getGuildLanguageStmt, err = db.PrepareContext(ctx, getGuildLanguage); err != nil {
+		return nil, fmt.Errorf("error preparing query GetGuildLanguage: %w", err)
+	}
+	if q.getGuildOutputModeStmt, err = db.PrepareContext(ctx, getGuildOutputMode); err != nil {
+		return nil, fmt.Errorf("error preparing query GetGuildOutputMode: %w", err)
+	}
 	if q.getGuildRoleAccessStmt, err = db.PrepareContext(ctx, getGuildRoleAccess); err != nil {
 		return nil, fmt.Errorf("error preparing query GetGuildRoleAccess: %w", err)
 	}
@@ -168,18 +339,72 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getMatchStmt, err = db.PrepareContext(ctx, getMatch); err != nil {
 		return nil, fmt.Errorf("error preparing query GetMatch: %w", err)
 	}
+	if q.getMatchByChannelStmt, err = db.PrepareContext(ctx, getMatchByChannel); err != nil {
+		return nil, fmt.Errorf("error preparing query GetMatchByChannel: %w", err)
+	}
+	if q.getMatchByNumberStmt, err = db.PrepareContext(ctx, getMatchByNumber); err != nil {
+		return nil, fmt.Errorf("error preparing query GetMatchByNumber: %w", err)
+	}
 	if q.getMatchTeamStmt, err = db.PrepareContext(ctx, getMatchTeam); err != nil {
 		return nil, fmt.Errorf("error preparing query GetMatchTeam: %w", err)
 	}
 	if q.getMatchTeamByRolesStmt, err = db.PrepareContext(ctx, getMatchTeamByRoles); err != nil {
 		return nil, fmt.Errorf("error preparing query GetMatchTeamByRoles: %w", err)
 	}
+	if q.getMessageTemplateStmt, err = db.PrepareContext(ctx, getMessageTemplate); err != nil {
+		return nil, fmt.Errorf("error preparing query GetMessageTemplate: %w", err)
+	}
 	if q.getNotificationByOffsetStmt, err = db.PrepareContext(ctx, getNotificationByOffset); err != nil {
 		return nil, fmt.Errorf("error preparing query GetNotificationByOffset: %w", err)
 	}
+	if q.getOverflowCategoryStmt, err = db.PrepareContext(ctx, getOverflowCategory); err != nil {
+		return nil, fmt.Errorf("error preparing query GetOverflowCategory: %w", err)
+	}
+	if q.getParticipantStmt, err = db.PrepareContext(ctx, getParticipant); err != nil {
+		return nil, fmt.Errorf("error preparing query GetParticipant: %w", err)
+	}
 	if q.getParticipationRequirementsStmt, err = db.PrepareContext(ctx, getParticipationRequirements); err != nil {
 		return nil, fmt.Errorf("error preparing query GetParticipationRequirements: %w", err)
 	}
+	if q.getParticipationRequirementsByChannelStmt, err = db.PrepareContext(ctx, getParticipationRequirementsByChannel); err != nil {
+		return nil, fmt.Errorf("error preparing query GetParticipationRequirementsByChannel: %w", err)
+	}
+	if q.getRegisteredTeamStmt, err = db.PrepareContext(ctx, getRegisteredTeam); err != nil {
+		return nil, fmt.Errorf("error preparing query GetRegisteredTeam: %w", err)
+	}
+	if q.getRegisteredTeamByNameStmt, err = db.PrepareContext(ctx, getRegisteredTeamByName); err != nil {
+		return nil, fmt.Errorf("error preparing query GetRegisteredTeamByName: %w", err)
+	}
+	if q.getRescheduleProposalStmt, err = db.PrepareContext(ctx, getRescheduleProposal); err != nil {
+		return nil, fmt.Errorf("error preparing query GetRescheduleProposal: %w", err)
+	}
+	if q.getRescheduleProposalByMessageStmt, err = db.PrepareContext(ctx, getRescheduleProposalByMessage); err != nil {
+		return nil, fmt.Errorf("error preparing query GetRescheduleProposalByMessage: %w", err)
+	}
+	if q.getResultStmt, err = db.PrepareContext(ctx, getResult); err != nil {
+		return nil, fmt.Errorf("error preparing query GetResult: %w", err)
+	}
+	if q.getResultByMessageStmt, err = db.PrepareContext(ctx, getResultByMessage); err != nil {
+		return nil, fmt.Errorf("error preparing query GetResultByMessage: %w", err)
+	}
+	if q.getScheduleBoardStmt, err = db.PrepareContext(ctx, getScheduleBoard); err != nil {
+		return nil, fmt.Errorf("error preparing query GetScheduleBoard: %w", err)
+	}
+	if q.getSeasonDraftStmt, err = db.PrepareContext(ctx, getSeasonDraft); err != nil {
+		return nil, fmt.Errorf("error preparing query GetSeasonDraft: %w", err)
+	}
+	if q.getStandingsMessageStmt, err = db.PrepareContext(ctx, getStandingsMessage); err != nil {
+		return nil, fmt.Errorf("error preparing query GetStandingsMessage: %w", err)
+	}
+	if q.getSwissTournamentByNameStmt, err = db.PrepareContext(ctx, getSwissTournamentByName); err != nil {
+		return nil, fmt.Errorf("error preparing query GetSwissTournamentByName: %w", err)
+	}
+	if q.getTeamDivisionStmt, err = db.PrepareContext(ctx, getTeamDivision); err != nil {
+		return nil, fmt.Errorf("error preparing query GetTeamDivision: %w", err)
+	}
+	if q.getTeamRatingStmt, err = db.PrepareContext(ctx, getTeamRating); err != nil {
+		return nil, fmt.Errorf("error preparing query GetTeamRating: %w", err)
+	}
 	if q.hasRoleAccessStmt, err = db.PrepareContext(ctx, hasRoleAccess); err != nil {
 		return nil, fmt.Errorf("error preparing query HasRoleAccess: %w", err)
 	}
@@ -192,6 +417,51 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.isGuildEnabledStmt, err = db.PrepareContext(ctx, isGuildEnabled); err != nil {
 		return nil, fmt.Errorf("error preparing query IsGuildEnabled: %w", err)
 	}
+	if q.isMatchCaptainStmt, err = db.PrepareContext(ctx, isMatchCaptain); err != nil {
+		return nil, fmt.Errorf("error preparing query IsMatchCaptain: %w", err)
+	}
+	if q.isMatchModeratorStmt, err = db.PrepareContext(ctx, isMatchModerator); err != nil {
+		return nil, fmt.Errorf("error preparing query IsMatchModerator: %w", err)
+	}
+	if q.isTeamCaptainStmt, err = db.PrepareContext(ctx, isTeamCaptain); err != nil {
+		return nil, fmt.Errorf("error preparing query IsTeamCaptain: %w", err)
+	}
+	if q.listBracketSlotsStmt, err = db.PrepareContext(ctx, listBracketSlots); err != nil {
+		return nil, fmt.Errorf("error preparing query ListBracketSlots: %w", err)
+	}
+	if q.listConfirmedSeasonsWithoutMatchesStmt, err = db.PrepareContext(ctx, listConfirmedSeasonsWithoutMatches); err != nil {
+		return nil, fmt.Errorf("error preparing query ListConfirmedSeasonsWithoutMatches: %w", err)
+	}
+	if q.listDivisionFinalTeamResultsStmt, err = db.PrepareContext(ctx, listDivisionFinalTeamResults); err != nil {
+		return nil, fmt.Errorf("error preparing query ListDivisionFinalTeamResults: %w", err)
+	}
+	if q.listDivisionTeamRoleIDsStmt, err = db.PrepareContext(ctx, listDivisionTeamRoleIDs); err != nil {
+		return nil, fmt.Errorf("error preparing query ListDivisionTeamRoleIDs: %w", err)
+	}
+	if q.listEnabledScheduleBoardsStmt, err = db.PrepareContext(ctx, listEnabledScheduleBoards); err != nil {
+		return nil, fmt.Errorf("error preparing query ListEnabledScheduleBoards: %w", err)
+	}
+	if q.listFixtureTeamsStmt, err = db.PrepareContext(ctx, listFixtureTeams); err != nil {
+		return nil, fmt.Errorf("error preparing query ListFixtureTeams: %w", err)
+	}
+	if q.listGuildAnnouncementNamesStmt, err = db.PrepareContext(ctx, listGuildAnnouncementNames); err != nil {
+		return nil, fmt.Errorf("error preparing query ListGuildAnnouncementNames: %w", err)
+	}
+	if q.listGuildAnnouncementsStmt, err = db.PrepareContext(ctx, listGuildAnnouncements); err != nil {
+		return nil, fmt.Errorf("error preparing query ListGuildAnnouncements: %w", err)
+	}
+	if q.listGuildBracketNamesStmt, err = db.PrepareContext(ctx, listGuildBracketNames); err != nil {
+		return nil, fmt.Errorf("error preparing query ListGuildBracketNames: %w", err)
+	}
+	if q.listGuildDivisionNamesStmt, err = db.PrepareContext(ctx, listGuildDivisionNames); err != nil {
+		return nil, fmt.Errorf("error preparing query ListGuildDivisionNames: %w", err)
+	}
+	if q.listGuildDivisionsStmt, err = db.PrepareContext(ctx, listGuildDivisions); err != nil {
+		return nil, fmt.Errorf("error preparing query ListGuildDivisions: %w", err)
+	}
+	if q.listGuildFinalTeamResultsStmt, err = db.PrepareContext(ctx, listGuildFinalTeamResults); err != nil {
+		return nil, fmt.Errorf("error preparing query ListGuildFinalTeamResults: %w", err)
+	}
 	if q.listGuildMatchesStmt, err = db.PrepareContext(ctx, listGuildMatches); err != nil {
 		return nil, fmt.Errorf("error preparing query ListGuildMatches: %w", err)
 	}
@@ -201,18 +471,33 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.listGuildRoleAccessStmt, err = db.PrepareContext(ctx, listGuildRoleAccess); err != nil {
 		return nil, fmt.Errorf("error preparing query ListGuildRoleAccess: %w", err)
 	}
+	if q.listGuildSwissTournamentNamesStmt, err = db.PrepareContext(ctx, listGuildSwissTournamentNames); err != nil {
+		return nil, fmt.Errorf("error preparing query ListGuildSwissTournamentNames: %w", err)
+	}
+	if q.listGuildTeamRatingsStmt, err = db.PrepareContext(ctx, listGuildTeamRatings); err != nil {
+		return nil, fmt.Errorf("error preparing query ListGuildTeamRatings: %w", err)
+	}
 	if q.listGuildUserAccessStmt, err = db.PrepareContext(ctx, listGuildUserAccess); err != nil {
 		return nil, fmt.Errorf("error preparing query ListGuildUserAccess: %w", err)
 	}
+	if q.listMatchCaptainRolesStmt, err = db.PrepareContext(ctx, listMatchCaptainRoles); err != nil {
+		return nil, fmt.Errorf("error preparing query ListMatchCaptainRoles: %w", err)
+	}
 	if q.listMatchModeratorsStmt, err = db.PrepareContext(ctx, listMatchModerators); err != nil {
 		return nil, fmt.Errorf("error preparing query ListMatchModerators: %w", err)
 	}
 	if q.listMatchStreamersStmt, err = db.PrepareContext(ctx, listMatchStreamers); err != nil {
 		return nil, fmt.Errorf("error preparing query ListMatchStreamers: %w", err)
 	}
+	if q.listMatchTeamCaptainsStmt, err = db.PrepareContext(ctx, listMatchTeamCaptains); err != nil {
+		return nil, fmt.Errorf("error preparing query ListMatchTeamCaptains: %w", err)
+	}
 	if q.listMatchTeamsStmt, err = db.PrepareContext(ctx, listMatchTeams); err != nil {
 		return nil, fmt.Errorf("error preparing query ListMatchTeams: %w", err)
 	}
+	if q.listMessageTemplatesStmt, err = db.PrepareContext(ctx, listMessageTemplates); err != nil {
+		return nil, fmt.Errorf("error preparing query ListMessageTemplates: %w", err)
+	}
 	if q.listNotificationsStmt, err = db.PrepareContext(ctx, listNotifications); err != nil {
 		return nil, fmt.Errorf("error preparing query ListNotifications: %w", err)
 	}
@@ -231,6 +516,51 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.listNowDueParticipationRequirementsStmt, err = db.PrepareContext(ctx, listNowDueParticipationRequirements); err != nil {
 		return nil, fmt.Errorf("error preparing query ListNowDueParticipationRequirements: %w", err)
 	}
+	if q.listOpenLegacyParticipationRequirementsStmt, err = db.PrepareContext(ctx, listOpenLegacyParticipationRequirements); err != nil {
+		return nil, fmt.Errorf("error preparing query ListOpenLegacyParticipationRequirements: %w", err)
+	}
+	if q.listOverflowCategoriesStmt, err = db.PrepareContext(ctx, listOverflowCategories); err != nil {
+		return nil, fmt.Errorf("error preparing query ListOverflowCategories: %w", err)
+	}
+	if q.listParticipantsStmt, err = db.PrepareContext(ctx, listParticipants); err != nil {
+		return nil, fmt.Errorf("error preparing query ListParticipants: %w", err)
+	}
+	if q.listRegisteredTeamsStmt, err = db.PrepareContext(ctx, listRegisteredTeams); err != nil {
+		return nil, fmt.Errorf("error preparing query ListRegisteredTeams: %w", err)
+	}
+	if q.listRegisteredTeamsByRolesStmt, err = db.PrepareContext(ctx, listRegisteredTeamsByRoles); err != nil {
+		return nil, fmt.Errorf("error preparing query ListRegisteredTeamsByRoles: %w", err)
+	}
+	if q.listScheduleBoardMessagesStmt, err = db.PrepareContext(ctx, listScheduleBoardMessages); err != nil {
+		return nil, fmt.Errorf("error preparing query ListScheduleBoardMessages: %w", err)
+	}
+	if q.listSeasonFixtureResultsStmt, err = db.PrepareContext(ctx, listSeasonFixtureResults); err != nil {
+		return nil, fmt.Errorf("error preparing query ListSeasonFixtureResults: %w", err)
+	}
+	if q.listSeasonFixturesStmt, err = db.PrepareContext(ctx, listSeasonFixtures); err != nil {
+		return nil, fmt.Errorf("error preparing query ListSeasonFixtures: %w", err)
+	}
+	if q.listSeasonFixturesWithoutMatchStmt, err = db.PrepareContext(ctx, listSeasonFixturesWithoutMatch); err != nil {
+		return nil, fmt.Errorf("error preparing query ListSeasonFixturesWithoutMatch: %w", err)
+	}
+	if q.listSeasonMatchIDsWithoutChannelStmt, err = db.PrepareContext(ctx, listSeasonMatchIDsWithoutChannel); err != nil {
+		return nil, fmt.Errorf("error preparing query ListSeasonMatchIDsWithoutChannel: %w", err)
+	}
+	if q.listSwissByesStmt, err = db.PrepareContext(ctx, listSwissByes); err != nil {
+		return nil, fmt.Errorf("error preparing query ListSwissByes: %w", err)
+	}
+	if q.listSwissTeamsStmt, err = db.PrepareContext(ctx, listSwissTeams); err != nil {
+		return nil, fmt.Errorf("error preparing query ListSwissTeams: %w", err)
+	}
+	if q.listTeamMembersStmt, err = db.PrepareContext(ctx, listTeamMembers); err != nil {
+		return nil, fmt.Errorf("error preparing query ListTeamMembers: %w", err)
+	}
+	if q.listTeamRatingHistoryStmt, err = db.PrepareContext(ctx, listTeamRatingHistory); err != nil {
+		return nil, fmt.Errorf("error preparing query ListTeamRatingHistory: %w", err)
+	}
+	if q.listTeamResultsStmt, err = db.PrepareContext(ctx, listTeamResults); err != nil {
+		return nil, fmt.Errorf("error preparing query ListTeamResults: %w", err)
+	}
 	if q.nextAccessibleChannelStmt, err = db.PrepareContext(ctx, nextAccessibleChannel); err != nil {
 		return nil, fmt.Errorf("error preparing query NextAccessibleChannel: %w", err)
 	}
@@ -249,18 +579,33 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.nextParticipationRequirementStmt, err = db.PrepareContext(ctx, nextParticipationRequirement); err != nil {
 		return nil, fmt.Errorf("error preparing query NextParticipationRequirement: %w", err)
 	}
+	if q.promoteSubstituteStmt, err = db.PrepareContext(ctx, promoteSubstitute); err != nil {
+		return nil, fmt.Errorf("error preparing query PromoteSubstitute: %w", err)
+	}
 	if q.removeGuildRoleAccessStmt, err = db.PrepareContext(ctx, removeGuildRoleAccess); err != nil {
 		return nil, fmt.Errorf("error preparing query RemoveGuildRoleAccess: %w", err)
 	}
 	if q.removeGuildUserAccessStmt, err = db.PrepareContext(ctx, removeGuildUserAccess); err != nil {
 		return nil, fmt.Errorf("error preparing query RemoveGuildUserAccess: %w", err)
 	}
+	if q.removeParticipantStmt, err = db.PrepareContext(ctx, removeParticipant); err != nil {
+		return nil, fmt.Errorf("error preparing query RemoveParticipant: %w", err)
+	}
+	if q.removeTeamMemberStmt, err = db.PrepareContext(ctx, removeTeamMember); err != nil {
+		return nil, fmt.Errorf("error preparing query RemoveTeamMember: %w", err)
+	}
 	if q.rescheduleMatchStmt, err = db.PrepareContext(ctx, rescheduleMatch); err != nil {
 		return nil, fmt.Errorf("error preparing query RescheduleMatch: %w", err)
 	}
 	if q.resetEventIDStmt, err = db.PrepareContext(ctx, resetEventID); err != nil {
 		return nil, fmt.Errorf("error preparing query ResetEventID: %w", err)
 	}
+	if q.resetMatchChannelStmt, err = db.PrepareContext(ctx, resetMatchChannel); err != nil {
+		return nil, fmt.Errorf("error preparing query ResetMatchChannel: %w", err)
+	}
+	if q.resetTeamCaptainsStmt, err = db.PrepareContext(ctx, resetTeamCaptains); err != nil {
+		return nil, fmt.Errorf("error preparing query ResetTeamCaptains: %w", err)
+	}
 	if q.setGuildChannelAccessOffsetStmt, err = db.PrepareContext(ctx, setGuildChannelAccessOffset); err != nil {
 		return nil, fmt.Errorf("error preparing query SetGuildChannelAccessOffset: %w", err)
 	}
@@ -279,12 +624,30 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.setGuildRequirementsOffsetStmt, err = db.PrepareContext(ctx, setGuildRequirementsOffset); err != nil {
 		return nil, fmt.Errorf("error preparing query SetGuildRequirementsOffset: %w", err)
 	}
+	if q.setMatchTeamConfirmedParticipantsStmt, err = db.PrepareContext(ctx, setMatchTeamConfirmedParticipants); err != nil {
+		return nil, fmt.Errorf("error preparing query SetMatchTeamConfirmedParticipants: %w", err)
+	}
+	if q.setMessageTemplateStmt, err = db.PrepareContext(ctx, setMessageTemplate); err != nil {
+		return nil, fmt.Errorf("error preparing query SetMessageTemplate: %w", err)
+	}
+	if q.updateBracketModeratorTurnStmt, err = db.PrepareContext(ctx, updateBracketModeratorTurn); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateBracketModeratorTurn: %w", err)
+	}
 	if q.updateCategoryIdStmt, err = db.PrepareContext(ctx, updateCategoryId); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateCategoryId: %w", err)
 	}
+	if q.updateDivisionCategoryIdStmt, err = db.PrepareContext(ctx, updateDivisionCategoryId); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateDivisionCategoryId: %w", err)
+	}
+	if q.updateFixtureMatchStmt, err = db.PrepareContext(ctx, updateFixtureMatch); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateFixtureMatch: %w", err)
+	}
 	if q.updateGuildConfigStmt, err = db.PrepareContext(ctx, updateGuildConfig); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateGuildConfig: %w", err)
 	}
+	if q.updateMatchChannelStmt, err = db.PrepareContext(ctx, updateMatchChannel); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateMatchChannel: %w", err)
+	}
 	if q.updateMatchChannelAccessibilityStmt, err = db.PrepareContext(ctx, updateMatchChannelAccessibility); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateMatchChannelAccessibility: %w", err)
 	}
@@ -294,6 +657,21 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.updateParticipationRequirementsStmt, err = db.PrepareContext(ctx, updateParticipationRequirements); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateParticipationRequirements: %w", err)
 	}
+	if q.updateRegisteredTeamStmt, err = db.PrepareContext(ctx, updateRegisteredTeam); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateRegisteredTeam: %w", err)
+	}
+	if q.updateRescheduleProposalMessageStmt, err = db.PrepareContext(ctx, updateRescheduleProposalMessage); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateRescheduleProposalMessage: %w", err)
+	}
+	if q.updateResultMessageStmt, err = db.PrepareContext(ctx, updateResultMessage); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateResultMessage: %w", err)
+	}
+	if q.updateResultStatusStmt, err = db.PrepareContext(ctx, updateResultStatus); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateResultStatus: %w", err)
+	}
+	if q.updateSwissTournamentRoundStmt, err = db.PrepareContext(ctx, updateSwissTournamentRound); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateSwissTournamentRound: %w", err)
+	}
 	return &q, nil
 }
 
@@ -304,6 +682,36 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing addAnnouncementStmt: %w", cerr)
 		}
 	}
+	if q.addBracketStmt != nil {
+		if cerr := q.addBracketStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addBracketStmt: %w", cerr)
+		}
+	}
+	if q.addBracketSlotStmt != nil {
+		if cerr := q.addBracketSlotStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addBracketSlotStmt: %w", cerr)
+		}
+	}
+	if q.addDivisionStmt != nil {
+		if cerr := q.addDivisionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addDivisionStmt: %w", cerr)
+		}
+	}
+	if q.addDivisionTeamStmt != nil {
+		if cerr := q.addDivisionTeamStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addDivisionTeamStmt: %w", cerr)
+		}
+	}
+	if q.addFixtureStmt != nil {
+		if cerr := q.addFixtureStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addFixtureStmt: %w", cerr)
+		}
+	}
+	if q.addFixtureTeamStmt != nil {
+		if cerr := q.addFixtureTeamStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addFixtureTeamStmt: %w", cerr)
+		}
+	}
 	if q.addGuildConfigStmt != nil {
 		if cerr := q.addGuildConfigStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing addGuildConfigStmt: %w", cerr)
@@ -349,36 +757,131 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing addMatchTeamStmt: %w", cerr)
 		}
 	}
-	if q.addMatchTeamResultsStmt != nil {
-		if cerr := q.addMatchTeamResultsStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing addMatchTeamResultsStmt: %w", cerr)
-		}
-	}
 	if q.addNotificationStmt != nil {
 		if cerr := q.addNotificationStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing addNotificationStmt: %w", cerr)
 		}
 	}
+	if q.addOverflowCategoryStmt != nil {
+		if cerr := q.addOverflowCategoryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addOverflowCategoryStmt: %w", cerr)
+		}
+	}
+	if q.addParticipantStmt != nil {
+		if cerr := q.addParticipantStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addParticipantStmt: %w", cerr)
+		}
+	}
 	if q.addParticipationRequirementsStmt != nil {
 		if cerr := q.addParticipationRequirementsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing addParticipationRequirementsStmt: %w", cerr)
 		}
 	}
+	if q.addRatingHistoryStmt != nil {
+		if cerr := q.addRatingHistoryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addRatingHistoryStmt: %w", cerr)
+		}
+	}
+	if q.addRegisteredTeamStmt != nil {
+		if cerr := q.addRegisteredTeamStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addRegisteredTeamStmt: %w", cerr)
+		}
+	}
+	if q.addRescheduleProposalStmt != nil {
+		if cerr := q.addRescheduleProposalStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addRescheduleProposalStmt: %w", cerr)
+		}
+	}
+	if q.addResultStmt != nil {
+		if cerr := q.addResultStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addResultStmt: %w", cerr)
+		}
+	}
+	if q.addResultConfirmationStmt != nil {
+		if cerr := q.addResultConfirmationStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addResultConfirmationStmt: %w", cerr)
+		}
+	}
+	if q.addScheduleBoardStmt != nil {
+		if cerr := q.addScheduleBoardStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addScheduleBoardStmt: %w", cerr)
+		}
+	}
+	if q.addScheduleBoardMessageStmt != nil {
+		if cerr := q.addScheduleBoardMessageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addScheduleBoardMessageStmt: %w", cerr)
+		}
+	}
+	if q.addSeasonStmt != nil {
+		if cerr := q.addSeasonStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addSeasonStmt: %w", cerr)
+		}
+	}
+	if q.addStandingsMessageStmt != nil {
+		if cerr := q.addStandingsMessageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addStandingsMessageStmt: %w", cerr)
+		}
+	}
+	if q.addSwissByeStmt != nil {
+		if cerr := q.addSwissByeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addSwissByeStmt: %w", cerr)
+		}
+	}
+	if q.addSwissTeamStmt != nil {
+		if cerr := q.addSwissTeamStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addSwissTeamStmt: %w", cerr)
+		}
+	}
+	if q.addSwissTournamentStmt != nil {
+		if cerr := q.addSwissTournamentStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addSwissTournamentStmt: %w", cerr)
+		}
+	}
+	if q.addTeamCaptainStmt != nil {
+		if cerr := q.addTeamCaptainStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addTeamCaptainStmt: %w", cerr)
+		}
+	}
+	if q.addTeamMemberStmt != nil {
+		if cerr := q.addTeamMemberStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addTeamMemberStmt: %w", cerr)
+		}
+	}
+	if q.addTeamRatingStmt != nil {
+		if cerr := q.addTeamRatingStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addTeamRatingStmt: %w", cerr)
+		}
+	}
+	if q.addTeamResultStmt != nil {
+		if cerr := q.addTeamResultStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addTeamResultStmt: %w", cerr)
+		}
+	}
+	if q.archiveMatchListStmt != nil {
+		if cerr := q.archiveMatchListStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing archiveMatchListStmt: %w", cerr)
+		}
+	}
+	if q.cancelMatchStmt != nil {
+		if cerr := q.cancelMatchStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing cancelMatchStmt: %w", cerr)
+		}
+	}
 	if q.closeParticipationEntryStmt != nil {
 		if cerr := q.closeParticipationEntryStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing closeParticipationEntryStmt: %w", cerr)
 		}
 	}
+	if q.confirmSeasonStmt != nil {
+		if cerr := q.confirmSeasonStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing confirmSeasonStmt: %w", cerr)
+		}
+	}
 	if q.continueAnnouncementStmt != nil {
 		if cerr := q.continueAnnouncementStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing continueAnnouncementStmt: %w", cerr)
 		}
 	}
-	if q.continueAnnouncementsStmt != nil {
-		if cerr := q.continueAnnouncementsStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing continueAnnouncementsStmt: %w", cerr)
-		}
-	}
 	if q.countAllMatchesStmt != nil {
 		if cerr := q.countAllMatchesStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing countAllMatchesStmt: %w", cerr)
@@ -399,6 +902,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing countDisabledGuildsStmt: %w", cerr)
 		}
 	}
+	if q.countDivisionMatchesStmt != nil {
+		if cerr := q.countDivisionMatchesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countDivisionMatchesStmt: %w", cerr)
+		}
+	}
 	if q.countEnabledEventCreationStmt != nil {
 		if cerr := q.countEnabledEventCreationStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing countEnabledEventCreationStmt: %w", cerr)
@@ -409,6 +917,16 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing countEnabledGuildsStmt: %w", cerr)
 		}
 	}
+	if q.countGuildAnnouncementsStmt != nil {
+		if cerr := q.countGuildAnnouncementsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countGuildAnnouncementsStmt: %w", cerr)
+		}
+	}
+	if q.countGuildDivisionsStmt != nil {
+		if cerr := q.countGuildDivisionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countGuildDivisionsStmt: %w", cerr)
+		}
+	}
 	if q.countMatchesStmt != nil {
 		if cerr := q.countMatchesStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing countMatchesStmt: %w", cerr)
@@ -419,6 +937,26 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing countNotificationsStmt: %w", cerr)
 		}
 	}
+	if q.countResultConfirmationsStmt != nil {
+		if cerr := q.countResultConfirmationsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countResultConfirmationsStmt: %w", cerr)
+		}
+	}
+	if q.countSeasonFixturesStmt != nil {
+		if cerr := q.countSeasonFixturesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countSeasonFixturesStmt: %w", cerr)
+		}
+	}
+	if q.countTeamStartersStmt != nil {
+		if cerr := q.countTeamStartersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countTeamStartersStmt: %w", cerr)
+		}
+	}
+	if q.countTeamSubstitutesStmt != nil {
+		if cerr := q.countTeamSubstitutesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countTeamSubstitutesStmt: %w", cerr)
+		}
+	}
 	if q.decreaseMatchTeamConfirmedParticipantsStmt != nil {
 		if cerr := q.decreaseMatchTeamConfirmedParticipantsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing decreaseMatchTeamConfirmedParticipantsStmt: %w", cerr)
@@ -444,6 +982,26 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing deleteAnnouncementStmt: %w", cerr)
 		}
 	}
+	if q.deleteDivisionStmt != nil {
+		if cerr := q.deleteDivisionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteDivisionStmt: %w", cerr)
+		}
+	}
+	if q.deleteDivisionOverflowCategoriesStmt != nil {
+		if cerr := q.deleteDivisionOverflowCategoriesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteDivisionOverflowCategoriesStmt: %w", cerr)
+		}
+	}
+	if q.deleteDivisionTeamStmt != nil {
+		if cerr := q.deleteDivisionTeamStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteDivisionTeamStmt: %w", cerr)
+		}
+	}
+	if q.deleteFixtureStmt != nil {
+		if cerr := q.deleteFixtureStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteFixtureStmt: %w", cerr)
+		}
+	}
 	if q.deleteGuildConfigStmt != nil {
 		if cerr := q.deleteGuildConfigStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteGuildConfigStmt: %w", cerr)
@@ -454,14 +1012,24 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing deleteGuildMatchesStmt: %w", cerr)
 		}
 	}
+	if q.deleteGuildRatingHistoryStmt != nil {
+		if cerr := q.deleteGuildRatingHistoryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteGuildRatingHistoryStmt: %w", cerr)
+		}
+	}
+	if q.deleteGuildTeamRatingsStmt != nil {
+		if cerr := q.deleteGuildTeamRatingsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteGuildTeamRatingsStmt: %w", cerr)
+		}
+	}
 	if q.deleteMatchStmt != nil {
 		if cerr := q.deleteMatchStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteMatchStmt: %w", cerr)
 		}
 	}
-	if q.deleteMatchListStmt != nil {
-		if cerr := q.deleteMatchListStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing deleteMatchListStmt: %w", cerr)
+	if q.deleteMatchGeneratedNotificationsStmt != nil {
+		if cerr := q.deleteMatchGeneratedNotificationsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteMatchGeneratedNotificationsStmt: %w", cerr)
 		}
 	}
 	if q.deleteMatchModeratorStmt != nil {
@@ -494,16 +1062,66 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing deleteMatchTeamStmt: %w", cerr)
 		}
 	}
+	if q.deleteMessageTemplateStmt != nil {
+		if cerr := q.deleteMessageTemplateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteMessageTemplateStmt: %w", cerr)
+		}
+	}
 	if q.deleteNotificationStmt != nil {
 		if cerr := q.deleteNotificationStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteNotificationStmt: %w", cerr)
 		}
 	}
+	if q.deleteOverflowCategoryStmt != nil {
+		if cerr := q.deleteOverflowCategoryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteOverflowCategoryStmt: %w", cerr)
+		}
+	}
 	if q.deleteParticipationRequirementsStmt != nil {
 		if cerr := q.deleteParticipationRequirementsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteParticipationRequirementsStmt: %w", cerr)
 		}
 	}
+	if q.deleteRegisteredTeamStmt != nil {
+		if cerr := q.deleteRegisteredTeamStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteRegisteredTeamStmt: %w", cerr)
+		}
+	}
+	if q.deleteRescheduleProposalStmt != nil {
+		if cerr := q.deleteRescheduleProposalStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteRescheduleProposalStmt: %w", cerr)
+		}
+	}
+	if q.deleteResultConfirmationsStmt != nil {
+		if cerr := q.deleteResultConfirmationsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteResultConfirmationsStmt: %w", cerr)
+		}
+	}
+	if q.deleteScheduleBoardStmt != nil {
+		if cerr := q.deleteScheduleBoardStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteScheduleBoardStmt: %w", cerr)
+		}
+	}
+	if q.deleteScheduleBoardMessagesFromStmt != nil {
+		if cerr := q.deleteScheduleBoardMessagesFromStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteScheduleBoardMessagesFromStmt: %w", cerr)
+		}
+	}
+	if q.deleteSeasonStmt != nil {
+		if cerr := q.deleteSeasonStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteSeasonStmt: %w", cerr)
+		}
+	}
+	if q.deleteSeasonDraftsStmt != nil {
+		if cerr := q.deleteSeasonDraftsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteSeasonDraftsStmt: %w", cerr)
+		}
+	}
+	if q.deleteStandingsMessageStmt != nil {
+		if cerr := q.deleteStandingsMessageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteStandingsMessageStmt: %w", cerr)
+		}
+	}
 	if q.disableGuildStmt != nil {
 		if cerr := q.disableGuildStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing disableGuildStmt: %w", cerr)
@@ -514,6 +1132,41 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getAnnouncementStmt: %w", cerr)
 		}
 	}
+	if q.getBracketByNameStmt != nil {
+		if cerr := q.getBracketByNameStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getBracketByNameStmt: %w", cerr)
+		}
+	}
+	if q.getBracketOfFixtureStmt != nil {
+		if cerr := q.getBracketOfFixtureStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getBracketOfFixtureStmt: %w", cerr)
+		}
+	}
+	if q.getDivisionStmt != nil {
+		if cerr := q.getDivisionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getDivisionStmt: %w", cerr)
+		}
+	}
+	if q.getDivisionByCategoryStmt != nil {
+		if cerr := q.getDivisionByCategoryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getDivisionByCategoryStmt: %w", cerr)
+		}
+	}
+	if q.getFirstTeamSubstituteStmt != nil {
+		if cerr := q.getFirstTeamSubstituteStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getFirstTeamSubstituteStmt: %w", cerr)
+		}
+	}
+	if q.getFixtureStmt != nil {
+		if cerr := q.getFixtureStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getFixtureStmt: %w", cerr)
+		}
+	}
+	if q.getFixtureByMatchStmt != nil {
+		if cerr := q.getFixtureByMatchStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getFixtureByMatchStmt: %w", cerr)
+		}
+	}
 	if q.getGuildConfigStmt != nil {
 		if cerr := q.getGuildConfigStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getGuildConfigStmt: %w", cerr)
@@ -524,6 +1177,16 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getGuildConfigByCategoryStmt: %w", cerr)
 		}
 	}
+	if q.getGuildLanguageStmt != nil {
+		if cerr := q.getGuildLanguageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getGuildLanguageStmt: %w", cerr)
+		}
+	}
+	if q.getGuildOutputModeStmt != nil {
+		if cerr := q.getGuildOutputModeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getGuildOutputModeStmt: %w", cerr)
+		}
+	}
 	if q.getGuildRoleAccessStmt != nil {
 		if cerr := q.getGuildRoleAccessStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getGuildRoleAccessStmt: %w", cerr)
@@ -539,6 +1202,16 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getMatchStmt: %w", cerr)
 		}
 	}
+	if q.getMatchByChannelStmt != nil {
+		if cerr := q.getMatchByChannelStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getMatchByChannelStmt: %w", cerr)
+		}
+	}
+	if q.getMatchByNumberStmt != nil {
+		if cerr := q.getMatchByNumberStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getMatchByNumberStmt: %w", cerr)
+		}
+	}
 	if q.getMatchTeamStmt != nil {
 		if cerr := q.getMatchTeamStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getMatchTeamStmt: %w", cerr)
@@ -549,16 +1222,96 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getMatchTeamByRolesStmt: %w", cerr)
 		}
 	}
+	if q.getMessageTemplateStmt != nil {
+		if cerr := q.getMessageTemplateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getMessageTemplateStmt: %w", cerr)
+		}
+	}
 	if q.getNotificationByOffsetStmt != nil {
 		if cerr := q.getNotificationByOffsetStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getNotificationByOffsetStmt: %w", cerr)
 		}
 	}
+	if q.getOverflowCategoryStmt != nil {
+		if cerr := q.getOverflowCategoryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getOverflowCategoryStmt: %w", cerr)
+		}
+	}
+	if q.getParticipantStmt != nil {
+		if cerr := q.getParticipantStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getParticipantStmt: %w", cerr)
+		}
+	}
 	if q.getParticipationRequirementsStmt != nil {
 		if cerr := q.getParticipationRequirementsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getParticipationRequirementsStmt: %w", cerr)
 		}
 	}
+	if q.getParticipationRequirementsByChannelStmt != nil {
+		if cerr := q.getParticipationRequirementsByChannelStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getParticipationRequirementsByChannelStmt: %w", cerr)
+		}
+	}
+	if q.getRegisteredTeamStmt != nil {
+		if cerr := q.getRegisteredTeamStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getRegisteredTeamStmt: %w", cerr)
+		}
+	}
+	if q.getRegisteredTeamByNameStmt != nil {
+		if cerr := q.getRegisteredTeamByNameStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getRegisteredTeamByNameStmt: %w", cerr)
+		}
+	}
+	if q.getRescheduleProposalStmt != nil {
+		if cerr := q.getRescheduleProposalStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getRescheduleProposalStmt: %w", cerr)
+		}
+	}
+	if q.getRescheduleProposalByMessageStmt != nil {
+		if cerr := q.getRescheduleProposalByMessageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getRescheduleProposalByMessageStmt: %w", cerr)
+		}
+	}
+	if q.getResultStmt != nil {
+		if cerr := q.getResultStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getResultStmt: %w", cerr)
+		}
+	}
+	if q.getResultByMessageStmt != nil {
+		if cerr := q.getResultByMessageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getResultByMessageStmt: %w", cerr)
+		}
+	}
+	if q.getScheduleBoardStmt != nil {
+		if cerr := q.getScheduleBoardStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getScheduleBoardStmt: %w", cerr)
+		}
+	}
+	if q.getSeasonDraftStmt != nil {
+		if cerr := q.getSeasonDraftStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getSeasonDraftStmt: %w", cerr)
+		}
+	}
+	if q.getStandingsMessageStmt != nil {
+		if cerr := q.getStandingsMessageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getStandingsMessageStmt: %w", cerr)
+		}
+	}
+	if q.getSwissTournamentByNameStmt != nil {
+		if cerr := q.getSwissTournamentByNameStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getSwissTournamentByNameStmt: %w", cerr)
+		}
+	}
+	if q.getTeamDivisionStmt != nil {
+		if cerr := q.getTeamDivisionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getTeamDivisionStmt: %w", cerr)
+		}
+	}
+	if q.getTeamRatingStmt != nil {
+		if cerr := q.getTeamRatingStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getTeamRatingStmt: %w", cerr)
+		}
+	}
 	if q.hasRoleAccessStmt != nil {
 		if cerr := q.hasRoleAccessStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing hasRoleAccessStmt: %w", cerr)
@@ -579,6 +1332,81 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing isGuildEnabledStmt: %w", cerr)
 		}
 	}
+	if q.isMatchCaptainStmt != nil {
+		if cerr := q.isMatchCaptainStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing isMatchCaptainStmt: %w", cerr)
+		}
+	}
+	if q.isMatchModeratorStmt != nil {
+		if cerr := q.isMatchModeratorStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing isMatchModeratorStmt: %w", cerr)
+		}
+	}
+	if q.isTeamCaptainStmt != nil {
+		if cerr := q.isTeamCaptainStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing isTeamCaptainStmt: %w", cerr)
+		}
+	}
+	if q.listBracketSlotsStmt != nil {
+		if cerr := q.listBracketSlotsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listBracketSlotsStmt: %w", cerr)
+		}
+	}
+	if q.listConfirmedSeasonsWithoutMatchesStmt != nil {
+		if cerr := q.listConfirmedSeasonsWithoutMatchesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listConfirmedSeasonsWithoutMatchesStmt: %w", cerr)
+		}
+	}
+	if q.listDivisionFinalTeamResultsStmt != nil {
+		if cerr := q.listDivisionFinalTeamResultsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listDivisionFinalTeamResultsStmt: %w", cerr)
+		}
+	}
+	if q.listDivisionTeamRoleIDsStmt != nil {
+		if cerr := q.listDivisionTeamRoleIDsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listDivisionTeamRoleIDsStmt: %w", cerr)
+		}
+	}
+	if q.listEnabledScheduleBoardsStmt != nil {
+		if cerr := q.listEnabledScheduleBoardsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listEnabledScheduleBoardsStmt: %w", cerr)
+		}
+	}
+	if q.listFixtureTeamsStmt != nil {
+		if cerr := q.listFixtureTeamsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listFixtureTeamsStmt: %w", cerr)
+		}
+	}
+	if q.listGuildAnnouncementNamesStmt != nil {
+		if cerr := q.listGuildAnnouncementNamesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listGuildAnnouncementNamesStmt: %w", cerr)
+		}
+	}
+	if q.listGuildAnnouncementsStmt != nil {
+		if cerr := q.listGuildAnnouncementsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listGuildAnnouncementsStmt: %w", cerr)
+		}
+	}
+	if q.listGuildBracketNamesStmt != nil {
+		if cerr := q.listGuildBracketNamesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listGuildBracketNamesStmt: %w", cerr)
+		}
+	}
+	if q.listGuildDivisionNamesStmt != nil {
+		if cerr := q.listGuildDivisionNamesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listGuildDivisionNamesStmt: %w", cerr)
+		}
+	}
+	if q.listGuildDivisionsStmt != nil {
+		if cerr := q.listGuildDivisionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listGuildDivisionsStmt: %w", cerr)
+		}
+	}
+	if q.listGuildFinalTeamResultsStmt != nil {
+		if cerr := q.listGuildFinalTeamResultsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listGuildFinalTeamResultsStmt: %w", cerr)
+		}
+	}
 	if q.listGuildMatchesStmt != nil {
 		if cerr := q.listGuildMatchesStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listGuildMatchesStmt: %w", cerr)
@@ -594,11 +1422,26 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing listGuildRoleAccessStmt: %w", cerr)
 		}
 	}
+	if q.listGuildSwissTournamentNamesStmt != nil {
+		if cerr := q.listGuildSwissTournamentNamesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listGuildSwissTournamentNamesStmt: %w", cerr)
+		}
+	}
+	if q.listGuildTeamRatingsStmt != nil {
+		if cerr := q.listGuildTeamRatingsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listGuildTeamRatingsStmt: %w", cerr)
+		}
+	}
 	if q.listGuildUserAccessStmt != nil {
 		if cerr := q.listGuildUserAccessStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listGuildUserAccessStmt: %w", cerr)
 		}
 	}
+	if q.listMatchCaptainRolesStmt != nil {
+		if cerr := q.listMatchCaptainRolesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listMatchCaptainRolesStmt: %w", cerr)
+		}
+	}
 	if q.listMatchModeratorsStmt != nil {
 		if cerr := q.listMatchModeratorsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listMatchModeratorsStmt: %w", cerr)
@@ -609,11 +1452,21 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing listMatchStreamersStmt: %w", cerr)
 		}
 	}
+	if q.listMatchTeamCaptainsStmt != nil {
+		if cerr := q.listMatchTeamCaptainsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listMatchTeamCaptainsStmt: %w", cerr)
+		}
+	}
 	if q.listMatchTeamsStmt != nil {
 		if cerr := q.listMatchTeamsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listMatchTeamsStmt: %w", cerr)
 		}
 	}
+	if q.listMessageTemplatesStmt != nil {
+		if cerr := q.listMessageTemplatesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listMessageTemplatesStmt: %w", cerr)
+		}
+	}
 	if q.listNotificationsStmt != nil {
 		if cerr := q.listNotificationsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listNotificationsStmt: %w", cerr)
@@ -644,6 +1497,81 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing listNowDueParticipationRequirementsStmt: %w", cerr)
 		}
 	}
+	if q.listOpenLegacyParticipationRequirementsStmt != nil {
+		if cerr := q.listOpenLegacyParticipationRequirementsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listOpenLegacyParticipationRequirementsStmt: %w", cerr)
+		}
+	}
+	if q.listOverflowCategoriesStmt != nil {
+		if cerr := q.listOverflowCategoriesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listOverflowCategoriesStmt: %w", cerr)
+		}
+	}
+	if q.listParticipantsStmt != nil {
+		if cerr := q.listParticipantsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listParticipantsStmt: %w", cerr)
+		}
+	}
+	if q.listRegisteredTeamsStmt != nil {
+		if cerr := q.listRegisteredTeamsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listRegisteredTeamsStmt: %w", cerr)
+		}
+	}
+	if q.listRegisteredTeamsByRolesStmt != nil {
+		if cerr := q.listRegisteredTeamsByRolesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listRegisteredTeamsByRolesStmt: %w", cerr)
+		}
+	}
+	if q.listScheduleBoardMessagesStmt != nil {
+		if cerr := q.listScheduleBoardMessagesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listScheduleBoardMessagesStmt: %w", cerr)
+		}
+	}
+	if q.listSeasonFixtureResultsStmt != nil {
+		if cerr := q.listSeasonFixtureResultsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listSeasonFixtureResultsStmt: %w", cerr)
+		}
+	}
+	if q.listSeasonFixturesStmt != nil {
+		if cerr := q.listSeasonFixturesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listSeasonFixturesStmt: %w", cerr)
+		}
+	}
+	if q.listSeasonFixturesWithoutMatchStmt != nil {
+		if cerr := q.listSeasonFixturesWithoutMatchStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listSeasonFixturesWithoutMatchStmt: %w", cerr)
+		}
+	}
+	if q.listSeasonMatchIDsWithoutChannelStmt != nil {
+		if cerr := q.listSeasonMatchIDsWithoutChannelStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listSeasonMatchIDsWithoutChannelStmt: %w", cerr)
+		}
+	}
+	if q.listSwissByesStmt != nil {
+		if cerr := q.listSwissByesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listSwissByesStmt: %w", cerr)
+		}
+	}
+	if q.listSwissTeamsStmt != nil {
+		if cerr := q.listSwissTeamsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listSwissTeamsStmt: %w", cerr)
+		}
+	}
+	if q.listTeamMembersStmt != nil {
+		if cerr := q.listTeamMembersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listTeamMembersStmt: %w", cerr)
+		}
+	}
+	if q.listTeamRatingHistoryStmt != nil {
+		if cerr := q.listTeamRatingHistoryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listTeamRatingHistoryStmt: %w", cerr)
+		}
+	}
+	if q.listTeamResultsStmt != nil {
+		if cerr := q.listTeamResultsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listTeamResultsStmt: %w", cerr)
+		}
+	}
 	if q.nextAccessibleChannelStmt != nil {
 		if cerr := q.nextAccessibleChannelStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing nextAccessibleChannelStmt: %w", cerr)
@@ -674,6 +1602,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing nextParticipationRequirementStmt: %w", cerr)
 		}
 	}
+	if q.promoteSubstituteStmt != nil {
+		if cerr := q.promoteSubstituteStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing promoteSubstituteStmt: %w", cerr)
+		}
+	}
 	if q.removeGuildRoleAccessStmt != nil {
 		if cerr := q.removeGuildRoleAccessStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing removeGuildRoleAccessStmt: %w", cerr)
@@ -684,6 +1617,16 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing removeGuildUserAccessStmt: %w", cerr)
 		}
 	}
+	if q.removeParticipantStmt != nil {
+		if cerr := q.removeParticipantStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing removeParticipantStmt: %w", cerr)
+		}
+	}
+	if q.removeTeamMemberStmt != nil {
+		if cerr := q.removeTeamMemberStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing removeTeamMemberStmt: %w", cerr)
+		}
+	}
 	if q.rescheduleMatchStmt != nil {
 		if cerr := q.rescheduleMatchStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing rescheduleMatchStmt: %w", cerr)
@@ -694,6 +1637,16 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing resetEventIDStmt: %w", cerr)
 		}
 	}
+	if q.resetMatchChannelStmt != nil {
+		if cerr := q.resetMatchChannelStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing resetMatchChannelStmt: %w", cerr)
+		}
+	}
+	if q.resetTeamCaptainsStmt != nil {
+		if cerr := q.resetTeamCaptainsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing resetTeamCaptainsStmt: %w", cerr)
+		}
+	}
 	if q.setGuildChannelAccessOffsetStmt != nil {
 		if cerr := q.setGuildChannelAccessOffsetStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing setGuildChannelAccessOffsetStmt: %w", cerr)
@@ -724,16 +1677,46 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing setGuildRequirementsOffsetStmt: %w", cerr)
 		}
 	}
+	if q.setMatchTeamConfirmedParticipantsStmt != nil {
+		if cerr := q.setMatchTeamConfirmedParticipantsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setMatchTeamConfirmedParticipantsStmt: %w", cerr)
+		}
+	}
+	if q.setMessageTemplateStmt != nil {
+		if cerr := q.setMessageTemplateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setMessageTemplateStmt: %w", cerr)
+		}
+	}
+	if q.updateBracketModeratorTurnStmt != nil {
+		if cerr := q.updateBracketModeratorTurnStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateBracketModeratorTurnStmt: %w", cerr)
+		}
+	}
 	if q.updateCategoryIdStmt != nil {
 		if cerr := q.updateCategoryIdStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateCategoryIdStmt: %w", cerr)
 		}
 	}
+	if q.updateDivisionCategoryIdStmt != nil {
+		if cerr := q.updateDivisionCategoryIdStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateDivisionCategoryIdStmt: %w", cerr)
+		}
+	}
+	if q.updateFixtureMatchStmt != nil {
+		if cerr := q.updateFixtureMatchStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateFixtureMatchStmt: %w", cerr)
+		}
+	}
 	if q.updateGuildConfigStmt != nil {
 		if cerr := q.updateGuildConfigStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateGuildConfigStmt: %w", cerr)
 		}
 	}
+	if q.updateMatchChannelStmt != nil {
+		if cerr := q.updateMatchChannelStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateMatchChannelStmt: %w", cerr)
+		}
+	}
 	if q.updateMatchChannelAccessibilityStmt != nil {
 		if cerr := q.updateMatchChannelAccessibilityStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateMatchChannelAccessibilityStmt: %w", cerr)
@@ -749,6 +1732,31 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing updateParticipationRequirementsStmt: %w", cerr)
 		}
 	}
+	if q.updateRegisteredTeamStmt != nil {
+		if cerr := q.updateRegisteredTeamStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateRegisteredTeamStmt: %w", cerr)
+		}
+	}
+	if q.updateRescheduleProposalMessageStmt != nil {
+		if cerr := q.updateRescheduleProposalMessageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateRescheduleProposalMessageStmt: %w", cerr)
+		}
+	}
+	if q.updateResultMessageStmt != nil {
+		if cerr := q.updateResultMessageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateResultMessageStmt: %w", cerr)
+		}
+	}
+	if q.updateResultStatusStmt != nil {
+		if cerr := q.updateResultStatusStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateResultStatusStmt: %w", cerr)
+		}
+	}
+	if q.updateSwissTournamentRoundStmt != nil {
+		if cerr := q.updateSwissTournamentRoundStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateSwissTournamentRoundStmt: %w", cerr)
+		}
+	}
 	return err
 }
 
@@ -786,193 +1794,445 @@ func (q *Queries) queryRow(ctx context.Context, stmt *sql.Stmt, query string, ar
 }
 
 type Queries struct {
-	db                                         DBTX
-	tx                                         *sql.Tx
-	addAnnouncementStmt                        *sql.Stmt
-	addGuildConfigStmt                         *sql.Stmt
-	addGuildRoleReadAccessStmt                 *sql.Stmt
-	addGuildRoleWriteAccessStmt                *sql.Stmt
-	addGuildUserAccessStmt                     *sql.Stmt
-	addGuildUserWriteAccessStmt                *sql.Stmt
-	addMatchStmt                               *sql.Stmt
-	addMatchModeratorStmt                      *sql.Stmt
-	addMatchStreamerStmt                       *sql.Stmt
-	addMatchTeamStmt                           *sql.Stmt
-	addMatchTeamResultsStmt                    *sql.Stmt
-	addNotificationStmt                        *sql.Stmt
-	addParticipationRequirementsStmt           *sql.Stmt
-	closeParticipationEntryStmt                *sql.Stmt
-	continueAnnouncementStmt                   *sql.Stmt
-	continueAnnouncementsStmt                  *sql.Stmt
-	countAllMatchesStmt                        *sql.Stmt
-	countAllNotificationsStmt                  *sql.Stmt
-	countAnnouncementsStmt                     *sql.Stmt
-	countDisabledGuildsStmt                    *sql.Stmt
-	countEnabledEventCreationStmt              *sql.Stmt
-	countEnabledGuildsStmt                     *sql.Stmt
-	countMatchesStmt                           *sql.Stmt
-	countNotificationsStmt                     *sql.Stmt
-	decreaseMatchTeamConfirmedParticipantsStmt *sql.Stmt
-	deleteAllMatchModeratorsStmt               *sql.Stmt
-	deleteAllMatchStreamersStmt                *sql.Stmt
-	deleteAllMatchTeamsStmt                    *sql.Stmt
-	deleteAnnouncementStmt                     *sql.Stmt
-	deleteGuildConfigStmt                      *sql.Stmt
-	deleteGuildMatchesStmt                     *sql.Stmt
-	deleteMatchStmt                            *sql.Stmt
-	deleteMatchListStmt                        *sql.Stmt
-	deleteMatchModeratorStmt                   *sql.Stmt
-	deleteMatchModeratorsStmt                  *sql.Stmt
-	deleteMatchNotificationsStmt               *sql.Stmt
-	deleteMatchStreamerStmt                    *sql.Stmt
-	deleteMatchStreamersStmt                   *sql.Stmt
-	deleteMatchTeamStmt                        *sql.Stmt
-	deleteNotificationStmt                     *sql.Stmt
-	deleteParticipationRequirementsStmt        *sql.Stmt
-	disableGuildStmt                           *sql.Stmt
-	getAnnouncementStmt                        *sql.Stmt
-	getGuildConfigStmt                         *sql.Stmt
-	getGuildConfigByCategoryStmt               *sql.Stmt
-	getGuildRoleAccessStmt                     *sql.Stmt
-	getGuildUserAccessStmt                     *sql.Stmt
-	getMatchStmt                               *sql.Stmt
-	getMatchTeamStmt                           *sql.Stmt
-	getMatchTeamByRolesStmt                    *sql.Stmt
-	getNotificationByOffsetStmt                *sql.Stmt
-	getParticipationRequirementsStmt           *sql.Stmt
-	hasRoleAccessStmt                          *sql.Stmt
-	hasUserAccessStmt                          *sql.Stmt
-	increaseMatchTeamConfirmedParticipantsStmt *sql.Stmt
-	isGuildEnabledStmt                         *sql.Stmt
-	listGuildMatchesStmt                       *sql.Stmt
-	listGuildMatchesScheduledBetweenStmt       *sql.Stmt
-	listGuildRoleAccessStmt                    *sql.Stmt
-	listGuildUserAccessStmt                    *sql.Stmt
-	listMatchModeratorsStmt                    *sql.Stmt
-	listMatchStreamersStmt                     *sql.Stmt
-	listMatchTeamsStmt                         *sql.Stmt
-	listNotificationsStmt                      *sql.Stmt
-	listNowAccessibleChannelsStmt              *sql.Stmt
-	listNowDeletableChannelsStmt               *sql.Stmt
-	listNowDueAnnouncementsStmt                *sql.Stmt
-	listNowDueNotificationsStmt                *sql.Stmt
-	listNowDueParticipationRequirementsStmt    *sql.Stmt
-	nextAccessibleChannelStmt                  *sql.Stmt
-	nextAnnouncementStmt                       *sql.Stmt
-	nextDeletableChannelStmt                   *sql.Stmt
-	nextMatchCounterStmt                       *sql.Stmt
-	nextNotificationStmt                       *sql.Stmt
-	nextParticipationRequirementStmt           *sql.Stmt
-	removeGuildRoleAccessStmt                  *sql.Stmt
-	removeGuildUserAccessStmt                  *sql.Stmt
-	rescheduleMatchStmt                        *sql.Stmt
-	resetEventIDStmt                           *sql.Stmt
-	setGuildChannelAccessOffsetStmt            *sql.Stmt
-	setGuildChannelDeleteOffsetStmt            *sql.Stmt
-	setGuildEnabledStmt                        *sql.Stmt
-	setGuildEventCreationEnabledStmt           *sql.Stmt
-	setGuildNotificationOffsetsStmt            *sql.Stmt
-	setGuildRequirementsOffsetStmt             *sql.Stmt
-	updateCategoryIdStmt                       *sql.Stmt
-	updateGuildConfigStmt                      *sql.Stmt
-	updateMatchChannelAccessibilityStmt        *sql.Stmt
-	updateMatchEventIDStmt                     *sql.Stmt
-	updateParticipationRequirementsStmt        *sql.Stmt
+	db                                          DBTX
+	tx                                          *sql.Tx
+	addAnnouncementStmt                         *sql.Stmt
+	addBracketStmt                              *sql.Stmt
+	addBracketSlotStmt                          *sql.Stmt
+	addDivisionStmt                             *sql.Stmt
+	addDivisionTeamStmt                         *sql.Stmt
+	addFixtureStmt                              *sql.Stmt
+	addFixtureTeamStmt                          *sql.Stmt
+	addGuildConfigStmt                          *sql.Stmt
+	addGuildRoleReadAccessStmt                  *sql.Stmt
+	addGuildRoleWriteAccessStmt                 *sql.Stmt
+	addGuildUserAccessStmt                      *sql.Stmt
+	addGuildUserWriteAccessStmt                 *sql.Stmt
+	addMatchStmt                                *sql.Stmt
+	addMatchModeratorStmt                       *sql.Stmt
+	addMatchStreamerStmt                        *sql.Stmt
+	addMatchTeamStmt                            *sql.Stmt
+	addNotificationStmt                         *sql.Stmt
+	addOverflowCategoryStmt                     *sql.Stmt
+	addParticipantStmt                          *sql.Stmt
+	addParticipationRequirementsStmt            *sql.Stmt
+	addRatingHistoryStmt                        *sql.Stmt
+	addRegisteredTeamStmt                       *sql.Stmt
+	addRescheduleProposalStmt                   *sql.Stmt
+	addResultStmt                               *sql.Stmt
+	addResultConfirmationStmt                   *sql.Stmt
+	addScheduleBoardStmt                        *sql.Stmt
+	addScheduleBoardMessageStmt                 *sql.Stmt
+	addSeasonStmt                               *sql.Stmt
+	addStandingsMessageStmt                     *sql.Stmt
+	addSwissByeStmt                             *sql.Stmt
+	addSwissTeamStmt                            *sql.Stmt
+	addSwissTournamentStmt                      *sql.Stmt
+	addTeamCaptainStmt                          *sql.Stmt
+	addTeamMemberStmt                           *sql.Stmt
+	addTeamRatingStmt                           *sql.Stmt
+	addTeamResultStmt                           *sql.Stmt
+	archiveMatchListStmt                        *sql.Stmt
+	cancelMatchStmt                             *sql.Stmt
+	closeParticipationEntryStmt                 *sql.Stmt
+	confirmSeasonStmt                           *sql.Stmt
+	continueAnnouncementStmt                    *sql.Stmt
+	countAllMatchesStmt                         *sql.Stmt
+	countAllNotificationsStmt                   *sql.Stmt
+	countAnnouncementsStmt                      *sql.Stmt
+	countDisabledGuildsStmt                     *sql.Stmt
+	countDivisionMatchesStmt                    *sql.Stmt
+	countEnabledEventCreationStmt               *sql.Stmt
+	countEnabledGuildsStmt                      *sql.Stmt
+	countGuildAnnouncementsStmt                 *sql.Stmt
+	countGuildDivisionsStmt                     *sql.Stmt
+	countMatchesStmt                            *sql.Stmt
+	countNotificationsStmt                      *sql.Stmt
+	countResultConfirmationsStmt                *sql.Stmt
+	countSeasonFixturesStmt                     *sql.Stmt
+	countTeamStartersStmt                       *sql.Stmt
+	countTeamSubstitutesStmt                    *sql.Stmt
+	decreaseMatchTeamConfirmedParticipantsStmt  *sql.Stmt
+	deleteAllMatchModeratorsStmt                *sql.Stmt
+	deleteAllMatchStreamersStmt                 *sql.Stmt
+	deleteAllMatchTeamsStmt                     *sql.Stmt
+	deleteAnnouncementStmt                      *sql.Stmt
+	deleteDivisionStmt                          *sql.Stmt
+	deleteDivisionOverflowCategoriesStmt        *sql.Stmt
+	deleteDivisionTeamStmt                      *sql.Stmt
+	deleteFixtureStmt                           *sql.Stmt
+	deleteGuildConfigStmt                       *sql.Stmt
+	deleteGuildMatchesStmt                      *sql.Stmt
+	deleteGuildRatingHistoryStmt                *sql.Stmt
+	deleteGuildTeamRatingsStmt                  *sql.Stmt
+	deleteMatchStmt                             *sql.Stmt
+	deleteMatchGeneratedNotificationsStmt       *sql.Stmt
+	deleteMatchModeratorStmt                    *sql.Stmt
+	deleteMatchModeratorsStmt                   *sql.Stmt
+	deleteMatchNotificationsStmt                *sql.Stmt
+	deleteMatchStreamerStmt                     *sql.Stmt
+	deleteMatchStreamersStmt                    *sql.Stmt
+	deleteMatchTeamStmt                         *sql.Stmt
+	deleteMessageTemplateStmt                   *sql.Stmt
+	deleteNotificationStmt                      *sql.Stmt
+	deleteOverflowCategoryStmt                  *sql.Stmt
+	deleteParticipationRequirementsStmt         *sql.Stmt
+	deleteRegisteredTeamStmt                    *sql.Stmt
+	deleteRescheduleProposalStmt                *sql.Stmt
+	deleteResultConfirmationsStmt               *sql.Stmt
+	deleteScheduleBoardStmt                     *sql.Stmt
+	deleteScheduleBoardMessagesFromStmt         *sql.Stmt
+	deleteSeasonStmt                            *sql.Stmt
+	deleteSeasonDraftsStmt                      *sql.Stmt
+	deleteStandingsMessageStmt                  *sql.Stmt
+	disableGuildStmt                            *sql.Stmt
+	getAnnouncementStmt                         *sql.Stmt
+	getBracketByNameStmt                        *sql.Stmt
+	getBracketOfFixtureStmt                     *sql.Stmt
+	getDivisionStmt                             *sql.Stmt
+	getDivisionByCategoryStmt                   *sql.Stmt
+	getFirstTeamSubstituteStmt                  *sql.Stmt
+	getFixtureStmt                              *sql.Stmt
+	getFixtureByMatchStmt                       *sql.Stmt
+	getGuildConfigStmt                          *sql.Stmt
+	getGuildConfigByCategoryStmt                *sql.Stmt
+	getGuildLanguageStmt                        *sql.Stmt
+	getGuildOutputModeStmt                      *sql.Stmt
+	getGuildRoleAccessStmt                      *sql.Stmt
+	getGuildUserAccessStmt                      *sql.Stmt
+	getMatchStmt                                *sql.Stmt
+	getMatchByChannelStmt                       *sql.Stmt
+	getMatchByNumberStmt                        *sql.Stmt
+	getMatchTeamStmt                            *sql.Stmt
+	getMatchTeamByRolesStmt                     *sql.Stmt
+	getMessageTemplateStmt                      *sql.Stmt
+	getNotificationByOffsetStmt                 *sql.Stmt
+	getOverflowCategoryStmt                     *sql.Stmt
+	getParticipantStmt                          *sql.Stmt
+	getParticipationRequirementsStmt            *sql.Stmt
+	getParticipationRequirementsByChannelStmt   *sql.Stmt
+	getRegisteredTeamStmt                       *sql.Stmt
+	getRegisteredTeamByNameStmt                 *sql.Stmt
+	getRescheduleProposalStmt                   *sql.Stmt
+	getRescheduleProposalByMessageStmt          *sql.Stmt
+	getResultStmt                               *sql.Stmt
+	getResultByMessageStmt                      *sql.Stmt
+	getScheduleBoardStmt                        *sql.Stmt
+	getSeasonDraftStmt                          *sql.Stmt
+	getStandingsMessageStmt                     *sql.Stmt
+	getSwissTournamentByNameStmt                *sql.Stmt
+	getTeamDivisionStmt                         *sql.Stmt
+	getTeamRatingStmt                           *sql.Stmt
+	hasRoleAccessStmt                           *sql.Stmt
+	hasUserAccessStmt                           *sql.Stmt
+	increaseMatchTeamConfirmedParticipantsStmt  *sql.Stmt
+	isGuildEnabledStmt                          *sql.Stmt
+	isMatchCaptainStmt                          *sql.Stmt
+	isMatchModeratorStmt                        *sql.Stmt
+	isTeamCaptainStmt                           *sql.Stmt
+	listBracketSlotsStmt                        *sql.Stmt
+	listConfirmedSeasonsWithoutMatchesStmt      *sql.Stmt
+	listDivisionFinalTeamResultsStmt            *sql.Stmt
+	listDivisionTeamRoleIDsStmt                 *sql.Stmt
+	listEnabledScheduleBoardsStmt               *sql.Stmt
+	listFixtureTeamsStmt                        *sql.Stmt
+	listGuildAnnouncementNamesStmt              *sql.Stmt
+	listGuildAnnouncementsStmt                  *sql.Stmt
+	listGuildBracketNamesStmt                   *sql.Stmt
+	listGuildDivisionNamesStmt                  *sql.Stmt
+	listGuildDivisionsStmt                      *sql.Stmt
+	listGuildFinalTeamResultsStmt               *sql.Stmt
+	listGuildMatchesStmt                        *sql.Stmt
+	listGuildMatchesScheduledBetweenStmt        *sql.Stmt
+	listGuildRoleAccessStmt                     *sql.Stmt
+	listGuildSwissTournamentNamesStmt           *sql.Stmt
+	listGuildTeamRatingsStmt                    *sql.Stmt
+	listGuildUserAccessStmt                     *sql.Stmt
+	listMatchCaptainRolesStmt                   *sql.Stmt
+	listMatchModeratorsStmt                     *sql.Stmt
+	listMatchStreamersStmt                      *sql.Stmt
+	listMatchTeamCaptainsStmt                   *sql.Stmt
+	listMatchTeamsStmt                          *sql.Stmt
+	listMessageTemplatesStmt                    *sql.Stmt
+	listNotificationsStmt                       *sql.Stmt
+	listNowAccessibleChannelsStmt               *sql.Stmt
+	listNowDeletableChannelsStmt                *sql.Stmt
+	listNowDueAnnouncementsStmt                 *sql.Stmt
+	listNowDueNotificationsStmt                 *sql.Stmt
+	listNowDueParticipationRequirementsStmt     *sql.Stmt
+	listOpenLegacyParticipationRequirementsStmt *sql.Stmt
+	listOverflowCategoriesStmt                  *sql.Stmt
+	listParticipantsStmt                        *sql.Stmt
+	listRegisteredTeamsStmt                     *sql.Stmt
+	listRegisteredTeamsByRolesStmt              *sql.Stmt
+	listScheduleBoardMessagesStmt               *sql.Stmt
+	listSeasonFixtureResultsStmt                *sql.Stmt
+	listSeasonFixturesStmt                      *sql.Stmt
+	listSeasonFixturesWithoutMatchStmt          *sql.Stmt
+	listSeasonMatchIDsWithoutChannelStmt        *sql.Stmt
+	listSwissByesStmt                           *sql.Stmt
+	listSwissTeamsStmt                          *sql.Stmt
+	listTeamMembersStmt                         *sql.Stmt
+	listTeamRatingHistoryStmt                   *sql.Stmt
+	listTeamResultsStmt                         *sql.Stmt
+	nextAccessibleChannelStmt                   *sql.Stmt
+	nextAnnouncementStmt                        *sql.Stmt
+	nextDeletableChannelStmt                    *sql.Stmt
+	nextMatchCounterStmt                        *sql.Stmt
+	nextNotificationStmt                        *sql.Stmt
+	nextParticipationRequirementStmt            *sql.Stmt
+	promoteSubstituteStmt                       *sql.Stmt
+	removeGuildRoleAccessStmt                   *sql.Stmt
+	removeGuildUserAccessStmt                   *sql.Stmt
+	removeParticipantStmt                       *sql.Stmt
+	removeTeamMemberStmt                        *sql.Stmt
+	rescheduleMatchStmt                         *sql.Stmt
+	resetEventIDStmt                            *sql.Stmt
+	resetMatchChannelStmt                       *sql.Stmt
+	resetTeamCaptainsStmt                       *sql.Stmt
+	setGuildChannelAccessOffsetStmt             *sql.Stmt
+	setGuildChannelDeleteOffsetStmt             *sql.Stmt
+	setGuildEnabledStmt                         *sql.Stmt
+	setGuildEventCreationEnabledStmt            *sql.Stmt
+	setGuildNotificationOffsetsStmt             *sql.Stmt
+	setGuildRequirementsOffsetStmt              *sql.Stmt
+	setMatchTeamConfirmedParticipantsStmt       *sql.Stmt
+	setMessageTemplateStmt                      *sql.Stmt
+	updateBracketModeratorTurnStmt              *sql.Stmt
+	updateCategoryIdStmt                        *sql.Stmt
+	updateDivisionCategoryIdStmt                *sql.Stmt
+	updateFixtureMatchStmt                      *sql.Stmt
+	updateGuildConfigStmt                       *sql.Stmt
+	updateMatchChannelStmt                      *sql.Stmt
+	updateMatchChannelAccessibilityStmt         *sql.Stmt
+	updateMatchEventIDStmt                      *sql.Stmt
+	updateParticipationRequirementsStmt         *sql.Stmt
+	updateRegisteredTeamStmt                    *sql.Stmt
+	updateRescheduleProposalMessageStmt         *sql.Stmt
+	updateResultMessageStmt                     *sql.Stmt
+	updateResultStatusStmt                      *sql.Stmt
+	updateSwissTournamentRoundStmt              *sql.Stmt
 }
 
 func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 	return &Queries{
-		db:                                         tx,
-		tx:                                         tx,
-		addAnnouncementStmt:                        q.addAnnouncementStmt,
-		addGuildConfigStmt:                         q.addGuildConfigStmt,
-		addGuildRoleReadAccessStmt:                 q.addGuildRoleReadAccessStmt,
-		addGuildRoleWriteAccessStmt:                q.addGuildRoleWriteAccessStmt,
-		addGuildUserAccessStmt:                     q.addGuildUserAccessStmt,
-		addGuildUserWriteAccessStmt:                q.addGuildUserWriteAccessStmt,
-		addMatchStmt:                               q.addMatchStmt,
-		addMatchModeratorStmt:                      q.addMatchModeratorStmt,
-		addMatchStreamerStmt:                       q.addMatchStreamerStmt,
-		addMatchTeamStmt:                           q.addMatchTeamStmt,
-		addMatchTeamResultsStmt:                    q.addMatchTeamResultsStmt,
-		addNotificationStmt:                        q.addNotificationStmt,
-		addParticipationRequirementsStmt:           q.addParticipationRequirementsStmt,
-		closeParticipationEntryStmt:                q.closeParticipationEntryStmt,
-		continueAnnouncementStmt:                   q.continueAnnouncementStmt,
-		continueAnnouncementsStmt:                  q.continueAnnouncementsStmt,
-		countAllMatchesStmt:                        q.countAllMatchesStmt,
-		countAllNotificationsStmt:                  q.countAllNotificationsStmt,
-		countAnnouncementsStmt:                     q.countAnnouncementsStmt,
-		countDisabledGuildsStmt:                    q.countDisabledGuildsStmt,
-		countEnabledEventCreationStmt:              q.countEnabledEventCreationStmt,
-		countEnabledGuildsStmt:                     q.countEnabledGuildsStmt,
-		countMatchesStmt:                           q.countMatchesStmt,
-		countNotificationsStmt:                     q.countNotificationsStmt,
-		decreaseMatchTeamConfirmedParticipantsStmt: q.decreaseMatchTeamConfirmedParticipantsStmt,
-		deleteAllMatchModeratorsStmt:               q.deleteAllMatchModeratorsStmt,
-		deleteAllMatchStreamersStmt:                q.deleteAllMatchStreamersStmt,
-		deleteAllMatchTeamsStmt:                    q.deleteAllMatchTeamsStmt,
-		deleteAnnouncementStmt:                     q.deleteAnnouncementStmt,
-		deleteGuildConfigStmt:                      q.deleteGuildConfigStmt,
-		deleteGuildMatchesStmt:                     q.deleteGuildMatchesStmt,
-		deleteMatchStmt:                            q.deleteMatchStmt,
-		deleteMatchListStmt:                        q.deleteMatchListStmt,
-		deleteMatchModeratorStmt:                   q.deleteMatchModeratorStmt,
-		deleteMatchModeratorsStmt:                  q.deleteMatchModeratorsStmt,
-		deleteMatchNotificationsStmt:               q.deleteMatchNotificationsStmt,
-		deleteMatchStreamerStmt:                    q.deleteMatchStreamerStmt,
-		deleteMatchStreamersStmt:                   q.deleteMatchStreamersStmt,
-		deleteMatchTeamStmt:                        q.deleteMatchTeamStmt,
-		deleteNotificationStmt:                     q.deleteNotificationStmt,
-		deleteParticipationRequirementsStmt:        q.deleteParticipationRequirementsStmt,
-		disableGuildStmt:                           q.disableGuildStmt,
-		getAnnouncementStmt:                        q.getAnnouncementStmt,
-		getGuildConfigStmt:                         q.getGuildConfigStmt,
-		getGuildConfigByCategoryStmt:               q.getGuildConfigByCategoryStmt,
-		getGuildRoleAccessStmt:                     q.getGuildRoleAccessStmt,
-		getGuildUserAccessStmt:                     q.getGuildUserAccessStmt,
-		getMatchStmt:                               q.getMatchStmt,
-		getMatchTeamStmt:                           q.getMatchTeamStmt,
-		getMatchTeamByRolesStmt:                    q.getMatchTeamByRolesStmt,
-		getNotificationByOffsetStmt:                q.getNotificationByOffsetStmt,
-		getParticipationRequirementsStmt:           q.getParticipationRequirementsStmt,
-		hasRoleAccessStmt:                          q.hasRoleAccessStmt,
-		hasUserAccessStmt:                          q.hasUserAccessStmt,
-		increaseMatchTeamConfirmedParticipantsStmt: q.increaseMatchTeamConfirmedParticipantsStmt,
-		isGuildEnabledStmt:                         q.isGuildEnabledStmt,
-		listGuildMatchesStmt:                       q.listGuildMatchesStmt,
-		listGuildMatchesScheduledBetweenStmt:       q.listGuildMatchesScheduledBetweenStmt,
-		listGuildRoleAccessStmt:                    q.listGuildRoleAccessStmt,
-		listGuildUserAccessStmt:                    q.listGuildUserAccessStmt,
-		listMatchModeratorsStmt:                    q.listMatchModeratorsStmt,
-		listMatchStreamersStmt:                     q.listMatchStreamersStmt,
-		listMatchTeamsStmt:                         q.listMatchTeamsStmt,
-		listNotificationsStmt:                      q.listNotificationsStmt,
-		listNowAccessibleChannelsStmt:              q.listNowAccessibleChannelsStmt,
-		listNowDeletableChannelsStmt:               q.listNowDeletableChannelsStmt,
-		listNowDueAnnouncementsStmt:                q.listNowDueAnnouncementsStmt,
-		listNowDueNotificationsStmt:                q.listNowDueNotificationsStmt,
-		listNowDueParticipationRequirementsStmt:    q.listNowDueParticipationRequirementsStmt,
-		nextAccessibleChannelStmt:                  q.nextAccessibleChannelStmt,
-		nextAnnouncementStmt:                       q.nextAnnouncementStmt,
-		nextDeletableChannelStmt:                   q.nextDeletableChannelStmt,
-		nextMatchCounterStmt:                       q.nextMatchCounterStmt,
-		nextNotificationStmt:                       q.nextNotificationStmt,
-		nextParticipationRequirementStmt:           q.nextParticipationRequirementStmt,
-		removeGuildRoleAccessStmt:                  q.removeGuildRoleAccessStmt,
-		removeGuildUserAccessStmt:                  q.removeGuildUserAccessStmt,
-		rescheduleMatchStmt:                        q.rescheduleMatchStmt,
-		resetEventIDStmt:                           q.resetEventIDStmt,
-		setGuildChannelAccessOffsetStmt:            q.setGuildChannelAccessOffsetStmt,
-		setGuildChannelDeleteOffsetStmt:            q.setGuildChannelDeleteOffsetStmt,
-		setGuildEnabledStmt:                        q.setGuildEnabledStmt,
-		setGuildEventCreationEnabledStmt:           q.setGuildEventCreationEnabledStmt,
-		setGuildNotificationOffsetsStmt:            q.setGuildNotificationOffsetsStmt,
-		setGuildRequirementsOffsetStmt:             q.setGuildRequirementsOffsetStmt,
-		updateCategoryIdStmt:                       q.updateCategoryIdStmt,
-		updateGuildConfigStmt:                      q.updateGuildConfigStmt,
-		updateMatchChannelAccessibilityStmt:        q.updateMatchChannelAccessibilityStmt,
-		updateMatchEventIDStmt:                     q.updateMatchEventIDStmt,
-		updateParticipationRequirementsStmt:        q.updateParticipationRequirementsStmt,
+		db:                                          tx,
+		tx:                                          tx,
+		addAnnouncementStmt:                         q.addAnnouncementStmt,
+		addBracketStmt:                              q.addBracketStmt,
+		addBracketSlotStmt:                          q.addBracketSlotStmt,
+		addDivisionStmt:                             q.addDivisionStmt,
+		addDivisionTeamStmt:                         q.addDivisionTeamStmt,
+		addFixtureStmt:                              q.addFixtureStmt,
+		addFixtureTeamStmt:                          q.addFixtureTeamStmt,
+		addGuildConfigStmt:                          q.addGuildConfigStmt,
+		addGuildRoleReadAccessStmt:                  q.addGuildRoleReadAccessStmt,
+		addGuildRoleWriteAccessStmt:                 q.addGuildRoleWriteAccessStmt,
+		addGuildUserAccessStmt:                      q.addGuildUserAccessStmt,
+		addGuildUserWriteAccessStmt:                 q.addGuildUserWriteAccessStmt,
+		addMatchStmt:                                q.addMatchStmt,
+		addMatchModeratorStmt:                       q.addMatchModeratorStmt,
+		addMatchStreamerStmt:                        q.addMatchStreamerStmt,
+		addMatchTeamStmt:                            q.addMatchTeamStmt,
+		addNotificationStmt:                         q.addNotificationStmt,
+		addOverflowCategoryStmt:                     q.addOverflowCategoryStmt,
+		addParticipantStmt:                          q.addParticipantStmt,
+		addParticipationRequirementsStmt:            q.addParticipationRequirementsStmt,
+		addRatingHistoryStmt:                        q.addRatingHistoryStmt,
+		addRegisteredTeamStmt:                       q.addRegisteredTeamStmt,
+		addRescheduleProposalStmt:                   q.addRescheduleProposalStmt,
+		addResultStmt:                               q.addResultStmt,
+		addResultConfirmationStmt:                   q.addResultConfirmationStmt,
+		addScheduleBoardStmt:                        q.addScheduleBoardStmt,
+		addScheduleBoardMessageStmt:                 q.addScheduleBoardMessageStmt,
+		addSeasonStmt:                               q.addSeasonStmt,
+		addStandingsMessageStmt:                     q.addStandingsMessageStmt,
+		addSwissByeStmt:                             q.addSwissByeStmt,
+		addSwissTeamStmt:                            q.addSwissTeamStmt,
+		addSwissTournamentStmt:                      q.addSwissTournamentStmt,
+		addTeamCaptainStmt:                          q.addTeamCaptainStmt,
+		addTeamMemberStmt:                           q.addTeamMemberStmt,
+		addTeamRatingStmt:                           q.addTeamRatingStmt,
+		addTeamResultStmt:                           q.addTeamResultStmt,
+		archiveMatchListStmt:                        q.archiveMatchListStmt,
+		cancelMatchStmt:                             q.cancelMatchStmt,
+		closeParticipationEntryStmt:                 q.closeParticipationEntryStmt,
+		confirmSeasonStmt:                           q.confirmSeasonStmt,
+		continueAnnouncementStmt:                    q.continueAnnouncementStmt,
+		countAllMatchesStmt:                         q.countAllMatchesStmt,
+		countAllNotificationsStmt:                   q.countAllNotificationsStmt,
+		countAnnouncementsStmt:                      q.countAnnouncementsStmt,
+		countDisabledGuildsStmt:                     q.countDisabledGuildsStmt,
+		countDivisionMatchesStmt:                    q.countDivisionMatchesStmt,
+		countEnabledEventCreationStmt:               q.countEnabledEventCreationStmt,
+		countEnabledGuildsStmt:                      q.countEnabledGuildsStmt,
+		countGuildAnnouncementsStmt:                 q.countGuildAnnouncementsStmt,
+		countGuildDivisionsStmt:                     q.countGuildDivisionsStmt,
+		countMatchesStmt:                            q.countMatchesStmt,
+		countNotificationsStmt:                      q.countNotificationsStmt,
+		countResultConfirmationsStmt:                q.countResultConfirmationsStmt,
+		countSeasonFixturesStmt:                     q.countSeasonFixturesStmt,
+		countTeamStartersStmt:                       q.countTeamStartersStmt,
+		countTeamSubstitutesStmt:                    q.countTeamSubstitutesStmt,
+		decreaseMatchTeamConfirmedParticipantsStmt:  q.decreaseMatchTeamConfirmedParticipantsStmt,
+		deleteAllMatchModeratorsStmt:                q.deleteAllMatchModeratorsStmt,
+		deleteAllMatchStreamersStmt:                 q.deleteAllMatchStreamersStmt,
+		deleteAllMatchTeamsStmt:                     q.deleteAllMatchTeamsStmt,
+		deleteAnnouncementStmt:                      q.deleteAnnouncementStmt,
+		deleteDivisionStmt:                          q.deleteDivisionStmt,
+		deleteDivisionOverflowCategoriesStmt:        q.deleteDivisionOverflowCategoriesStmt,
+		deleteDivisionTeamStmt:                      q.deleteDivisionTeamStmt,
+		deleteFixtureStmt:                           q.deleteFixtureStmt,
+		deleteGuildConfigStmt:                       q.deleteGuildConfigStmt,
+		deleteGuildMatchesStmt:                      q.deleteGuildMatchesStmt,
+		deleteGuildRatingHistoryStmt:                q.deleteGuildRatingHistoryStmt,
+		deleteGuildTeamRatingsStmt:                  q.deleteGuildTeamRatingsStmt,
+		deleteMatchStmt:                             q.deleteMatchStmt,
+		deleteMatchGeneratedNotificationsStmt:       q.deleteMatchGeneratedNotificationsStmt,
+		deleteMatchModeratorStmt:                    q.deleteMatchModeratorStmt,
+		deleteMatchModeratorsStmt:                   q.deleteMatchModeratorsStmt,
+		deleteMatchNotificationsStmt:                q.deleteMatchNotificationsStmt,
+		deleteMatchStreamerStmt:                     q.deleteMatchStreamerStmt,
+		deleteMatchStreamersStmt:                    q.deleteMatchStreamersStmt,
+		deleteMatchTeamStmt:                         q.deleteMatchTeamStmt,
+		deleteMessageTemplateStmt:                   q.deleteMessageTemplateStmt,
+		deleteNotificationStmt:                      q.deleteNotificationStmt,
+		deleteOverflowCategoryStmt:                  q.deleteOverflowCategoryStmt,
+		deleteParticipationRequirementsStmt:         q.deleteParticipationRequirementsStmt,
+		deleteRegisteredTeamStmt:                    q.deleteRegisteredTeamStmt,
+		deleteRescheduleProposalStmt:                q.deleteRescheduleProposalStmt,
+		deleteResultConfirmationsStmt:               q.deleteResultConfirmationsStmt,
+		deleteScheduleBoardStmt:                     q.deleteScheduleBoardStmt,
+		deleteScheduleBoardMessagesFromStmt:         q.deleteScheduleBoardMessagesFromStmt,
+		deleteSeasonStmt:                            q.deleteSeasonStmt,
+		deleteSeasonDraftsStmt:                      q.deleteSeasonDraftsStmt,
+		deleteStandingsMessageStmt:                  q.deleteStandingsMessageStmt,
+		disableGuildStmt:                            q.disableGuildStmt,
+		getAnnouncementStmt:                         q.getAnnouncementStmt,
+		getBracketByNameStmt:                        q.getBracketByNameStmt,
+		getBracketOfFixtureStmt:                     q.getBracketOfFixtureStmt,
+		getDivisionStmt:                             q.getDivisionStmt,
+		getDivisionByCategoryStmt:                   q.getDivisionByCategoryStmt,
+		getFirstTeamSubstituteStmt:                  q.getFirstTeamSubstituteStmt,
+		getFixtureStmt:                              q.getFixtureStmt,
+		getFixtureByMatchStmt:                       q.getFixtureByMatchStmt,
+		getGuildConfigStmt:                          q.getGuildConfigStmt,
+		getGuildConfigByCategoryStmt:                q.getGuildConfigByCategoryStmt,
+		getGuildLanguageStmt:                        q.getGuildLanguageStmt,
+		getGuildOutputModeStmt:                      q.getGuildOutputModeStmt,
+		getGuildRoleAccessStmt:                      q.getGuildRoleAccessStmt,
+		getGuildUserAccessStmt:                      q.getGuildUserAccessStmt,
+		getMatchStmt:                                q.getMatchStmt,
+		getMatchByChannelStmt:                       q.getMatchByChannelStmt,
+		getMatchByNumberStmt:                        q.getMatchByNumberStmt,
+		getMatchTeamStmt:                            q.getMatchTeamStmt,
+		getMatchTeamByRolesStmt:                     q.getMatchTeamByRolesStmt,
+		getMessageTemplateStmt:                      q.getMessageTemplateStmt,
+		getNotificationByOffsetStmt:                 q.getNotificationByOffsetStmt,
+		getOverflowCategoryStmt:                     q.getOverflowCategoryStmt,
+		getParticipantStmt:                          q.getParticipantStmt,
+		getParticipationRequirementsStmt:            q.getParticipationRequirementsStmt,
+		getParticipationRequirementsByChannelStmt:   q.getParticipationRequirementsByChannelStmt,
+		getRegisteredTeamStmt:                       q.getRegisteredTeamStmt,
+		getRegisteredTeamByNameStmt:                 q.getRegisteredTeamByNameStmt,
+		getRescheduleProposalStmt:                   q.getRescheduleProposalStmt,
+		getRescheduleProposalByMessageStmt:          q.getRescheduleProposalByMessageStmt,
+		getResultStmt:                               q.getResultStmt,
+		getResultByMessageStmt:                      q.getResultByMessageStmt,
+		getScheduleBoardStmt:                        q.getScheduleBoardStmt,
+		getSeasonDraftStmt:                          q.getSeasonDraftStmt,
+		getStandingsMessageStmt:                     q.getStandingsMessageStmt,
+		getSwissTournamentByNameStmt:                q.getSwissTournamentByNameStmt,
+		getTeamDivisionStmt:                         q.getTeamDivisionStmt,
+		getTeamRatingStmt:                           q.getTeamRatingStmt,
+		hasRoleAccessStmt:                           q.hasRoleAccessStmt,
+		hasUserAccessStmt:                           q.hasUserAccessStmt,
+		increaseMatchTeamConfirmedParticipantsStmt:  q.increaseMatchTeamConfirmedParticipantsStmt,
+		isGuildEnabledStmt:                          q.isGuildEnabledStmt,
+		isMatchCaptainStmt:                          q.isMatchCaptainStmt,
+		isMatchModeratorStmt:                        q.isMatchModeratorStmt,
+		isTeamCaptainStmt:                           q.isTeamCaptainStmt,
+		listBracketSlotsStmt:                        q.listBracketSlotsStmt,
+		listConfirmedSeasonsWithoutMatchesStmt:      q.listConfirmedSeasonsWithoutMatchesStmt,
+		listDivisionFinalTeamResultsStmt:            q.listDivisionFinalTeamResultsStmt,
+		listDivisionTeamRoleIDsStmt:                 q.listDivisionTeamRoleIDsStmt,
+		listEnabledScheduleBoardsStmt:               q.listEnabledScheduleBoardsStmt,
+		listFixtureTeamsStmt:                        q.listFixtureTeamsStmt,
+		listGuildAnnouncementNamesStmt:              q.listGuildAnnouncementNamesStmt,
+		listGuildAnnouncementsStmt:                  q.listGuildAnnouncementsStmt,
+		listGuildBracketNamesStmt:                   q.listGuildBracketNamesStmt,
+		listGuildDivisionNamesStmt:                  q.listGuildDivisionNamesStmt,
+		listGuildDivisionsStmt:                      q.listGuildDivisionsStmt,
+		listGuildFinalTeamResultsStmt:               q.listGuildFinalTeamResultsStmt,
+		listGuildMatchesStmt:                        q.listGuildMatchesStmt,
+		listGuildMatchesScheduledBetweenStmt:        q.listGuildMatchesScheduledBetweenStmt,
+		listGuildRoleAccessStmt:                     q.listGuildRoleAccessStmt,
+		listGuildSwissTournamentNamesStmt:           q.listGuildSwissTournamentNamesStmt,
+		listGuildTeamRatingsStmt:                    q.listGuildTeamRatingsStmt,
+		listGuildUserAccessStmt:                     q.listGuildUserAccessStmt,
+		listMatchCaptainRolesStmt:                   q.listMatchCaptainRolesStmt,
+		listMatchModeratorsStmt:                     q.listMatchModeratorsStmt,
+		listMatchStreamersStmt:                      q.listMatchStreamersStmt,
+		listMatchTeamCaptainsStmt:                   q.listMatchTeamCaptainsStmt,
+		listMatchTeamsStmt:                          q.listMatchTeamsStmt,
+		listMessageTemplatesStmt:                    q.listMessageTemplatesStmt,
+		listNotificationsStmt:                       q.listNotificationsStmt,
+		listNowAccessibleChannelsStmt:               q.listNowAccessibleChannelsStmt,
+		listNowDeletableChannelsStmt:                q.listNowDeletableChannelsStmt,
+		listNowDueAnnouncementsStmt:                 q.listNowDueAnnouncementsStmt,
+		listNowDueNotificationsStmt:                 q.listNowDueNotificationsStmt,
+		listNowDueParticipationRequirementsStmt:     q.listNowDueParticipationRequirementsStmt,
+		listOpenLegacyParticipationRequirementsStmt: q.listOpenLegacyParticipationRequirementsStmt,
+		listOverflowCategoriesStmt:                  q.listOverflowCategoriesStmt,
+		listParticipantsStmt:                        q.listParticipantsStmt,
+		listRegisteredTeamsStmt:                     q.listRegisteredTeamsStmt,
+		listRegisteredTeamsByRolesStmt:              q.listRegisteredTeamsByRolesStmt,
+		listScheduleBoardMessagesStmt:               q.listScheduleBoardMessagesStmt,
+		listSeasonFixtureResultsStmt:                q.listSeasonFixtureResultsStmt,
+		listSeasonFixturesStmt:                      q.listSeasonFixturesStmt,
+		listSeasonFixturesWithoutMatchStmt:          q.listSeasonFixturesWithoutMatchStmt,
+		listSeasonMatchIDsWithoutChannelStmt:        q.listSeasonMatchIDsWithoutChannelStmt,
+		listSwissByesStmt:                           q.listSwissByesStmt,
+		listSwissTeamsStmt:                          q.listSwissTeamsStmt,
+		listTeamMembersStmt:                         q.listTeamMembersStmt,
+		listTeamRatingHistoryStmt:                   q.listTeamRatingHistoryStmt,
+		listTeamResultsStmt:                         q.listTeamResultsStmt,
+		nextAccessibleChannelStmt:                   q.nextAccessibleChannelStmt,
+		nextAnnouncementStmt:                        q.nextAnnouncementStmt,
+		nextDeletableChannelStmt:                    q.nextDeletableChannelStmt,
+		nextMatchCounterStmt:                        q.nextMatchCounterStmt,
+		nextNotificationStmt:                        q.nextNotificationStmt,
+		nextParticipationRequirementStmt:            q.nextParticipationRequirementStmt,
+		promoteSubstituteStmt:                       q.promoteSubstituteStmt,
+		removeGuildRoleAccessStmt:                   q.removeGuildRoleAccessStmt,
+		removeGuildUserAccessStmt:                   q.removeGuildUserAccessStmt,
+		removeParticipantStmt:                       q.removeParticipantStmt,
+		removeTeamMemberStmt:                        q.removeTeamMemberStmt,
+		rescheduleMatchStmt:                         q.rescheduleMatchStmt,
+		resetEventIDStmt:                            q.resetEventIDStmt,
+		resetMatchChannelStmt:                       q.resetMatchChannelStmt,
+		resetTeamCaptainsStmt:                       q.resetTeamCaptainsStmt,
+		setGuildChannelAccessOffsetStmt:             q.setGuildChannelAccessOffsetStmt,
+		setGuildChannelDeleteOffsetStmt:             q.setGuildChannelDeleteOffsetStmt,
+		setGuildEnabledStmt:                         q.setGuildEnabledStmt,
+		setGuildEventCreationEnabledStmt:            q.setGuildEventCreationEnabledStmt,
+		setGuildNotificationOffsetsStmt:             q.setGuildNotificationOffsetsStmt,
+		setGuildRequirementsOffsetStmt:              q.setGuildRequirementsOffsetStmt,
+		setMatchTeamConfirmedParticipantsStmt:       q.setMatchTeamConfirmedParticipantsStmt,
+		setMessageTemplateStmt:                      q.setMessageTemplateStmt,
+		updateBracketModeratorTurnStmt:              q.updateBracketModeratorTurnStmt,
+		updateCategoryIdStmt:                        q.updateCategoryIdStmt,
+		updateDivisionCategoryIdStmt:                q.updateDivisionCategoryIdStmt,
+		updateFixtureMatchStmt:                      q.updateFixtureMatchStmt,
+		updateGuildConfigStmt:                       q.updateGuildConfigStmt,
+		updateMatchChannelStmt:                      q.updateMatchChannelStmt,
+		updateMatchChannelAccessibilityStmt:         q.updateMatchChannelAccessibilityStmt,
+		updateMatchEventIDStmt:                      q.updateMatchEventIDStmt,
+		updateParticipationRequirementsStmt:         q.updateParticipationRequirementsStmt,
+		updateRegisteredTeamStmt:                    q.updateRegisteredTeamStmt,
+		updateRescheduleProposalMessageStmt:         q.updateRescheduleProposalMessageStmt,
+		updateResultMessageStmt:                     q.updateResultMessageStmt,
+		updateResultStatusStmt:                      q.updateResultStatusStmt,
+		updateSwissTournamentRoundStmt:              q.updateSwissTournamentRoundStmt,
 	}
 }
@@ -0,0 +1,356 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: divisions.sql
+
+package sqlc
+
+import (
+	"context"
+)
+
+const addDivision = `-- name: AddDivision :exec
+INSERT INTO divisions (
+    guild_id,
+    name,
+    category_id,
+    channel_access_offset,
+    requirements_offset,
+    channel_delete_offset,
+    notification_offsets
+) VALUES (
+    ?1,
+    ?2,
+    ?3,
+    ?4,
+    ?5,
+    ?6,
+    ?7
+) ON CONFLICT (guild_id, name) DO UPDATE SET
+    channel_access_offset = excluded.channel_access_offset,
+    requirements_offset = excluded.requirements_offset,
+    channel_delete_offset = excluded.channel_delete_offset,
+    notification_offsets = excluded.notification_offsets
+`
+
+type AddDivisionParams struct {
+	GuildID             string `db:"guild_id"`
+	Name                string `db:"name"`
+	CategoryID          string `db:"category_id"`
+	ChannelAccessOffset int64  `db:"channel_access_offset"`
+	RequirementsOffset  int64  `db:"requirements_offset"`
+	ChannelDeleteOffset int64  `db:"channel_delete_offset"`
+	NotificationOffsets string `db:"notification_offsets"`
+}
+
+func (q *Queries) AddDivision(ctx context.Context, arg AddDivisionParams) error {
+	_, err := q.exec(ctx, q.addDivisionStmt, addDivision,
+		arg.GuildID,
+		arg.Name,
+		arg.CategoryID,
+		arg.ChannelAccessOffset,
+		arg.RequirementsOffset,
+		arg.ChannelDeleteOffset,
+		arg.NotificationOffsets,
+	)
+	return err
+}
+
+const addDivisionTeam = `-- name: AddDivisionTeam :exec
+INSERT OR REPLACE INTO division_teams (
+    guild_id,
+    role_id,
+    division
+) VALUES (
+    ?1,
+    ?2,
+    ?3
+)
+`
+
+type AddDivisionTeamParams struct {
+	GuildID  string `db:"guild_id"`
+	RoleID   string `db:"role_id"`
+	Division string `db:"division"`
+}
+
+func (q *Queries) AddDivisionTeam(ctx context.Context, arg AddDivisionTeamParams) error {
+	_, err := q.exec(ctx, q.addDivisionTeamStmt, addDivisionTeam, arg.GuildID, arg.RoleID, arg.Division)
+	return err
+}
+
+const countDivisionMatches = `-- name: CountDivisionMatches :one
+SELECT COUNT(*)
+FROM matches
+WHERE guild_id = ?1
+AND division = ?2
+AND channel_deleted = 0
+`
+
+type CountDivisionMatchesParams struct {
+	GuildID  string `db:"guild_id"`
+	Division string `db:"division"`
+}
+
+func (q *Queries) CountDivisionMatches(ctx context.Context, arg CountDivisionMatchesParams) (int64, error) {
+	row := q.queryRow(ctx, q.countDivisionMatchesStmt, countDivisionMatches, arg.GuildID, arg.Division)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countGuildDivisions = `-- name: CountGuildDivisions :one
+SELECT COUNT(*)
+FROM divisions
+WHERE guild_id = ?1
+`
+
+func (q *Queries) CountGuildDivisions(ctx context.Context, guildID string) (int64, error) {
+	row := q.queryRow(ctx, q.countGuildDivisionsStmt, countGuildDivisions, guildID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const deleteDivision = `-- name: DeleteDivision :exec
+DELETE FROM divisions
+WHERE guild_id = ?1
+AND name = ?2
+`
+
+type DeleteDivisionParams struct {
+	GuildID string `db:"guild_id"`
+	Name    string `db:"name"`
+}
+
+func (q *Queries) DeleteDivision(ctx context.Context, arg DeleteDivisionParams) error {
+	_, err := q.exec(ctx, q.deleteDivisionStmt, deleteDivision, arg.GuildID, arg.Name)
+	return err
+}
+
+const deleteDivisionTeam = `-- name: DeleteDivisionTeam :exec
+DELETE FROM division_teams
+WHERE guild_id = ?1
+AND role_id = ?2
+`
+
+type DeleteDivisionTeamParams struct {
+	GuildID string `db:"guild_id"`
+	RoleID  string `db:"role_id"`
+}
+
+func (q *Queries) DeleteDivisionTeam(ctx context.Context, arg DeleteDivisionTeamParams) error {
+	_, err := q.exec(ctx, q.deleteDivisionTeamStmt, deleteDivisionTeam, arg.GuildID, arg.RoleID)
+	return err
+}
+
+const getDivision = `-- name: GetDivision :one
+SELECT
+    guild_id,
+    name,
+    category_id,
+    channel_access_offset,
+    requirements_offset,
+    channel_delete_offset,
+    notification_offsets
+FROM divisions
+WHERE guild_id = ?1
+AND name = ?2
+`
+
+type GetDivisionParams struct {
+	GuildID string `db:"guild_id"`
+	Name    string `db:"name"`
+}
+
+func (q *Queries) GetDivision(ctx context.Context, arg GetDivisionParams) (Division, error) {
+	row := q.queryRow(ctx, q.getDivisionStmt, getDivision, arg.GuildID, arg.Name)
+	var i Division
+	err := row.Scan(
+		&i.GuildID,
+		&i.Name,
+		&i.CategoryID,
+		&i.ChannelAccessOffset,
+		&i.RequirementsOffset,
+		&i.ChannelDeleteOffset,
+		&i.NotificationOffsets,
+	)
+	return i, err
+}
+
+const getDivisionByCategory = `-- name: GetDivisionByCategory :one
+SELECT
+    guild_id,
+    name,
+    category_id,
+    channel_access_offset,
+    requirements_offset,
+    channel_delete_offset,
+    notification_offsets
+FROM divisions
+WHERE category_id = ?1
+`
+
+func (q *Queries) GetDivisionByCategory(ctx context.Context, categoryID string) (Division, error) {
+	row := q.queryRow(ctx, q.getDivisionByCategoryStmt, getDivisionByCategory, categoryID)
+	var i Division
+	err := row.Scan(
+		&i.GuildID,
+		&i.Name,
+		&i.CategoryID,
+		&i.ChannelAccessOffset,
+		&i.RequirementsOffset,
+		&i.ChannelDeleteOffset,
+		&i.NotificationOffsets,
+	)
+	return i, err
+}
+
+const getTeamDivision = `-- name: GetTeamDivision :one
+SELECT division
+FROM division_teams
+WHERE guild_id = ?1
+AND role_id = ?2
+`
+
+type GetTeamDivisionParams struct {
+	GuildID string `db:"guild_id"`
+	RoleID  string `db:"role_id"`
+}
+
+func (q *Queries) GetTeamDivision(ctx context.Context, arg GetTeamDivisionParams) (string, error) {
+	row := q.queryRow(ctx, q.getTeamDivisionStmt, getTeamDivision, arg.GuildID, arg.RoleID)
+	var division string
+	err := row.Scan(&division)
+	return division, err
+}
+
+const listDivisionTeamRoleIDs = `-- name: ListDivisionTeamRoleIDs :many
+SELECT role_id
+FROM division_teams
+WHERE guild_id = ?1
+AND division = ?2
+ORDER BY role_id
+`
+
+type ListDivisionTeamRoleIDsParams struct {
+	GuildID  string `db:"guild_id"`
+	Division string `db:"division"`
+}
+
+func (q *Queries) ListDivisionTeamRoleIDs(ctx context.Context, arg ListDivisionTeamRoleIDsParams) ([]string, error) {
+	rows, err := q.query(ctx, q.listDivisionTeamRoleIDsStmt, listDivisionTeamRoleIDs, arg.GuildID, arg.Division)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var role_id string
+		if err := rows.Scan(&role_id); err != nil {
+			return nil, err
+		}
+		items = append(items, role_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listGuildDivisionNames = `-- name: ListGuildDivisionNames :many
+SELECT name
+FROM divisions
+WHERE guild_id = ?1
+ORDER BY name
+`
+
+func (q *Queries) ListGuildDivisionNames(ctx context.Context, guildID string) ([]string, error) {
+	rows, err := q.query(ctx, q.listGuildDivisionNamesStmt, listGuildDivisionNames, guildID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		items = append(items, name)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listGuildDivisions = `-- name: ListGuildDivisions :many
+SELECT
+    guild_id,
+    name,
+    category_id,
+    channel_access_offset,
+    requirements_offset,
+    channel_delete_offset,
+    notification_offsets
+FROM divisions
+WHERE guild_id = ?1
+ORDER BY name
+`
+
+func (q *Queries) ListGuildDivisions(ctx context.Context, guildID string) ([]Division, error) {
+	rows, err := q.query(ctx, q.listGuildDivisionsStmt, listGuildDivisions, guildID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Division{}
+	for rows.Next() {
+		var i Division
+		if err := rows.Scan(
+			&i.GuildID,
+			&i.Name,
+			&i.CategoryID,
+			&i.ChannelAccessOffset,
+			&i.RequirementsOffset,
+			&i.ChannelDeleteOffset,
+			&i.NotificationOffsets,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateDivisionCategoryId = `-- name: UpdateDivisionCategoryId :exec
+UPDATE divisions
+SET
+    category_id = ?1
+WHERE guild_id = ?2
+AND name = ?3
+`
+
+type UpdateDivisionCategoryIdParams struct {
+	CategoryID string `db:"category_id"`
+	GuildID    string `db:"guild_id"`
+	Name       string `db:"name"`
+}
+
+func (q *Queries) UpdateDivisionCategoryId(ctx context.Context, arg UpdateDivisionCategoryIdParams) error {
+	_, err := q.exec(ctx, q.updateDivisionCategoryIdStmt, updateDivisionCategoryId, arg.CategoryID, arg.GuildID, arg.Name)
+	return err
+}
@@ -126,7 +126,12 @@ SELECT
     event_creation_enabled,
     channel_delete_offset,
     requirements_offset,
-    notification_offsets
+    notification_offsets,
+    points_win,
+    points_draw,
+    points_loss,
+    language,
+    output_mode
 FROM guild_config
 WHERE guild_id = ?1
 `
@@ -140,6 +145,11 @@ type GetGuildConfigRow struct {
 	ChannelDeleteOffset  int64  `db:"channel_delete_offset"`
 	RequirementsOffset   int64  `db:"requirements_offset"`
 	NotificationOffsets  string `db:"notification_offsets"`
+	PointsWin            int64  `db:"points_win"`
+	PointsDraw           int64  `db:"points_draw"`
+	PointsLoss           int64  `db:"points_loss"`
+	Language             string `db:"language"`
+	OutputMode           string `db:"output_mode"`
 }
 
 func (q *Queries) GetGuildConfig(ctx context.Context, guildID string) (GetGuildConfigRow, error) {
@@ -154,6 +164,11 @@ func (q *Queries) GetGuildConfig(ctx context.Context, guildID string) (GetGuildC
 		&i.ChannelDeleteOffset,
 		&i.RequirementsOffset,
 		&i.NotificationOffsets,
+		&i.PointsWin,
+		&i.PointsDraw,
+		&i.PointsLoss,
+		&i.Language,
+		&i.OutputMode,
 	)
 	return i, err
 }
@@ -200,6 +215,32 @@ func (q *Queries) GetGuildConfigByCategory(ctx context.Context, categoryID strin
 	return i, err
 }
 
+const getGuildLanguage = `-- name: GetGuildLanguage :one
+SELECT language
+FROM guild_config
+WHERE guild_id = ?1
+`
+
+func (q *Queries) GetGuildLanguage(ctx context.Context, guildID string) (string, error) {
+	row := q.queryRow(ctx, q.getGuildLanguageStmt, getGuildLanguage, guildID)
+	var language string
+	err := row.Scan(&language)
+	return language, err
+}
+
+const getGuildOutputMode = `-- name: GetGuildOutputMode :one
+SELECT output_mode
+FROM guild_config
+WHERE guild_id = ?1
+`
+
+func (q *Queries) GetGuildOutputMode(ctx context.Context, guildID string) (string, error) {
+	row := q.queryRow(ctx, q.getGuildOutputModeStmt, getGuildOutputMode, guildID)
+	var output_mode string
+	err := row.Scan(&output_mode)
+	return output_mode, err
+}
+
 const isGuildEnabled = `-- name: IsGuildEnabled :one
 SELECT enabled
 FROM guild_config
@@ -348,8 +389,13 @@ SET
     event_creation_enabled = ?3,
     channel_delete_offset = ?4,
     requirements_offset = ?5,
-    notification_offsets = ?6
-WHERE guild_id = ?7
+    notification_offsets = ?6,
+    points_win = ?7,
+    points_draw = ?8,
+    points_loss = ?9,
+    language = ?10,
+    output_mode = ?11
+WHERE guild_id = ?12
 `
 
 type UpdateGuildConfigParams struct {
@@ -359,6 +405,11 @@ type UpdateGuildConfigParams struct {
 	ChannelDeleteOffset  int64  `db:"channel_delete_offset"`
 	RequirementsOffset   int64  `db:"requirements_offset"`
 	NotificationOffsets  string `db:"notification_offsets"`
+	PointsWin            int64  `db:"points_win"`
+	PointsDraw           int64  `db:"points_draw"`
+	PointsLoss           int64  `db:"points_loss"`
+	Language             string `db:"language"`
+	OutputMode           string `db:"output_mode"`
 	GuildID              string `db:"guild_id"`
 }
 
@@ -370,6 +421,11 @@ func (q *Queries) UpdateGuildConfig(ctx context.Context, arg UpdateGuildConfigPa
 		arg.ChannelDeleteOffset,
 		arg.RequirementsOffset,
 		arg.NotificationOffsets,
+		arg.PointsWin,
+		arg.PointsDraw,
+		arg.PointsLoss,
+		arg.Language,
+		arg.OutputMode,
 		arg.GuildID,
 	)
 	return err
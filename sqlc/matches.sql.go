@@ -10,9 +10,10 @@ import (
 	"strings"
 )
 
-const addMatch = `-- name: AddMatch :exec
+const addMatch = `-- name: AddMatch :one
 INSERT INTO matches (
     guild_id,
+    number,
     channel_id,
     channel_accessible_at,
     channel_accessible,
@@ -23,7 +24,8 @@ INSERT INTO matches (
     created_at,
     created_by,
     updated_at,
-    updated_by
+    updated_by,
+    division
 ) VALUES (
     ?1,
     ?2,
@@ -36,12 +38,15 @@ INSERT INTO matches (
     ?9,
     ?10,
     ?11,
-    ?12
-)
+    ?12,
+    ?13,
+    ?14
+) RETURNING match_id
 `
 
 type AddMatchParams struct {
 	GuildID             string `db:"guild_id"`
+	Number              int64  `db:"number"`
 	ChannelID           string `db:"channel_id"`
 	ChannelAccessibleAt int64  `db:"channel_accessible_at"`
 	ChannelAccessible   int64  `db:"channel_accessible"`
@@ -53,11 +58,13 @@ type AddMatchParams struct {
 	CreatedBy           string `db:"created_by"`
 	UpdatedAt           int64  `db:"updated_at"`
 	UpdatedBy           string `db:"updated_by"`
+	Division            string `db:"division"`
 }
 
-func (q *Queries) AddMatch(ctx context.Context, arg AddMatchParams) error {
-	_, err := q.exec(ctx, q.addMatchStmt, addMatch,
+func (q *Queries) AddMatch(ctx context.Context, arg AddMatchParams) (int64, error) {
+	row := q.queryRow(ctx, q.addMatchStmt, addMatch,
 		arg.GuildID,
+		arg.Number,
 		arg.ChannelID,
 		arg.ChannelAccessibleAt,
 		arg.ChannelAccessible,
@@ -69,6 +76,66 @@ func (q *Queries) AddMatch(ctx context.Context, arg AddMatchParams) error {
 		arg.CreatedBy,
 		arg.UpdatedAt,
 		arg.UpdatedBy,
+		arg.Division,
+	)
+	var match_id int64
+	err := row.Scan(&match_id)
+	return match_id, err
+}
+
+const archiveMatchList = `-- name: ArchiveMatchList :exec
+UPDATE matches
+SET
+    channel_id = '',
+    message_id = '',
+    channel_accessible = 1,
+    channel_deleted = 1
+WHERE match_id IN (/*SLICE:match_id*/?)
+`
+
+func (q *Queries) ArchiveMatchList(ctx context.Context, matchID []int64) error {
+	query := archiveMatchList
+	var queryParams []interface{}
+	if len(matchID) > 0 {
+		for _, v := range matchID {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:match_id*/?", strings.Repeat(",?", len(matchID))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:match_id*/?", "NULL", 1)
+	}
+	_, err := q.exec(ctx, nil, query, queryParams...)
+	return err
+}
+
+const cancelMatch = `-- name: CancelMatch :exec
+UPDATE matches
+SET
+    cancelled_at = ?1,
+    cancel_reason = ?2,
+    channel_delete_at = ?3,
+    updated_at = ?4,
+    updated_by = ?5
+WHERE match_id = ?6
+`
+
+type CancelMatchParams struct {
+	CancelledAt     int64  `db:"cancelled_at"`
+	CancelReason    string `db:"cancel_reason"`
+	ChannelDeleteAt int64  `db:"channel_delete_at"`
+	UpdatedAt       int64  `db:"updated_at"`
+	UpdatedBy       string `db:"updated_by"`
+	MatchID         int64  `db:"match_id"`
+}
+
+func (q *Queries) CancelMatch(ctx context.Context, arg CancelMatchParams) error {
+	_, err := q.exec(ctx, q.cancelMatchStmt, cancelMatch,
+		arg.CancelledAt,
+		arg.CancelReason,
+		arg.ChannelDeleteAt,
+		arg.UpdatedAt,
+		arg.UpdatedBy,
+		arg.MatchID,
 	)
 	return err
 }
@@ -89,6 +156,7 @@ const countMatches = `-- name: CountMatches :one
 SELECT COUNT(*) AS count
 FROM matches
 WHERE guild_id = ?1
+AND channel_id != ''
 `
 
 func (q *Queries) CountMatches(ctx context.Context, guildID string) (int64, error) {
@@ -108,142 +176,224 @@ func (q *Queries) DeleteGuildMatches(ctx context.Context, guildID string) error
 }
 
 const deleteMatch = `-- name: DeleteMatch :exec
-DELETE FROM matches WHERE channel_id = ?1
+DELETE FROM matches WHERE match_id = ?1
 `
 
-func (q *Queries) DeleteMatch(ctx context.Context, channelID string) error {
-	_, err := q.exec(ctx, q.deleteMatchStmt, deleteMatch, channelID)
+func (q *Queries) DeleteMatch(ctx context.Context, matchID int64) error {
+	_, err := q.exec(ctx, q.deleteMatchStmt, deleteMatch, matchID)
 	return err
 }
 
-const deleteMatchList = `-- name: DeleteMatchList :exec
-DELETE FROM matches WHERE channel_id IN (/*SLICE:channel_id*/?)
+const getMatch = `-- name: GetMatch :one
+SELECT
+    match_id,
+    guild_id,
+    number,
+    channel_id,
+    channel_accessible,
+    channel_accessible_at,
+    channel_delete_at,
+    message_id,
+    scheduled_at,
+    created_at,
+    created_by,
+    updated_at,
+    updated_by,
+    event_id,
+    cancelled_at,
+    cancel_reason,
+    division,
+    channel_deleted
+FROM matches
+WHERE match_id = ?1
 `
 
-func (q *Queries) DeleteMatchList(ctx context.Context, channelID []string) error {
-	query := deleteMatchList
-	var queryParams []interface{}
-	if len(channelID) > 0 {
-		for _, v := range channelID {
-			queryParams = append(queryParams, v)
-		}
-		query = strings.Replace(query, "/*SLICE:channel_id*/?", strings.Repeat(",?", len(channelID))[1:], 1)
-	} else {
-		query = strings.Replace(query, "/*SLICE:channel_id*/?", "NULL", 1)
-	}
-	_, err := q.exec(ctx, nil, query, queryParams...)
-	return err
+func (q *Queries) GetMatch(ctx context.Context, matchID int64) (Match, error) {
+	row := q.queryRow(ctx, q.getMatchStmt, getMatch, matchID)
+	var i Match
+	err := row.Scan(
+		&i.MatchID,
+		&i.GuildID,
+		&i.Number,
+		&i.ChannelID,
+		&i.ChannelAccessible,
+		&i.ChannelAccessibleAt,
+		&i.ChannelDeleteAt,
+		&i.MessageID,
+		&i.ScheduledAt,
+		&i.CreatedAt,
+		&i.CreatedBy,
+		&i.UpdatedAt,
+		&i.UpdatedBy,
+		&i.EventID,
+		&i.CancelledAt,
+		&i.CancelReason,
+		&i.Division,
+		&i.ChannelDeleted,
+	)
+	return i, err
 }
 
-const getMatch = `-- name: GetMatch :one
+const getMatchByChannel = `-- name: GetMatchByChannel :one
 SELECT
+    match_id,
     guild_id,
+    number,
     channel_id,
-    channel_accessible_at,
     channel_accessible,
+    channel_accessible_at,
     channel_delete_at,
     message_id,
-    event_id,
     scheduled_at,
     created_at,
     created_by,
     updated_at,
-    updated_by
+    updated_by,
+    event_id,
+    cancelled_at,
+    cancel_reason,
+    division,
+    channel_deleted
 FROM matches
 WHERE channel_id = ?1
+AND channel_id != ''
 `
 
-type GetMatchRow struct {
-	GuildID             string `db:"guild_id"`
-	ChannelID           string `db:"channel_id"`
-	ChannelAccessibleAt int64  `db:"channel_accessible_at"`
-	ChannelAccessible   int64  `db:"channel_accessible"`
-	ChannelDeleteAt     int64  `db:"channel_delete_at"`
-	MessageID           string `db:"message_id"`
-	EventID             string `db:"event_id"`
-	ScheduledAt         int64  `db:"scheduled_at"`
-	CreatedAt           int64  `db:"created_at"`
-	CreatedBy           string `db:"created_by"`
-	UpdatedAt           int64  `db:"updated_at"`
-	UpdatedBy           string `db:"updated_by"`
+func (q *Queries) GetMatchByChannel(ctx context.Context, channelID string) (Match, error) {
+	row := q.queryRow(ctx, q.getMatchByChannelStmt, getMatchByChannel, channelID)
+	var i Match
+	err := row.Scan(
+		&i.MatchID,
+		&i.GuildID,
+		&i.Number,
+		&i.ChannelID,
+		&i.ChannelAccessible,
+		&i.ChannelAccessibleAt,
+		&i.ChannelDeleteAt,
+		&i.MessageID,
+		&i.ScheduledAt,
+		&i.CreatedAt,
+		&i.CreatedBy,
+		&i.UpdatedAt,
+		&i.UpdatedBy,
+		&i.EventID,
+		&i.CancelledAt,
+		&i.CancelReason,
+		&i.Division,
+		&i.ChannelDeleted,
+	)
+	return i, err
+}
+
+const getMatchByNumber = `-- name: GetMatchByNumber :one
+SELECT
+    match_id,
+    guild_id,
+    number,
+    channel_id,
+    channel_accessible,
+    channel_accessible_at,
+    channel_delete_at,
+    message_id,
+    scheduled_at,
+    created_at,
+    created_by,
+    updated_at,
+    updated_by,
+    event_id,
+    cancelled_at,
+    cancel_reason,
+    division,
+    channel_deleted
+FROM matches
+WHERE guild_id = ?1
+AND number = ?2
+`
+
+type GetMatchByNumberParams struct {
+	GuildID string `db:"guild_id"`
+	Number  int64  `db:"number"`
 }
 
-func (q *Queries) GetMatch(ctx context.Context, channelID string) (GetMatchRow, error) {
-	row := q.queryRow(ctx, q.getMatchStmt, getMatch, channelID)
-	var i GetMatchRow
+func (q *Queries) GetMatchByNumber(ctx context.Context, arg GetMatchByNumberParams) (Match, error) {
+	row := q.queryRow(ctx, q.getMatchByNumberStmt, getMatchByNumber, arg.GuildID, arg.Number)
+	var i Match
 	err := row.Scan(
+		&i.MatchID,
 		&i.GuildID,
+		&i.Number,
 		&i.ChannelID,
-		&i.ChannelAccessibleAt,
 		&i.ChannelAccessible,
+		&i.ChannelAccessibleAt,
 		&i.ChannelDeleteAt,
 		&i.MessageID,
-		&i.EventID,
 		&i.ScheduledAt,
 		&i.CreatedAt,
 		&i.CreatedBy,
 		&i.UpdatedAt,
 		&i.UpdatedBy,
+		&i.EventID,
+		&i.CancelledAt,
+		&i.CancelReason,
+		&i.Division,
+		&i.ChannelDeleted,
 	)
 	return i, err
 }
 
 const listGuildMatches = `-- name: ListGuildMatches :many
 SELECT
+    match_id,
     guild_id,
+    number,
     channel_id,
-    channel_accessible_at,
     channel_accessible,
+    channel_accessible_at,
     channel_delete_at,
     message_id,
-    event_id,
     scheduled_at,
     created_at,
     created_by,
     updated_at,
-    updated_by
+    updated_by,
+    event_id,
+    cancelled_at,
+    cancel_reason,
+    division,
+    channel_deleted
 FROM matches
 WHERE guild_id = ?1
 ORDER BY scheduled_at ASC
 `
 
-type ListGuildMatchesRow struct {
-	GuildID             string `db:"guild_id"`
-	ChannelID           string `db:"channel_id"`
-	ChannelAccessibleAt int64  `db:"channel_accessible_at"`
-	ChannelAccessible   int64  `db:"channel_accessible"`
-	ChannelDeleteAt     int64  `db:"channel_delete_at"`
-	MessageID           string `db:"message_id"`
-	EventID             string `db:"event_id"`
-	ScheduledAt         int64  `db:"scheduled_at"`
-	CreatedAt           int64  `db:"created_at"`
-	CreatedBy           string `db:"created_by"`
-	UpdatedAt           int64  `db:"updated_at"`
-	UpdatedBy           string `db:"updated_by"`
-}
-
-func (q *Queries) ListGuildMatches(ctx context.Context, guildID string) ([]ListGuildMatchesRow, error) {
+func (q *Queries) ListGuildMatches(ctx context.Context, guildID string) ([]Match, error) {
 	rows, err := q.query(ctx, q.listGuildMatchesStmt, listGuildMatches, guildID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []ListGuildMatchesRow{}
+	items := []Match{}
 	for rows.Next() {
-		var i ListGuildMatchesRow
+		var i Match
 		if err := rows.Scan(
+			&i.MatchID,
 			&i.GuildID,
+			&i.Number,
 			&i.ChannelID,
-			&i.ChannelAccessibleAt,
 			&i.ChannelAccessible,
+			&i.ChannelAccessibleAt,
 			&i.ChannelDeleteAt,
 			&i.MessageID,
-			&i.EventID,
 			&i.ScheduledAt,
 			&i.CreatedAt,
 			&i.CreatedBy,
 			&i.UpdatedAt,
 			&i.UpdatedBy,
+			&i.EventID,
+			&i.CancelledAt,
+			&i.CancelReason,
+			&i.Division,
+			&i.ChannelDeleted,
 		); err != nil {
 			return nil, err
 		}
@@ -260,21 +410,29 @@ func (q *Queries) ListGuildMatches(ctx context.Context, guildID string) ([]ListG
 
 const listGuildMatchesScheduledBetween = `-- name: ListGuildMatchesScheduledBetween :many
 SELECT
+    match_id,
     guild_id,
+    number,
     channel_id,
-    channel_accessible_at,
     channel_accessible,
+    channel_accessible_at,
     channel_delete_at,
     message_id,
-    event_id,
     scheduled_at,
     created_at,
     created_by,
     updated_at,
-    updated_by
+    updated_by,
+    event_id,
+    cancelled_at,
+    cancel_reason,
+    division,
+    channel_deleted
 FROM matches
 WHERE scheduled_at BETWEEN ?1 AND ?2
 AND guild_id = ?3
+AND cancelled_at = 0
+AND channel_deleted = 0
 ORDER BY scheduled_at ASC
 `
 
@@ -284,43 +442,34 @@ type ListGuildMatchesScheduledBetweenParams struct {
 	GuildID string `db:"guild_id"`
 }
 
-type ListGuildMatchesScheduledBetweenRow struct {
-	GuildID             string `db:"guild_id"`
-	ChannelID           string `db:"channel_id"`
-	ChannelAccessibleAt int64  `db:"channel_accessible_at"`
-	ChannelAccessible   int64  `db:"channel_accessible"`
-	ChannelDeleteAt     int64  `db:"channel_delete_at"`
-	MessageID           string `db:"message_id"`
-	EventID             string `db:"event_id"`
-	ScheduledAt         int64  `db:"scheduled_at"`
-	CreatedAt           int64  `db:"created_at"`
-	CreatedBy           string `db:"created_by"`
-	UpdatedAt           int64  `db:"updated_at"`
-	UpdatedBy           string `db:"updated_by"`
-}
-
-func (q *Queries) ListGuildMatchesScheduledBetween(ctx context.Context, arg ListGuildMatchesScheduledBetweenParams) ([]ListGuildMatchesScheduledBetweenRow, error) {
+func (q *Queries) ListGuildMatchesScheduledBetween(ctx context.Context, arg ListGuildMatchesScheduledBetweenParams) ([]Match, error) {
 	rows, err := q.query(ctx, q.listGuildMatchesScheduledBetweenStmt, listGuildMatchesScheduledBetween, arg.MinAt, arg.MaxAt, arg.GuildID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []ListGuildMatchesScheduledBetweenRow{}
+	items := []Match{}
 	for rows.Next() {
-		var i ListGuildMatchesScheduledBetweenRow
+		var i Match
 		if err := rows.Scan(
+			&i.MatchID,
 			&i.GuildID,
+			&i.Number,
 			&i.ChannelID,
-			&i.ChannelAccessibleAt,
 			&i.ChannelAccessible,
+			&i.ChannelAccessibleAt,
 			&i.ChannelDeleteAt,
 			&i.MessageID,
-			&i.EventID,
 			&i.ScheduledAt,
 			&i.CreatedAt,
 			&i.CreatedBy,
 			&i.UpdatedAt,
 			&i.UpdatedBy,
+			&i.EventID,
+			&i.CancelledAt,
+			&i.CancelReason,
+			&i.Division,
+			&i.ChannelDeleted,
 		); err != nil {
 			return nil, err
 		}
@@ -337,51 +486,46 @@ func (q *Queries) ListGuildMatchesScheduledBetween(ctx context.Context, arg List
 
 const listNowAccessibleChannels = `-- name: ListNowAccessibleChannels :many
 SELECT
+    match_id,
     guild_id,
+    number,
     channel_id,
-    channel_accessible_at,
     channel_accessible,
+    channel_accessible_at,
     channel_delete_at,
     message_id,
     scheduled_at,
     created_at,
     created_by,
     updated_at,
-    updated_by
+    updated_by,
+    event_id,
+    cancelled_at,
+    cancel_reason,
+    division,
+    channel_deleted
 FROM matches
 WHERE matches.channel_accessible = 0
 AND matches.channel_accessible_at <= unixepoch('now')
 ORDER BY channel_accessible_at ASC
 `
 
-type ListNowAccessibleChannelsRow struct {
-	GuildID             string `db:"guild_id"`
-	ChannelID           string `db:"channel_id"`
-	ChannelAccessibleAt int64  `db:"channel_accessible_at"`
-	ChannelAccessible   int64  `db:"channel_accessible"`
-	ChannelDeleteAt     int64  `db:"channel_delete_at"`
-	MessageID           string `db:"message_id"`
-	ScheduledAt         int64  `db:"scheduled_at"`
-	CreatedAt           int64  `db:"created_at"`
-	CreatedBy           string `db:"created_by"`
-	UpdatedAt           int64  `db:"updated_at"`
-	UpdatedBy           string `db:"updated_by"`
-}
-
-func (q *Queries) ListNowAccessibleChannels(ctx context.Context) ([]ListNowAccessibleChannelsRow, error) {
+func (q *Queries) ListNowAccessibleChannels(ctx context.Context) ([]Match, error) {
 	rows, err := q.query(ctx, q.listNowAccessibleChannelsStmt, listNowAccessibleChannels)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []ListNowAccessibleChannelsRow{}
+	items := []Match{}
 	for rows.Next() {
-		var i ListNowAccessibleChannelsRow
+		var i Match
 		if err := rows.Scan(
+			&i.MatchID,
 			&i.GuildID,
+			&i.Number,
 			&i.ChannelID,
-			&i.ChannelAccessibleAt,
 			&i.ChannelAccessible,
+			&i.ChannelAccessibleAt,
 			&i.ChannelDeleteAt,
 			&i.MessageID,
 			&i.ScheduledAt,
@@ -389,6 +533,11 @@ func (q *Queries) ListNowAccessibleChannels(ctx context.Context) ([]ListNowAcces
 			&i.CreatedBy,
 			&i.UpdatedAt,
 			&i.UpdatedBy,
+			&i.EventID,
+			&i.CancelledAt,
+			&i.CancelReason,
+			&i.Division,
+			&i.ChannelDeleted,
 		); err != nil {
 			return nil, err
 		}
@@ -405,60 +554,58 @@ func (q *Queries) ListNowAccessibleChannels(ctx context.Context) ([]ListNowAcces
 
 const listNowDeletableChannels = `-- name: ListNowDeletableChannels :many
 SELECT
+    match_id,
     guild_id,
+    number,
     channel_id,
-    channel_accessible_at,
     channel_accessible,
+    channel_accessible_at,
     channel_delete_at,
     message_id,
-    event_id,
     scheduled_at,
     created_at,
     created_by,
     updated_at,
-    updated_by
+    updated_by,
+    event_id,
+    cancelled_at,
+    cancel_reason,
+    division,
+    channel_deleted
 FROM matches
-WHERE matches.channel_delete_at <= unixepoch('now')
+WHERE matches.channel_deleted = 0
+AND matches.channel_delete_at <= unixepoch('now')
 ORDER BY channel_delete_at ASC
 `
 
-type ListNowDeletableChannelsRow struct {
-	GuildID             string `db:"guild_id"`
-	ChannelID           string `db:"channel_id"`
-	ChannelAccessibleAt int64  `db:"channel_accessible_at"`
-	ChannelAccessible   int64  `db:"channel_accessible"`
-	ChannelDeleteAt     int64  `db:"channel_delete_at"`
-	MessageID           string `db:"message_id"`
-	EventID             string `db:"event_id"`
-	ScheduledAt         int64  `db:"scheduled_at"`
-	CreatedAt           int64  `db:"created_at"`
-	CreatedBy           string `db:"created_by"`
-	UpdatedAt           int64  `db:"updated_at"`
-	UpdatedBy           string `db:"updated_by"`
-}
-
-func (q *Queries) ListNowDeletableChannels(ctx context.Context) ([]ListNowDeletableChannelsRow, error) {
+func (q *Queries) ListNowDeletableChannels(ctx context.Context) ([]Match, error) {
 	rows, err := q.query(ctx, q.listNowDeletableChannelsStmt, listNowDeletableChannels)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []ListNowDeletableChannelsRow{}
+	items := []Match{}
 	for rows.Next() {
-		var i ListNowDeletableChannelsRow
+		var i Match
 		if err := rows.Scan(
+			&i.MatchID,
 			&i.GuildID,
+			&i.Number,
 			&i.ChannelID,
-			&i.ChannelAccessibleAt,
 			&i.ChannelAccessible,
+			&i.ChannelAccessibleAt,
 			&i.ChannelDeleteAt,
 			&i.MessageID,
-			&i.EventID,
 			&i.ScheduledAt,
 			&i.CreatedAt,
 			&i.CreatedBy,
 			&i.UpdatedAt,
 			&i.UpdatedBy,
+			&i.EventID,
+			&i.CancelledAt,
+			&i.CancelReason,
+			&i.Division,
+			&i.ChannelDeleted,
 		); err != nil {
 			return nil, err
 		}
@@ -475,45 +622,40 @@ func (q *Queries) ListNowDeletableChannels(ctx context.Context) ([]ListNowDeleta
 
 const nextAccessibleChannel = `-- name: NextAccessibleChannel :one
 SELECT
+    match_id,
     guild_id,
+    number,
     channel_id,
-    channel_accessible_at,
     channel_accessible,
+    channel_accessible_at,
     channel_delete_at,
     message_id,
     scheduled_at,
     created_at,
     created_by,
     updated_at,
-    updated_by
+    updated_by,
+    event_id,
+    cancelled_at,
+    cancel_reason,
+    division,
+    channel_deleted
 FROM matches
 WHERE matches.channel_accessible = 0
 ORDER BY channel_accessible_at ASC
 LIMIT 1
 `
 
-type NextAccessibleChannelRow struct {
-	GuildID             string `db:"guild_id"`
-	ChannelID           string `db:"channel_id"`
-	ChannelAccessibleAt int64  `db:"channel_accessible_at"`
-	ChannelAccessible   int64  `db:"channel_accessible"`
-	ChannelDeleteAt     int64  `db:"channel_delete_at"`
-	MessageID           string `db:"message_id"`
-	ScheduledAt         int64  `db:"scheduled_at"`
-	CreatedAt           int64  `db:"created_at"`
-	CreatedBy           string `db:"created_by"`
-	UpdatedAt           int64  `db:"updated_at"`
-	UpdatedBy           string `db:"updated_by"`
-}
-
-func (q *Queries) NextAccessibleChannel(ctx context.Context) (NextAccessibleChannelRow, error) {
+func (q *Queries) NextAccessibleChannel(ctx context.Context) (Match, error) {
 	row := q.queryRow(ctx, q.nextAccessibleChannelStmt, nextAccessibleChannel)
-	var i NextAccessibleChannelRow
+	var i Match
 	err := row.Scan(
+		&i.MatchID,
 		&i.GuildID,
+		&i.Number,
 		&i.ChannelID,
-		&i.ChannelAccessibleAt,
 		&i.ChannelAccessible,
+		&i.ChannelAccessibleAt,
 		&i.ChannelDeleteAt,
 		&i.MessageID,
 		&i.ScheduledAt,
@@ -521,60 +663,63 @@ func (q *Queries) NextAccessibleChannel(ctx context.Context) (NextAccessibleChan
 		&i.CreatedBy,
 		&i.UpdatedAt,
 		&i.UpdatedBy,
+		&i.EventID,
+		&i.CancelledAt,
+		&i.CancelReason,
+		&i.Division,
+		&i.ChannelDeleted,
 	)
 	return i, err
 }
 
 const nextDeletableChannel = `-- name: NextDeletableChannel :one
 SELECT
+    match_id,
     guild_id,
+    number,
     channel_id,
-    channel_accessible_at,
     channel_accessible,
+    channel_accessible_at,
     channel_delete_at,
     message_id,
-    event_id,
     scheduled_at,
     created_at,
     created_by,
     updated_at,
-    updated_by
+    updated_by,
+    event_id,
+    cancelled_at,
+    cancel_reason,
+    division,
+    channel_deleted
 FROM matches
+WHERE matches.channel_deleted = 0
 ORDER BY channel_delete_at ASC
 LIMIT 1
 `
 
-type NextDeletableChannelRow struct {
-	GuildID             string `db:"guild_id"`
-	ChannelID           string `db:"channel_id"`
-	ChannelAccessibleAt int64  `db:"channel_accessible_at"`
-	ChannelAccessible   int64  `db:"channel_accessible"`
-	ChannelDeleteAt     int64  `db:"channel_delete_at"`
-	MessageID           string `db:"message_id"`
-	EventID             string `db:"event_id"`
-	ScheduledAt         int64  `db:"scheduled_at"`
-	CreatedAt           int64  `db:"created_at"`
-	CreatedBy           string `db:"created_by"`
-	UpdatedAt           int64  `db:"updated_at"`
-	UpdatedBy           string `db:"updated_by"`
-}
-
-func (q *Queries) NextDeletableChannel(ctx context.Context) (NextDeletableChannelRow, error) {
+func (q *Queries) NextDeletableChannel(ctx context.Context) (Match, error) {
 	row := q.queryRow(ctx, q.nextDeletableChannelStmt, nextDeletableChannel)
-	var i NextDeletableChannelRow
+	var i Match
 	err := row.Scan(
+		&i.MatchID,
 		&i.GuildID,
+		&i.Number,
 		&i.ChannelID,
-		&i.ChannelAccessibleAt,
 		&i.ChannelAccessible,
+		&i.ChannelAccessibleAt,
 		&i.ChannelDeleteAt,
 		&i.MessageID,
-		&i.EventID,
 		&i.ScheduledAt,
 		&i.CreatedAt,
 		&i.CreatedBy,
 		&i.UpdatedAt,
 		&i.UpdatedBy,
+		&i.EventID,
+		&i.CancelledAt,
+		&i.CancelReason,
+		&i.Division,
+		&i.ChannelDeleted,
 	)
 	return i, err
 }
@@ -589,7 +734,7 @@ SET
     scheduled_at = ?5,
     updated_at = ?6,
     updated_by = ?7
-WHERE channel_id = ?8
+WHERE match_id = ?8
 `
 
 type RescheduleMatchParams struct {
@@ -600,7 +745,7 @@ type RescheduleMatchParams struct {
 	ScheduledAt         int64  `db:"scheduled_at"`
 	UpdatedAt           int64  `db:"updated_at"`
 	UpdatedBy           string `db:"updated_by"`
-	ChannelID           string `db:"channel_id"`
+	MatchID             int64  `db:"match_id"`
 }
 
 func (q *Queries) RescheduleMatch(ctx context.Context, arg RescheduleMatchParams) error {
@@ -612,7 +757,7 @@ func (q *Queries) RescheduleMatch(ctx context.Context, arg RescheduleMatchParams
 		arg.ScheduledAt,
 		arg.UpdatedAt,
 		arg.UpdatedBy,
-		arg.ChannelID,
+		arg.MatchID,
 	)
 	return err
 }
@@ -635,20 +780,54 @@ func (q *Queries) ResetEventID(ctx context.Context, arg ResetEventIDParams) erro
 	return err
 }
 
+const resetMatchChannel = `-- name: ResetMatchChannel :exec
+UPDATE matches
+SET
+    channel_id = '',
+    message_id = '',
+    event_id = '',
+    channel_accessible = 0
+WHERE match_id = ?1
+`
+
+func (q *Queries) ResetMatchChannel(ctx context.Context, matchID int64) error {
+	_, err := q.exec(ctx, q.resetMatchChannelStmt, resetMatchChannel, matchID)
+	return err
+}
+
+const updateMatchChannel = `-- name: UpdateMatchChannel :exec
+UPDATE matches
+SET
+    channel_id = ?1,
+    message_id = ?2
+WHERE match_id = ?3
+`
+
+type UpdateMatchChannelParams struct {
+	ChannelID string `db:"channel_id"`
+	MessageID string `db:"message_id"`
+	MatchID   int64  `db:"match_id"`
+}
+
+func (q *Queries) UpdateMatchChannel(ctx context.Context, arg UpdateMatchChannelParams) error {
+	_, err := q.exec(ctx, q.updateMatchChannelStmt, updateMatchChannel, arg.ChannelID, arg.MessageID, arg.MatchID)
+	return err
+}
+
 const updateMatchChannelAccessibility = `-- name: UpdateMatchChannelAccessibility :exec
 UPDATE matches
 SET
     channel_accessible = ?1
-WHERE channel_id = ?2
+WHERE match_id = ?2
 `
 
 type UpdateMatchChannelAccessibilityParams struct {
-	ChannelAccessible int64  `db:"channel_accessible"`
-	ChannelID         string `db:"channel_id"`
+	ChannelAccessible int64 `db:"channel_accessible"`
+	MatchID           int64 `db:"match_id"`
 }
 
 func (q *Queries) UpdateMatchChannelAccessibility(ctx context.Context, arg UpdateMatchChannelAccessibilityParams) error {
-	_, err := q.exec(ctx, q.updateMatchChannelAccessibilityStmt, updateMatchChannelAccessibility, arg.ChannelAccessible, arg.ChannelID)
+	_, err := q.exec(ctx, q.updateMatchChannelAccessibilityStmt, updateMatchChannelAccessibility, arg.ChannelAccessible, arg.MatchID)
 	return err
 }
 
@@ -656,15 +835,15 @@ const updateMatchEventID = `-- name: UpdateMatchEventID :exec
 UPDATE matches
 SET
     event_id = ?1
-WHERE channel_id = ?2
+WHERE match_id = ?2
 `
 
 type UpdateMatchEventIDParams struct {
-	EventID   string `db:"event_id"`
-	ChannelID string `db:"channel_id"`
+	EventID string `db:"event_id"`
+	MatchID int64  `db:"match_id"`
 }
 
 func (q *Queries) UpdateMatchEventID(ctx context.Context, arg UpdateMatchEventIDParams) error {
-	_, err := q.exec(ctx, q.updateMatchEventIDStmt, updateMatchEventID, arg.EventID, arg.ChannelID)
+	_, err := q.exec(ctx, q.updateMatchEventIDStmt, updateMatchEventID, arg.EventID, arg.MatchID)
 	return err
 }
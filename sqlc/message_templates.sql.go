@@ -0,0 +1,136 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: message_templates.sql
+
+package sqlc
+
+import (
+	"context"
+)
+
+const deleteMessageTemplate = `-- name: DeleteMessageTemplate :exec
+DELETE FROM message_templates
+WHERE guild_id = ?1
+AND kind = ?2
+`
+
+type DeleteMessageTemplateParams struct {
+	GuildID string `db:"guild_id"`
+	Kind    string `db:"kind"`
+}
+
+func (q *Queries) DeleteMessageTemplate(ctx context.Context, arg DeleteMessageTemplateParams) error {
+	_, err := q.exec(ctx, q.deleteMessageTemplateStmt, deleteMessageTemplate, arg.GuildID, arg.Kind)
+	return err
+}
+
+const getMessageTemplate = `-- name: GetMessageTemplate :one
+SELECT
+    guild_id,
+    kind,
+    template,
+    updated_at,
+    updated_by
+FROM message_templates
+WHERE guild_id = ?1
+AND kind = ?2
+`
+
+type GetMessageTemplateParams struct {
+	GuildID string `db:"guild_id"`
+	Kind    string `db:"kind"`
+}
+
+func (q *Queries) GetMessageTemplate(ctx context.Context, arg GetMessageTemplateParams) (MessageTemplate, error) {
+	row := q.queryRow(ctx, q.getMessageTemplateStmt, getMessageTemplate, arg.GuildID, arg.Kind)
+	var i MessageTemplate
+	err := row.Scan(
+		&i.GuildID,
+		&i.Kind,
+		&i.Template,
+		&i.UpdatedAt,
+		&i.UpdatedBy,
+	)
+	return i, err
+}
+
+const listMessageTemplates = `-- name: ListMessageTemplates :many
+SELECT
+    guild_id,
+    kind,
+    template,
+    updated_at,
+    updated_by
+FROM message_templates
+WHERE guild_id = ?1
+ORDER BY kind
+`
+
+func (q *Queries) ListMessageTemplates(ctx context.Context, guildID string) ([]MessageTemplate, error) {
+	rows, err := q.query(ctx, q.listMessageTemplatesStmt, listMessageTemplates, guildID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []MessageTemplate{}
+	for rows.Next() {
+		var i MessageTemplate
+		if err := rows.Scan(
+			&i.GuildID,
+			&i.Kind,
+			&i.Template,
+			&i.UpdatedAt,
+			&i.UpdatedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setMessageTemplate = `-- name: SetMessageTemplate :exec
+INSERT INTO message_templates (
+    guild_id,
+    kind,
+    template,
+    updated_at,
+    updated_by
+) VALUES (
+    ?1,
+    ?2,
+    ?3,
+    ?4,
+    ?5
+)
+ON CONFLICT (guild_id, kind) DO UPDATE SET
+    template = excluded.template,
+    updated_at = excluded.updated_at,
+    updated_by = excluded.updated_by
+`
+
+type SetMessageTemplateParams struct {
+	GuildID   string `db:"guild_id"`
+	Kind      string `db:"kind"`
+	Template  string `db:"template"`
+	UpdatedAt int64  `db:"updated_at"`
+	UpdatedBy string `db:"updated_by"`
+}
+
+func (q *Queries) SetMessageTemplate(ctx context.Context, arg SetMessageTemplateParams) error {
+	_, err := q.exec(ctx, q.setMessageTemplateStmt, setMessageTemplate,
+		arg.GuildID,
+		arg.Kind,
+		arg.Template,
+		arg.UpdatedAt,
+		arg.UpdatedBy,
+	)
+	return err
+}
@@ -10,6 +10,7 @@ type Access struct {
 
 type Announcement struct {
 	GuildID          string `db:"guild_id"`
+	Name             string `db:"name"`
 	StartsAt         int64  `db:"starts_at"`
 	EndsAt           int64  `db:"ends_at"`
 	ChannelID        string `db:"channel_id"`
@@ -17,6 +18,75 @@ type Announcement struct {
 	LastAnnouncedAt  int64  `db:"last_announced_at"`
 	CustomTextBefore string `db:"custom_text_before"`
 	CustomTextAfter  string `db:"custom_text_after"`
+	Schedule         string `db:"schedule"`
+	Location         string `db:"location"`
+	NextAnnouncedAt  int64  `db:"next_announced_at"`
+	Division         string `db:"division"`
+}
+
+type Bracket struct {
+	BracketID     int64  `db:"bracket_id"`
+	GuildID       string `db:"guild_id"`
+	SeasonID      int64  `db:"season_id"`
+	Name          string `db:"name"`
+	Kind          string `db:"kind"`
+	Slots         string `db:"slots"`
+	Location      string `db:"location"`
+	ModeratorIds  string `db:"moderator_ids"`
+	ModeratorTurn int64  `db:"moderator_turn"`
+	ChannelID     string `db:"channel_id"`
+	CreatedAt     int64  `db:"created_at"`
+	CreatedBy     string `db:"created_by"`
+}
+
+type BracketSlot struct {
+	BracketID  int64  `db:"bracket_id"`
+	SlotIndex  int64  `db:"slot_index"`
+	Section    string `db:"section"`
+	Round      int64  `db:"round"`
+	Position   int64  `db:"position"`
+	Team1      string `db:"team_1"`
+	Team2      string `db:"team_2"`
+	Bye1       int64  `db:"bye_1"`
+	Bye2       int64  `db:"bye_2"`
+	WinnerTo   int64  `db:"winner_to"`
+	WinnerSide int64  `db:"winner_side"`
+	LoserTo    int64  `db:"loser_to"`
+	LoserSide  int64  `db:"loser_side"`
+	Done       int64  `db:"done"`
+	Winner     string `db:"winner"`
+	FixtureID  int64  `db:"fixture_id"`
+}
+
+type Division struct {
+	GuildID             string `db:"guild_id"`
+	Name                string `db:"name"`
+	CategoryID          string `db:"category_id"`
+	ChannelAccessOffset int64  `db:"channel_access_offset"`
+	RequirementsOffset  int64  `db:"requirements_offset"`
+	ChannelDeleteOffset int64  `db:"channel_delete_offset"`
+	NotificationOffsets string `db:"notification_offsets"`
+}
+
+type DivisionTeam struct {
+	GuildID  string `db:"guild_id"`
+	RoleID   string `db:"role_id"`
+	Division string `db:"division"`
+}
+
+type Fixture struct {
+	FixtureID   int64  `db:"fixture_id"`
+	SeasonID    int64  `db:"season_id"`
+	Round       int64  `db:"round"`
+	ScheduledAt int64  `db:"scheduled_at"`
+	ModeratorID string `db:"moderator_id"`
+	MatchID     int64  `db:"match_id"`
+}
+
+type FixtureTeam struct {
+	FixtureID int64  `db:"fixture_id"`
+	RoleID    string `db:"role_id"`
+	Position  int64  `db:"position"`
 }
 
 type GuildConfig struct {
@@ -29,10 +99,17 @@ type GuildConfig struct {
 	NotificationOffsets  string `db:"notification_offsets"`
 	MatchCounter         int64  `db:"match_counter"`
 	EventCreationEnabled int64  `db:"event_creation_enabled"`
+	PointsWin            int64  `db:"points_win"`
+	PointsDraw           int64  `db:"points_draw"`
+	PointsLoss           int64  `db:"points_loss"`
+	Language             string `db:"language"`
+	OutputMode           string `db:"output_mode"`
 }
 
 type Match struct {
+	MatchID             int64  `db:"match_id"`
 	GuildID             string `db:"guild_id"`
+	Number              int64  `db:"number"`
 	ChannelID           string `db:"channel_id"`
 	ChannelAccessible   int64  `db:"channel_accessible"`
 	ChannelAccessibleAt int64  `db:"channel_accessible_at"`
@@ -44,28 +121,110 @@ type Match struct {
 	UpdatedAt           int64  `db:"updated_at"`
 	UpdatedBy           string `db:"updated_by"`
 	EventID             string `db:"event_id"`
+	CancelledAt         int64  `db:"cancelled_at"`
+	CancelReason        string `db:"cancel_reason"`
+	Division            string `db:"division"`
+	ChannelDeleted      int64  `db:"channel_deleted"`
+}
+
+type MessageTemplate struct {
+	GuildID   string `db:"guild_id"`
+	Kind      string `db:"kind"`
+	Template  string `db:"template"`
+	UpdatedAt int64  `db:"updated_at"`
+	UpdatedBy string `db:"updated_by"`
 }
 
 type Moderator struct {
-	ChannelID string `db:"channel_id"`
-	UserID    string `db:"user_id"`
+	MatchID int64  `db:"match_id"`
+	UserID  string `db:"user_id"`
 }
 
 type Notification struct {
-	ChannelID  string `db:"channel_id"`
+	MatchID    int64  `db:"match_id"`
 	NotifyAt   int64  `db:"notify_at"`
 	CustomText string `db:"custom_text"`
 	CreatedAt  int64  `db:"created_at"`
 	CreatedBy  string `db:"created_by"`
 	UpdatedAt  int64  `db:"updated_at"`
 	UpdatedBy  string `db:"updated_by"`
+	Generated  int64  `db:"generated"`
+}
+
+type OverflowCategory struct {
+	CategoryID string `db:"category_id"`
+	GuildID    string `db:"guild_id"`
+	Division   string `db:"division"`
+	Number     int64  `db:"number"`
+}
+
+type Participant struct {
+	MatchID    int64  `db:"match_id"`
+	RoleID     string `db:"role_id"`
+	UserID     string `db:"user_id"`
+	JoinedAt   int64  `db:"joined_at"`
+	Reaction   int64  `db:"reaction"`
+	Substitute int64  `db:"substitute"`
 }
 
 type ParticipationRequirement struct {
-	ChannelID           string `db:"channel_id"`
-	ParticipantsPerTeam int64  `db:"participants_per_team"`
-	DeadlineAt          int64  `db:"deadline_at"`
-	EntryClosed         int64  `db:"entry_closed"`
+	MatchID             int64 `db:"match_id"`
+	ParticipantsPerTeam int64 `db:"participants_per_team"`
+	DeadlineAt          int64 `db:"deadline_at"`
+	EntryClosed         int64 `db:"entry_closed"`
+}
+
+type RatingHistory struct {
+	GuildID      string  `db:"guild_id"`
+	RoleID       string  `db:"role_id"`
+	MatchID      int64   `db:"match_id"`
+	RatingBefore float64 `db:"rating_before"`
+	RatingAfter  float64 `db:"rating_after"`
+	RatedAt      int64   `db:"rated_at"`
+}
+
+type RegisteredTeam struct {
+	GuildID   string `db:"guild_id"`
+	RoleID    string `db:"role_id"`
+	Name      string `db:"name"`
+	Tag       string `db:"tag"`
+	LogoUrl   string `db:"logo_url"`
+	CreatedAt int64  `db:"created_at"`
+	CreatedBy string `db:"created_by"`
+	UpdatedAt int64  `db:"updated_at"`
+	UpdatedBy string `db:"updated_by"`
+}
+
+type RescheduleProposal struct {
+	MatchID     int64  `db:"match_id"`
+	RoleID      string `db:"role_id"`
+	ScheduledAt int64  `db:"scheduled_at"`
+	ChannelID   string `db:"channel_id"`
+	MessageID   string `db:"message_id"`
+	ProposedAt  int64  `db:"proposed_at"`
+	ProposedBy  string `db:"proposed_by"`
+}
+
+type Result struct {
+	MatchID          int64  `db:"match_id"`
+	GuildID          string `db:"guild_id"`
+	ChannelName      string `db:"channel_name"`
+	ScheduledAt      int64  `db:"scheduled_at"`
+	ReportedAt       int64  `db:"reported_at"`
+	ReportedBy       string `db:"reported_by"`
+	Status           string `db:"status"`
+	MessageID        string `db:"message_id"`
+	FinalizedAt      int64  `db:"finalized_at"`
+	FinalizedBy      string `db:"finalized_by"`
+	Division         string `db:"division"`
+	MessageChannelID string `db:"message_channel_id"`
+}
+
+type ResultConfirmation struct {
+	MatchID     int64  `db:"match_id"`
+	RoleID      string `db:"role_id"`
+	UserID      string `db:"user_id"`
+	ConfirmedAt int64  `db:"confirmed_at"`
 }
 
 type RoleAccess struct {
@@ -74,20 +233,97 @@ type RoleAccess struct {
 	Permission string `db:"permission"`
 }
 
-type Streamer struct {
+type ScheduleBoard struct {
+	GuildID   string `db:"guild_id"`
+	ChannelID string `db:"channel_id"`
+	Days      int64  `db:"days"`
+}
+
+type ScheduleBoardMessage struct {
+	GuildID   string `db:"guild_id"`
+	Position  int64  `db:"position"`
+	MessageID string `db:"message_id"`
+}
+
+type Season struct {
+	SeasonID            int64  `db:"season_id"`
+	GuildID             string `db:"guild_id"`
+	ParticipantsPerTeam int64  `db:"participants_per_team"`
+	Confirmed           int64  `db:"confirmed"`
+	CreatedAt           int64  `db:"created_at"`
+	CreatedBy           string `db:"created_by"`
+}
+
+type StandingsMessage struct {
+	GuildID   string `db:"guild_id"`
 	ChannelID string `db:"channel_id"`
-	UserID    string `db:"user_id"`
-	Url       string `db:"url"`
+	MessageID string `db:"message_id"`
+	Division  string `db:"division"`
+}
+
+type Streamer struct {
+	MatchID int64  `db:"match_id"`
+	UserID  string `db:"user_id"`
+	Url     string `db:"url"`
+}
+
+type SwissBye struct {
+	SwissID int64  `db:"swiss_id"`
+	Round   int64  `db:"round"`
+	RoleID  string `db:"role_id"`
+}
+
+type SwissTeam struct {
+	SwissID int64  `db:"swiss_id"`
+	RoleID  string `db:"role_id"`
+}
+
+type SwissTournament struct {
+	SwissID       int64  `db:"swiss_id"`
+	GuildID       string `db:"guild_id"`
+	SeasonID      int64  `db:"season_id"`
+	Name          string `db:"name"`
+	Slots         string `db:"slots"`
+	Location      string `db:"location"`
+	ModeratorIds  string `db:"moderator_ids"`
+	ModeratorTurn int64  `db:"moderator_turn"`
+	CurrentRound  int64  `db:"current_round"`
+	CreatedAt     int64  `db:"created_at"`
+	CreatedBy     string `db:"created_by"`
 }
 
 type Team struct {
-	ChannelID             string `db:"channel_id"`
+	MatchID               int64  `db:"match_id"`
 	RoleID                string `db:"role_id"`
 	ConfirmedParticipants int64  `db:"confirmed_participants"`
-	Score                 int64  `db:"score"`
-	Time                  int64  `db:"time"`
-	Screenshot            []byte `db:"screenshot"`
-	Demo                  []byte `db:"demo"`
+}
+
+type TeamMember struct {
+	GuildID string `db:"guild_id"`
+	RoleID  string `db:"role_id"`
+	UserID  string `db:"user_id"`
+	Captain int64  `db:"captain"`
+}
+
+type TeamRating struct {
+	GuildID   string  `db:"guild_id"`
+	RoleID    string  `db:"role_id"`
+	Rating    float64 `db:"rating"`
+	Matches   int64   `db:"matches"`
+	UpdatedAt int64   `db:"updated_at"`
+}
+
+type TeamResult struct {
+	MatchID        int64  `db:"match_id"`
+	RoleID         string `db:"role_id"`
+	Score          int64  `db:"score"`
+	Time           int64  `db:"time"`
+	Screenshot     []byte `db:"screenshot"`
+	ScreenshotName string `db:"screenshot_name"`
+	Demo           []byte `db:"demo"`
+	DemoName       string `db:"demo_name"`
+	ReportedAt     int64  `db:"reported_at"`
+	ReportedBy     string `db:"reported_by"`
 }
 
 type UserAccess struct {
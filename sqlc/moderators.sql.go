@@ -12,7 +12,7 @@ import (
 
 const addMatchModerator = `-- name: AddMatchModerator :exec
 INSERT INTO moderators (
-    channel_id,
+    match_id,
     user_id
 ) VALUES (
     ?1,
@@ -21,56 +21,56 @@ INSERT INTO moderators (
 `
 
 type AddMatchModeratorParams struct {
-	ChannelID string `db:"channel_id"`
-	UserID    string `db:"user_id"`
+	MatchID int64  `db:"match_id"`
+	UserID  string `db:"user_id"`
 }
 
 func (q *Queries) AddMatchModerator(ctx context.Context, arg AddMatchModeratorParams) error {
-	_, err := q.exec(ctx, q.addMatchModeratorStmt, addMatchModerator, arg.ChannelID, arg.UserID)
+	_, err := q.exec(ctx, q.addMatchModeratorStmt, addMatchModerator, arg.MatchID, arg.UserID)
 	return err
 }
 
 const deleteAllMatchModerators = `-- name: DeleteAllMatchModerators :exec
 DELETE FROM moderators
-WHERE channel_id = ?1
+WHERE match_id = ?1
 `
 
-func (q *Queries) DeleteAllMatchModerators(ctx context.Context, channelID string) error {
-	_, err := q.exec(ctx, q.deleteAllMatchModeratorsStmt, deleteAllMatchModerators, channelID)
+func (q *Queries) DeleteAllMatchModerators(ctx context.Context, matchID int64) error {
+	_, err := q.exec(ctx, q.deleteAllMatchModeratorsStmt, deleteAllMatchModerators, matchID)
 	return err
 }
 
 const deleteMatchModerator = `-- name: DeleteMatchModerator :exec
 DELETE FROM moderators
-WHERE channel_id = ?1
+WHERE match_id = ?1
 AND user_id = ?2
 `
 
 type DeleteMatchModeratorParams struct {
-	ChannelID string `db:"channel_id"`
-	UserID    string `db:"user_id"`
+	MatchID int64  `db:"match_id"`
+	UserID  string `db:"user_id"`
 }
 
 func (q *Queries) DeleteMatchModerator(ctx context.Context, arg DeleteMatchModeratorParams) error {
-	_, err := q.exec(ctx, q.deleteMatchModeratorStmt, deleteMatchModerator, arg.ChannelID, arg.UserID)
+	_, err := q.exec(ctx, q.deleteMatchModeratorStmt, deleteMatchModerator, arg.MatchID, arg.UserID)
 	return err
 }
 
 const deleteMatchModerators = `-- name: DeleteMatchModerators :exec
 DELETE FROM moderators
-WHERE channel_id = ?1
+WHERE match_id = ?1
 AND user_id IN (/*SLICE:user_id*/?)
 `
 
 type DeleteMatchModeratorsParams struct {
-	ChannelID string   `db:"channel_id"`
-	UserID    []string `db:"user_id"`
+	MatchID int64    `db:"match_id"`
+	UserID  []string `db:"user_id"`
 }
 
 func (q *Queries) DeleteMatchModerators(ctx context.Context, arg DeleteMatchModeratorsParams) error {
 	query := deleteMatchModerators
 	var queryParams []interface{}
-	queryParams = append(queryParams, arg.ChannelID)
+	queryParams = append(queryParams, arg.MatchID)
 	if len(arg.UserID) > 0 {
 		for _, v := range arg.UserID {
 			queryParams = append(queryParams, v)
@@ -83,17 +83,36 @@ func (q *Queries) DeleteMatchModerators(ctx context.Context, arg DeleteMatchMode
 	return err
 }
 
+const isMatchModerator = `-- name: IsMatchModerator :one
+SELECT COUNT(*) > 0
+FROM moderators
+WHERE match_id = ?1
+AND user_id = ?2
+`
+
+type IsMatchModeratorParams struct {
+	MatchID int64  `db:"match_id"`
+	UserID  string `db:"user_id"`
+}
+
+func (q *Queries) IsMatchModerator(ctx context.Context, arg IsMatchModeratorParams) (bool, error) {
+	row := q.queryRow(ctx, q.isMatchModeratorStmt, isMatchModerator, arg.MatchID, arg.UserID)
+	var column_1 bool
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
 const listMatchModerators = `-- name: ListMatchModerators :many
 SELECT
-    channel_id,
+    match_id,
     user_id
 FROM moderators
-WHERE channel_id = ?1
+WHERE match_id = ?1
 ORDER BY user_id
 `
 
-func (q *Queries) ListMatchModerators(ctx context.Context, channelID string) ([]Moderator, error) {
-	rows, err := q.query(ctx, q.listMatchModeratorsStmt, listMatchModerators, channelID)
+func (q *Queries) ListMatchModerators(ctx context.Context, matchID int64) ([]Moderator, error) {
+	rows, err := q.query(ctx, q.listMatchModeratorsStmt, listMatchModerators, matchID)
 	if err != nil {
 		return nil, err
 	}
@@ -101,7 +120,7 @@ func (q *Queries) ListMatchModerators(ctx context.Context, channelID string) ([]
 	items := []Moderator{}
 	for rows.Next() {
 		var i Moderator
-		if err := rows.Scan(&i.ChannelID, &i.UserID); err != nil {
+		if err := rows.Scan(&i.MatchID, &i.UserID); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
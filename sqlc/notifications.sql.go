@@ -11,9 +11,10 @@ import (
 
 const addNotification = `-- name: AddNotification :exec
 INSERT INTO notifications (
-    channel_id,
+    match_id,
     notify_at,
     custom_text,
+    generated,
     created_by,
     created_at,
     updated_by,
@@ -25,14 +26,16 @@ INSERT INTO notifications (
     ?4,
     ?5,
     ?6,
-    ?7
+    ?7,
+    ?8
 )
 `
 
 type AddNotificationParams struct {
-	ChannelID  string `db:"channel_id"`
+	MatchID    int64  `db:"match_id"`
 	NotifyAt   int64  `db:"notify_at"`
 	CustomText string `db:"custom_text"`
+	Generated  int64  `db:"generated"`
 	CreatedBy  string `db:"created_by"`
 	CreatedAt  int64  `db:"created_at"`
 	UpdatedBy  string `db:"updated_by"`
@@ -41,9 +44,10 @@ type AddNotificationParams struct {
 
 func (q *Queries) AddNotification(ctx context.Context, arg AddNotificationParams) error {
 	_, err := q.exec(ctx, q.addNotificationStmt, addNotification,
-		arg.ChannelID,
+		arg.MatchID,
 		arg.NotifyAt,
 		arg.CustomText,
+		arg.Generated,
 		arg.CreatedBy,
 		arg.CreatedAt,
 		arg.UpdatedBy,
@@ -67,84 +71,95 @@ func (q *Queries) CountAllNotifications(ctx context.Context) (int64, error) {
 const countNotifications = `-- name: CountNotifications :one
 SELECT COUNT(*)
 FROM notifications
-WHERE channel_id = ?1
+WHERE match_id = ?1
 `
 
-func (q *Queries) CountNotifications(ctx context.Context, channelID string) (int64, error) {
-	row := q.queryRow(ctx, q.countNotificationsStmt, countNotifications, channelID)
+func (q *Queries) CountNotifications(ctx context.Context, matchID int64) (int64, error) {
+	row := q.queryRow(ctx, q.countNotificationsStmt, countNotifications, matchID)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
 }
 
+const deleteMatchGeneratedNotifications = `-- name: DeleteMatchGeneratedNotifications :exec
+DELETE FROM notifications
+WHERE match_id = ?1
+AND generated = 1
+`
+
+func (q *Queries) DeleteMatchGeneratedNotifications(ctx context.Context, matchID int64) error {
+	_, err := q.exec(ctx, q.deleteMatchGeneratedNotificationsStmt, deleteMatchGeneratedNotifications, matchID)
+	return err
+}
+
 const deleteMatchNotifications = `-- name: DeleteMatchNotifications :exec
 DELETE FROM notifications
-WHERE channel_id = ?1
+WHERE match_id = ?1
 `
 
-func (q *Queries) DeleteMatchNotifications(ctx context.Context, channelID string) error {
-	_, err := q.exec(ctx, q.deleteMatchNotificationsStmt, deleteMatchNotifications, channelID)
+func (q *Queries) DeleteMatchNotifications(ctx context.Context, matchID int64) error {
+	_, err := q.exec(ctx, q.deleteMatchNotificationsStmt, deleteMatchNotifications, matchID)
 	return err
 }
 
 const deleteNotification = `-- name: DeleteNotification :exec
 DELETE FROM notifications
-WHERE channel_id = ?1
+WHERE match_id = ?1
 AND notify_at = ?2
 `
 
 type DeleteNotificationParams struct {
-	ChannelID string `db:"channel_id"`
-	NotifyAt  int64  `db:"notify_at"`
+	MatchID  int64 `db:"match_id"`
+	NotifyAt int64 `db:"notify_at"`
 }
 
 func (q *Queries) DeleteNotification(ctx context.Context, arg DeleteNotificationParams) error {
-	_, err := q.exec(ctx, q.deleteNotificationStmt, deleteNotification, arg.ChannelID, arg.NotifyAt)
+	_, err := q.exec(ctx, q.deleteNotificationStmt, deleteNotification, arg.MatchID, arg.NotifyAt)
 	return err
 }
 
 const getNotificationByOffset = `-- name: GetNotificationByOffset :one
-SELECT channel_id, notify_at, custom_text
+SELECT match_id, notify_at, custom_text
 FROM notifications
-WHERE channel_id = ?1
+WHERE match_id = ?1
 ORDER BY notify_at
 LIMIT 1
 OFFSET ?2
 `
 
 type GetNotificationByOffsetParams struct {
-	ChannelID string `db:"channel_id"`
-	Offset    int64  `db:"offset"`
+	MatchID int64 `db:"match_id"`
+	Offset  int64 `db:"offset"`
 }
 
 type GetNotificationByOffsetRow struct {
-	ChannelID  string `db:"channel_id"`
+	MatchID    int64  `db:"match_id"`
 	NotifyAt   int64  `db:"notify_at"`
 	CustomText string `db:"custom_text"`
 }
 
 func (q *Queries) GetNotificationByOffset(ctx context.Context, arg GetNotificationByOffsetParams) (GetNotificationByOffsetRow, error) {
-	row := q.queryRow(ctx, q.getNotificationByOffsetStmt, getNotificationByOffset, arg.ChannelID, arg.Offset)
+	row := q.queryRow(ctx, q.getNotificationByOffsetStmt, getNotificationByOffset, arg.MatchID, arg.Offset)
 	var i GetNotificationByOffsetRow
-	err := row.Scan(&i.ChannelID, &i.NotifyAt, &i.CustomText)
+	err := row.Scan(&i.MatchID, &i.NotifyAt, &i.CustomText)
 	return i, err
 }
 
 const listNotifications = `-- name: ListNotifications :many
-SELECT channel_id, notify_at, custom_text
+SELECT match_id, notify_at, custom_text
 FROM notifications
-WHERE channel_id = ?1
+WHERE match_id = ?1
 ORDER BY notify_at ASC
 `
 
 type ListNotificationsRow struct {
-	ChannelID  string `db:"channel_id"`
+	MatchID    int64  `db:"match_id"`
 	NotifyAt   int64  `db:"notify_at"`
 	CustomText string `db:"custom_text"`
 }
 
-func (q *Queries) ListNotifications(ctx context.Context, channelID string) ([]ListNotificationsRow, error) {
-	rows, err := q.query(ctx, q.listNotificationsStmt, listNotifications, channelID)
+func (q *Queries) ListNotifications(ctx context.Context, matchID int64) ([]ListNotificationsRow, error) {
+	rows, err := q.query(ctx, q.listNotificationsStmt, listNotifications, matchID)
 	if err != nil {
 		return nil, err
 	}
@@ -152,7 +167,7 @@ func (q *Queries) ListNotifications(ctx context.Context, channelID string) ([]Li
 	items := []ListNotificationsRow{}
 	for rows.Next() {
 		var i ListNotificationsRow
-		if err := rows.Scan(&i.ChannelID, &i.NotifyAt, &i.CustomText); err != nil {
+		if err := rows.Scan(&i.MatchID, &i.NotifyAt, &i.CustomText); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -168,18 +183,26 @@ func (q *Queries) ListNotifications(ctx context.Context, channelID string) ([]Li
 
 const listNowDueNotifications = `-- name: ListNowDueNotifications :many
 SELECT
-    channel_id,
+    match_id,
     notify_at,
     custom_text,
     created_at,
     created_by,
     updated_at,
-    updated_by
+    updated_by,
+    generated
 FROM notifications
 WHERE notify_at <= unixepoch('now')
+AND match_id IN (
+    SELECT match_id
+    FROM matches
+    WHERE channel_id != ''
+    OR channel_deleted = 1
+)
 ORDER BY notify_at ASC
 `
 
+// notifications are kept pending until the match channel exists
 func (q *Queries) ListNowDueNotifications(ctx context.Context) ([]Notification, error) {
 	rows, err := q.query(ctx, q.listNowDueNotificationsStmt, listNowDueNotifications)
 	if err != nil {
@@ -190,13 +213,14 @@ func (q *Queries) ListNowDueNotifications(ctx context.Context) ([]Notification,
 	for rows.Next() {
 		var i Notification
 		if err := rows.Scan(
-			&i.ChannelID,
+			&i.MatchID,
 			&i.NotifyAt,
 			&i.CustomText,
 			&i.CreatedAt,
 			&i.CreatedBy,
 			&i.UpdatedAt,
 			&i.UpdatedBy,
+			&i.Generated,
 		); err != nil {
 			return nil, err
 		}
@@ -213,14 +237,21 @@ func (q *Queries) ListNowDueNotifications(ctx context.Context) ([]Notification,
 
 const nextNotification = `-- name: NextNotification :one
 SELECT
-    channel_id,
+    match_id,
     notify_at,
     custom_text,
     created_at,
     created_by,
     updated_at,
-    updated_by
+    updated_by,
+    generated
 FROM notifications
+WHERE match_id IN (
+    SELECT match_id
+    FROM matches
+    WHERE channel_id != ''
+    OR channel_deleted = 1
+)
 ORDER BY notify_at ASC
 LIMIT 1
 `
@@ -229,13 +260,14 @@ func (q *Queries) NextNotification(ctx context.Context) (Notification, error) {
 	row := q.queryRow(ctx, q.nextNotificationStmt, nextNotification)
 	var i Notification
 	err := row.Scan(
-		&i.ChannelID,
+		&i.MatchID,
 		&i.NotifyAt,
 		&i.CustomText,
 		&i.CreatedAt,
 		&i.CreatedBy,
 		&i.UpdatedAt,
 		&i.UpdatedBy,
+		&i.Generated,
 	)
 	return i, err
 }
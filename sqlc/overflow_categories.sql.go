@@ -0,0 +1,134 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: overflow_categories.sql
+
+package sqlc
+
+import (
+	"context"
+)
+
+const addOverflowCategory = `-- name: AddOverflowCategory :exec
+INSERT INTO overflow_categories (
+    category_id,
+    guild_id,
+    division,
+    number
+) VALUES (
+    ?1,
+    ?2,
+    ?3,
+    ?4
+)
+`
+
+type AddOverflowCategoryParams struct {
+	CategoryID string `db:"category_id"`
+	GuildID    string `db:"guild_id"`
+	Division   string `db:"division"`
+	Number     int64  `db:"number"`
+}
+
+func (q *Queries) AddOverflowCategory(ctx context.Context, arg AddOverflowCategoryParams) error {
+	_, err := q.exec(ctx, q.addOverflowCategoryStmt, addOverflowCategory,
+		arg.CategoryID,
+		arg.GuildID,
+		arg.Division,
+		arg.Number,
+	)
+	return err
+}
+
+const deleteDivisionOverflowCategories = `-- name: DeleteDivisionOverflowCategories :exec
+DELETE FROM overflow_categories
+WHERE guild_id = ?1
+AND division = ?2
+`
+
+type DeleteDivisionOverflowCategoriesParams struct {
+	GuildID  string `db:"guild_id"`
+	Division string `db:"division"`
+}
+
+func (q *Queries) DeleteDivisionOverflowCategories(ctx context.Context, arg DeleteDivisionOverflowCategoriesParams) error {
+	_, err := q.exec(ctx, q.deleteDivisionOverflowCategoriesStmt, deleteDivisionOverflowCategories, arg.GuildID, arg.Division)
+	return err
+}
+
+const deleteOverflowCategory = `-- name: DeleteOverflowCategory :exec
+DELETE FROM overflow_categories
+WHERE category_id = ?1
+`
+
+func (q *Queries) DeleteOverflowCategory(ctx context.Context, categoryID string) error {
+	_, err := q.exec(ctx, q.deleteOverflowCategoryStmt, deleteOverflowCategory, categoryID)
+	return err
+}
+
+const getOverflowCategory = `-- name: GetOverflowCategory :one
+SELECT
+    category_id,
+    guild_id,
+    division,
+    number
+FROM overflow_categories
+WHERE category_id = ?1
+`
+
+func (q *Queries) GetOverflowCategory(ctx context.Context, categoryID string) (OverflowCategory, error) {
+	row := q.queryRow(ctx, q.getOverflowCategoryStmt, getOverflowCategory, categoryID)
+	var i OverflowCategory
+	err := row.Scan(
+		&i.CategoryID,
+		&i.GuildID,
+		&i.Division,
+		&i.Number,
+	)
+	return i, err
+}
+
+const listOverflowCategories = `-- name: ListOverflowCategories :many
+SELECT
+    category_id,
+    guild_id,
+    division,
+    number
+FROM overflow_categories
+WHERE guild_id = ?1
+AND division = ?2
+ORDER BY number
+`
+
+type ListOverflowCategoriesParams struct {
+	GuildID  string `db:"guild_id"`
+	Division string `db:"division"`
+}
+
+func (q *Queries) ListOverflowCategories(ctx context.Context, arg ListOverflowCategoriesParams) ([]OverflowCategory, error) {
+	rows, err := q.query(ctx, q.listOverflowCategoriesStmt, listOverflowCategories, arg.GuildID, arg.Division)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []OverflowCategory{}
+	for rows.Next() {
+		var i OverflowCategory
+		if err := rows.Scan(
+			&i.CategoryID,
+			&i.GuildID,
+			&i.Division,
+			&i.Number,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,232 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: participants.sql
+
+package sqlc
+
+import (
+	"context"
+)
+
+const addParticipant = `-- name: AddParticipant :exec
+INSERT INTO participants (
+    match_id,
+    role_id,
+    user_id,
+    joined_at,
+    reaction,
+    substitute
+) VALUES (
+    ?1,
+    ?2,
+    ?3,
+    ?4,
+    ?5,
+    ?6
+)
+`
+
+type AddParticipantParams struct {
+	MatchID    int64  `db:"match_id"`
+	RoleID     string `db:"role_id"`
+	UserID     string `db:"user_id"`
+	JoinedAt   int64  `db:"joined_at"`
+	Reaction   int64  `db:"reaction"`
+	Substitute int64  `db:"substitute"`
+}
+
+func (q *Queries) AddParticipant(ctx context.Context, arg AddParticipantParams) error {
+	_, err := q.exec(ctx, q.addParticipantStmt, addParticipant,
+		arg.MatchID,
+		arg.RoleID,
+		arg.UserID,
+		arg.JoinedAt,
+		arg.Reaction,
+		arg.Substitute,
+	)
+	return err
+}
+
+const countTeamStarters = `-- name: CountTeamStarters :one
+SELECT COUNT(*)
+FROM participants
+WHERE match_id = ?1
+AND role_id = ?2
+AND substitute = 0
+`
+
+type CountTeamStartersParams struct {
+	MatchID int64  `db:"match_id"`
+	RoleID  string `db:"role_id"`
+}
+
+func (q *Queries) CountTeamStarters(ctx context.Context, arg CountTeamStartersParams) (int64, error) {
+	row := q.queryRow(ctx, q.countTeamStartersStmt, countTeamStarters, arg.MatchID, arg.RoleID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countTeamSubstitutes = `-- name: CountTeamSubstitutes :one
+SELECT COUNT(*)
+FROM participants
+WHERE match_id = ?1
+AND role_id = ?2
+AND substitute = 1
+`
+
+type CountTeamSubstitutesParams struct {
+	MatchID int64  `db:"match_id"`
+	RoleID  string `db:"role_id"`
+}
+
+func (q *Queries) CountTeamSubstitutes(ctx context.Context, arg CountTeamSubstitutesParams) (int64, error) {
+	row := q.queryRow(ctx, q.countTeamSubstitutesStmt, countTeamSubstitutes, arg.MatchID, arg.RoleID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const getFirstTeamSubstitute = `-- name: GetFirstTeamSubstitute :one
+SELECT
+    match_id,
+    role_id,
+    user_id,
+    joined_at,
+    reaction,
+    substitute
+FROM participants
+WHERE match_id = ?1
+AND role_id = ?2
+AND substitute = 1
+ORDER BY joined_at, user_id
+LIMIT 1
+`
+
+type GetFirstTeamSubstituteParams struct {
+	MatchID int64  `db:"match_id"`
+	RoleID  string `db:"role_id"`
+}
+
+func (q *Queries) GetFirstTeamSubstitute(ctx context.Context, arg GetFirstTeamSubstituteParams) (Participant, error) {
+	row := q.queryRow(ctx, q.getFirstTeamSubstituteStmt, getFirstTeamSubstitute, arg.MatchID, arg.RoleID)
+	var i Participant
+	err := row.Scan(
+		&i.MatchID,
+		&i.RoleID,
+		&i.UserID,
+		&i.JoinedAt,
+		&i.Reaction,
+		&i.Substitute,
+	)
+	return i, err
+}
+
+const getParticipant = `-- name: GetParticipant :one
+SELECT
+    match_id,
+    role_id,
+    user_id,
+    joined_at,
+    reaction,
+    substitute
+FROM participants
+WHERE match_id = ?1
+AND user_id = ?2
+`
+
+type GetParticipantParams struct {
+	MatchID int64  `db:"match_id"`
+	UserID  string `db:"user_id"`
+}
+
+func (q *Queries) GetParticipant(ctx context.Context, arg GetParticipantParams) (Participant, error) {
+	row := q.queryRow(ctx, q.getParticipantStmt, getParticipant, arg.MatchID, arg.UserID)
+	var i Participant
+	err := row.Scan(
+		&i.MatchID,
+		&i.RoleID,
+		&i.UserID,
+		&i.JoinedAt,
+		&i.Reaction,
+		&i.Substitute,
+	)
+	return i, err
+}
+
+const listParticipants = `-- name: ListParticipants :many
+SELECT
+    match_id,
+    role_id,
+    user_id,
+    joined_at,
+    reaction,
+    substitute
+FROM participants
+WHERE match_id = ?1
+ORDER BY joined_at, user_id
+`
+
+func (q *Queries) ListParticipants(ctx context.Context, matchID int64) ([]Participant, error) {
+	rows, err := q.query(ctx, q.listParticipantsStmt, listParticipants, matchID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Participant{}
+	for rows.Next() {
+		var i Participant
+		if err := rows.Scan(
+			&i.MatchID,
+			&i.RoleID,
+			&i.UserID,
+			&i.JoinedAt,
+			&i.Reaction,
+			&i.Substitute,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const promoteSubstitute = `-- name: PromoteSubstitute :exec
+UPDATE participants
+SET substitute = 0
+WHERE match_id = ?1
+AND user_id = ?2
+`
+
+type PromoteSubstituteParams struct {
+	MatchID int64  `db:"match_id"`
+	UserID  string `db:"user_id"`
+}
+
+func (q *Queries) PromoteSubstitute(ctx context.Context, arg PromoteSubstituteParams) error {
+	_, err := q.exec(ctx, q.promoteSubstituteStmt, promoteSubstitute, arg.MatchID, arg.UserID)
+	return err
+}
+
+const removeParticipant = `-- name: RemoveParticipant :exec
+DELETE FROM participants
+WHERE match_id = ?1
+AND user_id = ?2
+`
+
+type RemoveParticipantParams struct {
+	MatchID int64  `db:"match_id"`
+	UserID  string `db:"user_id"`
+}
+
+func (q *Queries) RemoveParticipant(ctx context.Context, arg RemoveParticipantParams) error {
+	_, err := q.exec(ctx, q.removeParticipantStmt, removeParticipant, arg.MatchID, arg.UserID)
+	return err
+}
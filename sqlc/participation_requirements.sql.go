@@ -11,7 +11,7 @@ import (
 
 const addParticipationRequirements = `-- name: AddParticipationRequirements :exec
 INSERT INTO participation_requirements (
-    channel_id,
+    match_id,
     participants_per_team,
     deadline_at,
     entry_closed
@@ -24,15 +24,15 @@ INSERT INTO participation_requirements (
 `
 
 type AddParticipationRequirementsParams struct {
-	ChannelID           string `db:"channel_id"`
-	ParticipantsPerTeam int64  `db:"participants_per_team"`
-	DeadlineAt          int64  `db:"deadline_at"`
-	EntryClosed         int64  `db:"entry_closed"`
+	MatchID             int64 `db:"match_id"`
+	ParticipantsPerTeam int64 `db:"participants_per_team"`
+	DeadlineAt          int64 `db:"deadline_at"`
+	EntryClosed         int64 `db:"entry_closed"`
 }
 
 func (q *Queries) AddParticipationRequirements(ctx context.Context, arg AddParticipationRequirementsParams) error {
 	_, err := q.exec(ctx, q.addParticipationRequirementsStmt, addParticipationRequirements,
-		arg.ChannelID,
+		arg.MatchID,
 		arg.ParticipantsPerTeam,
 		arg.DeadlineAt,
 		arg.EntryClosed,
@@ -44,39 +44,63 @@ const closeParticipationEntry = `-- name: CloseParticipationEntry :exec
 UPDATE participation_requirements
 SET
     entry_closed = 1
-WHERE channel_id = ?1
+WHERE match_id = ?1
 `
 
-func (q *Queries) CloseParticipationEntry(ctx context.Context, channelID string) error {
-	_, err := q.exec(ctx, q.closeParticipationEntryStmt, closeParticipationEntry, channelID)
+func (q *Queries) CloseParticipationEntry(ctx context.Context, matchID int64) error {
+	_, err := q.exec(ctx, q.closeParticipationEntryStmt, closeParticipationEntry, matchID)
 	return err
 }
 
 const deleteParticipationRequirements = `-- name: DeleteParticipationRequirements :exec
 DELETE FROM participation_requirements
-WHERE channel_id = ?1
+WHERE match_id = ?1
 `
 
-func (q *Queries) DeleteParticipationRequirements(ctx context.Context, channelID string) error {
-	_, err := q.exec(ctx, q.deleteParticipationRequirementsStmt, deleteParticipationRequirements, channelID)
+func (q *Queries) DeleteParticipationRequirements(ctx context.Context, matchID int64) error {
+	_, err := q.exec(ctx, q.deleteParticipationRequirementsStmt, deleteParticipationRequirements, matchID)
 	return err
 }
 
 const getParticipationRequirements = `-- name: GetParticipationRequirements :one
 SELECT
-    channel_id,
+    match_id,
     participants_per_team,
     deadline_at,
     entry_closed
 FROM participation_requirements
-WHERE channel_id = ?1
+WHERE match_id = ?1
 `
 
-func (q *Queries) GetParticipationRequirements(ctx context.Context, channelID string) (ParticipationRequirement, error) {
-	row := q.queryRow(ctx, q.getParticipationRequirementsStmt, getParticipationRequirements, channelID)
+func (q *Queries) GetParticipationRequirements(ctx context.Context, matchID int64) (ParticipationRequirement, error) {
+	row := q.queryRow(ctx, q.getParticipationRequirementsStmt, getParticipationRequirements, matchID)
 	var i ParticipationRequirement
 	err := row.Scan(
-		&i.ChannelID,
+		&i.MatchID,
+		&i.ParticipantsPerTeam,
+		&i.DeadlineAt,
+		&i.EntryClosed,
+	)
+	return i, err
+}
+
+const getParticipationRequirementsByChannel = `-- name: GetParticipationRequirementsByChannel :one
+SELECT
+    pr.match_id,
+    pr.participants_per_team,
+    pr.deadline_at,
+    pr.entry_closed
+FROM participation_requirements pr
+JOIN matches m ON m.match_id = pr.match_id
+WHERE m.channel_id = ?1
+AND m.channel_id != ''
+`
+
+func (q *Queries) GetParticipationRequirementsByChannel(ctx context.Context, channelID string) (ParticipationRequirement, error) {
+	row := q.queryRow(ctx, q.getParticipationRequirementsByChannelStmt, getParticipationRequirementsByChannel, channelID)
+	var i ParticipationRequirement
+	err := row.Scan(
+		&i.MatchID,
 		&i.ParticipantsPerTeam,
 		&i.DeadlineAt,
 		&i.EntryClosed,
@@ -86,16 +110,23 @@ func (q *Queries) GetParticipationRequirements(ctx context.Context, channelID st
 
 const listNowDueParticipationRequirements = `-- name: ListNowDueParticipationRequirements :many
 SELECT
-    channel_id,
+    match_id,
     participants_per_team,
     deadline_at,
     entry_closed
 FROM participation_requirements
 WHERE participation_requirements.deadline_at <= unixepoch('now')
 AND participation_requirements.entry_closed = 0
+AND participation_requirements.match_id IN (
+    SELECT match_id
+    FROM matches
+    WHERE channel_id != ''
+    OR channel_deleted = 1
+)
 ORDER BY deadline_at ASC
 `
 
+// the deadline is deferred until the match channel exists
 func (q *Queries) ListNowDueParticipationRequirements(ctx context.Context) ([]ParticipationRequirement, error) {
 	rows, err := q.query(ctx, q.listNowDueParticipationRequirementsStmt, listNowDueParticipationRequirements)
 	if err != nil {
@@ -106,7 +137,7 @@ func (q *Queries) ListNowDueParticipationRequirements(ctx context.Context) ([]Pa
 	for rows.Next() {
 		var i ParticipationRequirement
 		if err := rows.Scan(
-			&i.ChannelID,
+			&i.MatchID,
 			&i.ParticipantsPerTeam,
 			&i.DeadlineAt,
 			&i.EntryClosed,
@@ -124,14 +155,79 @@ func (q *Queries) ListNowDueParticipationRequirements(ctx context.Context) ([]Pa
 	return items, nil
 }
 
+const listOpenLegacyParticipationRequirements = `-- name: ListOpenLegacyParticipationRequirements :many
+SELECT
+    pr.match_id,
+    pr.participants_per_team,
+    m.guild_id,
+    m.channel_id,
+    m.message_id
+FROM participation_requirements pr
+JOIN matches m ON m.match_id = pr.match_id
+WHERE pr.entry_closed = 0
+AND m.cancelled_at = 0
+AND m.message_id != ''
+AND EXISTS (
+    SELECT 1
+    FROM participants p
+    WHERE p.match_id = pr.match_id
+    AND p.reaction = 1
+)
+ORDER BY pr.deadline_at ASC
+`
+
+type ListOpenLegacyParticipationRequirementsRow struct {
+	MatchID             int64  `db:"match_id"`
+	ParticipantsPerTeam int64  `db:"participants_per_team"`
+	GuildID             string `db:"guild_id"`
+	ChannelID           string `db:"channel_id"`
+	MessageID           string `db:"message_id"`
+}
+
+// only legacy match messages have participation reactions
+func (q *Queries) ListOpenLegacyParticipationRequirements(ctx context.Context) ([]ListOpenLegacyParticipationRequirementsRow, error) {
+	rows, err := q.query(ctx, q.listOpenLegacyParticipationRequirementsStmt, listOpenLegacyParticipationRequirements)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListOpenLegacyParticipationRequirementsRow{}
+	for rows.Next() {
+		var i ListOpenLegacyParticipationRequirementsRow
+		if err := rows.Scan(
+			&i.MatchID,
+			&i.ParticipantsPerTeam,
+			&i.GuildID,
+			&i.ChannelID,
+			&i.MessageID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const nextParticipationRequirement = `-- name: NextParticipationRequirement :one
 SELECT
-    channel_id,
+    match_id,
     participants_per_team,
     deadline_at,
     entry_closed
 FROM participation_requirements
 WHERE participation_requirements.entry_closed = 0
+AND participation_requirements.match_id IN (
+    SELECT match_id
+    FROM matches
+    WHERE channel_id != ''
+    OR channel_deleted = 1
+)
 ORDER BY deadline_at ASC
 LIMIT 1
 `
@@ -140,7 +236,7 @@ func (q *Queries) NextParticipationRequirement(ctx context.Context) (Participati
 	row := q.queryRow(ctx, q.nextParticipationRequirementStmt, nextParticipationRequirement)
 	var i ParticipationRequirement
 	err := row.Scan(
-		&i.ChannelID,
+		&i.MatchID,
 		&i.ParticipantsPerTeam,
 		&i.DeadlineAt,
 		&i.EntryClosed,
@@ -154,14 +250,14 @@ SET
     participants_per_team = ?1,
     deadline_at = ?2,
     entry_closed = ?3
-WHERE channel_id = ?4
+WHERE match_id = ?4
 `
 
 type UpdateParticipationRequirementsParams struct {
-	ParticipantsPerTeam int64  `db:"participants_per_team"`
-	DeadlineAt          int64  `db:"deadline_at"`
-	EntryClosed         int64  `db:"entry_closed"`
-	ChannelID           string `db:"channel_id"`
+	ParticipantsPerTeam int64 `db:"participants_per_team"`
+	DeadlineAt          int64 `db:"deadline_at"`
+	EntryClosed         int64 `db:"entry_closed"`
+	MatchID             int64 `db:"match_id"`
 }
 
 func (q *Queries) UpdateParticipationRequirements(ctx context.Context, arg UpdateParticipationRequirementsParams) error {
@@ -169,7 +265,7 @@ func (q *Queries) UpdateParticipationRequirements(ctx context.Context, arg Updat
 		arg.ParticipantsPerTeam,
 		arg.DeadlineAt,
 		arg.EntryClosed,
-		arg.ChannelID,
+		arg.MatchID,
 	)
 	return err
 }
@@ -0,0 +1,226 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: ratings.sql
+
+package sqlc
+
+import (
+	"context"
+)
+
+const addRatingHistory = `-- name: AddRatingHistory :exec
+INSERT OR REPLACE INTO rating_history (
+    guild_id,
+    role_id,
+    match_id,
+    rating_before,
+    rating_after,
+    rated_at
+) VALUES (
+    ?1,
+    ?2,
+    ?3,
+    ?4,
+    ?5,
+    ?6
+)
+`
+
+type AddRatingHistoryParams struct {
+	GuildID      string  `db:"guild_id"`
+	RoleID       string  `db:"role_id"`
+	MatchID      int64   `db:"match_id"`
+	RatingBefore float64 `db:"rating_before"`
+	RatingAfter  float64 `db:"rating_after"`
+	RatedAt      int64   `db:"rated_at"`
+}
+
+func (q *Queries) AddRatingHistory(ctx context.Context, arg AddRatingHistoryParams) error {
+	_, err := q.exec(ctx, q.addRatingHistoryStmt, addRatingHistory,
+		arg.GuildID,
+		arg.RoleID,
+		arg.MatchID,
+		arg.RatingBefore,
+		arg.RatingAfter,
+		arg.RatedAt,
+	)
+	return err
+}
+
+const addTeamRating = `-- name: AddTeamRating :exec
+INSERT OR REPLACE INTO team_ratings (
+    guild_id,
+    role_id,
+    rating,
+    matches,
+    updated_at
+) VALUES (
+    ?1,
+    ?2,
+    ?3,
+    ?4,
+    ?5
+)
+`
+
+type AddTeamRatingParams struct {
+	GuildID   string  `db:"guild_id"`
+	RoleID    string  `db:"role_id"`
+	Rating    float64 `db:"rating"`
+	Matches   int64   `db:"matches"`
+	UpdatedAt int64   `db:"updated_at"`
+}
+
+func (q *Queries) AddTeamRating(ctx context.Context, arg AddTeamRatingParams) error {
+	_, err := q.exec(ctx, q.addTeamRatingStmt, addTeamRating,
+		arg.GuildID,
+		arg.RoleID,
+		arg.Rating,
+		arg.Matches,
+		arg.UpdatedAt,
+	)
+	return err
+}
+
+const deleteGuildRatingHistory = `-- name: DeleteGuildRatingHistory :exec
+DELETE FROM rating_history
+WHERE guild_id = ?1
+`
+
+func (q *Queries) DeleteGuildRatingHistory(ctx context.Context, guildID string) error {
+	_, err := q.exec(ctx, q.deleteGuildRatingHistoryStmt, deleteGuildRatingHistory, guildID)
+	return err
+}
+
+const deleteGuildTeamRatings = `-- name: DeleteGuildTeamRatings :exec
+DELETE FROM team_ratings
+WHERE guild_id = ?1
+`
+
+func (q *Queries) DeleteGuildTeamRatings(ctx context.Context, guildID string) error {
+	_, err := q.exec(ctx, q.deleteGuildTeamRatingsStmt, deleteGuildTeamRatings, guildID)
+	return err
+}
+
+const getTeamRating = `-- name: GetTeamRating :one
+SELECT
+    guild_id,
+    role_id,
+    rating,
+    matches,
+    updated_at
+FROM team_ratings
+WHERE guild_id = ?1
+AND role_id = ?2
+`
+
+type GetTeamRatingParams struct {
+	GuildID string `db:"guild_id"`
+	RoleID  string `db:"role_id"`
+}
+
+func (q *Queries) GetTeamRating(ctx context.Context, arg GetTeamRatingParams) (TeamRating, error) {
+	row := q.queryRow(ctx, q.getTeamRatingStmt, getTeamRating, arg.GuildID, arg.RoleID)
+	var i TeamRating
+	err := row.Scan(
+		&i.GuildID,
+		&i.RoleID,
+		&i.Rating,
+		&i.Matches,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listGuildTeamRatings = `-- name: ListGuildTeamRatings :many
+SELECT
+    guild_id,
+    role_id,
+    rating,
+    matches,
+    updated_at
+FROM team_ratings
+WHERE guild_id = ?1
+ORDER BY rating DESC, role_id
+`
+
+func (q *Queries) ListGuildTeamRatings(ctx context.Context, guildID string) ([]TeamRating, error) {
+	rows, err := q.query(ctx, q.listGuildTeamRatingsStmt, listGuildTeamRatings, guildID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []TeamRating{}
+	for rows.Next() {
+		var i TeamRating
+		if err := rows.Scan(
+			&i.GuildID,
+			&i.RoleID,
+			&i.Rating,
+			&i.Matches,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTeamRatingHistory = `-- name: ListTeamRatingHistory :many
+SELECT
+    guild_id,
+    role_id,
+    match_id,
+    rating_before,
+    rating_after,
+    rated_at
+FROM rating_history
+WHERE guild_id = ?1
+AND role_id = ?2
+ORDER BY rated_at DESC, match_id DESC
+LIMIT ?3
+`
+
+type ListTeamRatingHistoryParams struct {
+	GuildID string `db:"guild_id"`
+	RoleID  string `db:"role_id"`
+	Limit   int64  `db:"limit"`
+}
+
+func (q *Queries) ListTeamRatingHistory(ctx context.Context, arg ListTeamRatingHistoryParams) ([]RatingHistory, error) {
+	rows, err := q.query(ctx, q.listTeamRatingHistoryStmt, listTeamRatingHistory, arg.GuildID, arg.RoleID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []RatingHistory{}
+	for rows.Next() {
+		var i RatingHistory
+		if err := rows.Scan(
+			&i.GuildID,
+			&i.RoleID,
+			&i.MatchID,
+			&i.RatingBefore,
+			&i.RatingAfter,
+			&i.RatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,135 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: reschedule_proposals.sql
+
+package sqlc
+
+import (
+	"context"
+)
+
+const addRescheduleProposal = `-- name: AddRescheduleProposal :exec
+INSERT OR REPLACE INTO reschedule_proposals (
+    match_id,
+    role_id,
+    scheduled_at,
+    channel_id,
+    message_id,
+    proposed_at,
+    proposed_by
+) VALUES (
+    ?1,
+    ?2,
+    ?3,
+    ?4,
+    ?5,
+    ?6,
+    ?7
+)
+`
+
+type AddRescheduleProposalParams struct {
+	MatchID     int64  `db:"match_id"`
+	RoleID      string `db:"role_id"`
+	ScheduledAt int64  `db:"scheduled_at"`
+	ChannelID   string `db:"channel_id"`
+	MessageID   string `db:"message_id"`
+	ProposedAt  int64  `db:"proposed_at"`
+	ProposedBy  string `db:"proposed_by"`
+}
+
+func (q *Queries) AddRescheduleProposal(ctx context.Context, arg AddRescheduleProposalParams) error {
+	_, err := q.exec(ctx, q.addRescheduleProposalStmt, addRescheduleProposal,
+		arg.MatchID,
+		arg.RoleID,
+		arg.ScheduledAt,
+		arg.ChannelID,
+		arg.MessageID,
+		arg.ProposedAt,
+		arg.ProposedBy,
+	)
+	return err
+}
+
+const deleteRescheduleProposal = `-- name: DeleteRescheduleProposal :exec
+DELETE FROM reschedule_proposals
+WHERE match_id = ?1
+`
+
+func (q *Queries) DeleteRescheduleProposal(ctx context.Context, matchID int64) error {
+	_, err := q.exec(ctx, q.deleteRescheduleProposalStmt, deleteRescheduleProposal, matchID)
+	return err
+}
+
+const getRescheduleProposal = `-- name: GetRescheduleProposal :one
+SELECT
+    match_id,
+    role_id,
+    scheduled_at,
+    channel_id,
+    message_id,
+    proposed_at,
+    proposed_by
+FROM reschedule_proposals
+WHERE match_id = ?1
+`
+
+func (q *Queries) GetRescheduleProposal(ctx context.Context, matchID int64) (RescheduleProposal, error) {
+	row := q.queryRow(ctx, q.getRescheduleProposalStmt, getRescheduleProposal, matchID)
+	var i RescheduleProposal
+	err := row.Scan(
+		&i.MatchID,
+		&i.RoleID,
+		&i.ScheduledAt,
+		&i.ChannelID,
+		&i.MessageID,
+		&i.ProposedAt,
+		&i.ProposedBy,
+	)
+	return i, err
+}
+
+const getRescheduleProposalByMessage = `-- name: GetRescheduleProposalByMessage :one
+SELECT
+    match_id,
+    role_id,
+    scheduled_at,
+    channel_id,
+    message_id,
+    proposed_at,
+    proposed_by
+FROM reschedule_proposals
+WHERE message_id = ?1
+`
+
+func (q *Queries) GetRescheduleProposalByMessage(ctx context.Context, messageID string) (RescheduleProposal, error) {
+	row := q.queryRow(ctx, q.getRescheduleProposalByMessageStmt, getRescheduleProposalByMessage, messageID)
+	var i RescheduleProposal
+	err := row.Scan(
+		&i.MatchID,
+		&i.RoleID,
+		&i.ScheduledAt,
+		&i.ChannelID,
+		&i.MessageID,
+		&i.ProposedAt,
+		&i.ProposedBy,
+	)
+	return i, err
+}
+
+const updateRescheduleProposalMessage = `-- name: UpdateRescheduleProposalMessage :exec
+UPDATE reschedule_proposals
+SET message_id = ?1
+WHERE match_id = ?2
+`
+
+type UpdateRescheduleProposalMessageParams struct {
+	MessageID string `db:"message_id"`
+	MatchID   int64  `db:"match_id"`
+}
+
+func (q *Queries) UpdateRescheduleProposalMessage(ctx context.Context, arg UpdateRescheduleProposalMessageParams) error {
+	_, err := q.exec(ctx, q.updateRescheduleProposalMessageStmt, updateRescheduleProposalMessage, arg.MessageID, arg.MatchID)
+	return err
+}
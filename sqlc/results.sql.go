@@ -0,0 +1,475 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: results.sql
+
+package sqlc
+
+import (
+	"context"
+)
+
+const addResult = `-- name: AddResult :exec
+INSERT INTO results (
+    match_id,
+    guild_id,
+    channel_name,
+    scheduled_at,
+    reported_at,
+    reported_by,
+    division
+) VALUES (
+    ?1,
+    ?2,
+    ?3,
+    ?4,
+    ?5,
+    ?6,
+    ?7
+) ON CONFLICT (match_id) DO UPDATE SET
+    channel_name = excluded.channel_name,
+    division = excluded.division,
+    scheduled_at = excluded.scheduled_at,
+    reported_at = excluded.reported_at,
+    reported_by = excluded.reported_by,
+    status = 'PENDING',
+    finalized_at = 0,
+    finalized_by = ''
+`
+
+type AddResultParams struct {
+	MatchID     int64  `db:"match_id"`
+	GuildID     string `db:"guild_id"`
+	ChannelName string `db:"channel_name"`
+	ScheduledAt int64  `db:"scheduled_at"`
+	ReportedAt  int64  `db:"reported_at"`
+	ReportedBy  string `db:"reported_by"`
+	Division    string `db:"division"`
+}
+
+func (q *Queries) AddResult(ctx context.Context, arg AddResultParams) error {
+	_, err := q.exec(ctx, q.addResultStmt, addResult,
+		arg.MatchID,
+		arg.GuildID,
+		arg.ChannelName,
+		arg.ScheduledAt,
+		arg.ReportedAt,
+		arg.ReportedBy,
+		arg.Division,
+	)
+	return err
+}
+
+const addResultConfirmation = `-- name: AddResultConfirmation :exec
+INSERT INTO result_confirmations (
+    match_id,
+    role_id,
+    user_id,
+    confirmed_at
+) VALUES (
+    ?1,
+    ?2,
+    ?3,
+    ?4
+) ON CONFLICT (match_id, role_id) DO UPDATE SET
+    user_id = excluded.user_id,
+    confirmed_at = excluded.confirmed_at
+`
+
+type AddResultConfirmationParams struct {
+	MatchID     int64  `db:"match_id"`
+	RoleID      string `db:"role_id"`
+	UserID      string `db:"user_id"`
+	ConfirmedAt int64  `db:"confirmed_at"`
+}
+
+func (q *Queries) AddResultConfirmation(ctx context.Context, arg AddResultConfirmationParams) error {
+	_, err := q.exec(ctx, q.addResultConfirmationStmt, addResultConfirmation,
+		arg.MatchID,
+		arg.RoleID,
+		arg.UserID,
+		arg.ConfirmedAt,
+	)
+	return err
+}
+
+const addTeamResult = `-- name: AddTeamResult :exec
+INSERT INTO team_results (
+    match_id,
+    role_id,
+    score,
+    time,
+    screenshot,
+    screenshot_name,
+    demo,
+    demo_name,
+    reported_at,
+    reported_by
+) VALUES (
+    ?1,
+    ?2,
+    ?3,
+    ?4,
+    ?5,
+    ?6,
+    ?7,
+    ?8,
+    ?9,
+    ?10
+) ON CONFLICT (match_id, role_id) DO UPDATE SET
+    score = excluded.score,
+    time = excluded.time,
+    screenshot = COALESCE(excluded.screenshot, team_results.screenshot),
+    screenshot_name = CASE WHEN excluded.screenshot IS NULL THEN team_results.screenshot_name ELSE excluded.screenshot_name END,
+    demo = COALESCE(excluded.demo, team_results.demo),
+    demo_name = CASE WHEN excluded.demo IS NULL THEN team_results.demo_name ELSE excluded.demo_name END,
+    reported_at = excluded.reported_at,
+    reported_by = excluded.reported_by
+`
+
+type AddTeamResultParams struct {
+	MatchID        int64  `db:"match_id"`
+	RoleID         string `db:"role_id"`
+	Score          int64  `db:"score"`
+	Time           int64  `db:"time"`
+	Screenshot     []byte `db:"screenshot"`
+	ScreenshotName string `db:"screenshot_name"`
+	Demo           []byte `db:"demo"`
+	DemoName       string `db:"demo_name"`
+	ReportedAt     int64  `db:"reported_at"`
+	ReportedBy     string `db:"reported_by"`
+}
+
+func (q *Queries) AddTeamResult(ctx context.Context, arg AddTeamResultParams) error {
+	_, err := q.exec(ctx, q.addTeamResultStmt, addTeamResult,
+		arg.MatchID,
+		arg.RoleID,
+		arg.Score,
+		arg.Time,
+		arg.Screenshot,
+		arg.ScreenshotName,
+		arg.Demo,
+		arg.DemoName,
+		arg.ReportedAt,
+		arg.ReportedBy,
+	)
+	return err
+}
+
+const countResultConfirmations = `-- name: CountResultConfirmations :one
+SELECT COUNT(*)
+FROM result_confirmations
+WHERE match_id = ?1
+`
+
+func (q *Queries) CountResultConfirmations(ctx context.Context, matchID int64) (int64, error) {
+	row := q.queryRow(ctx, q.countResultConfirmationsStmt, countResultConfirmations, matchID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const deleteResultConfirmations = `-- name: DeleteResultConfirmations :exec
+DELETE FROM result_confirmations
+WHERE match_id = ?1
+`
+
+func (q *Queries) DeleteResultConfirmations(ctx context.Context, matchID int64) error {
+	_, err := q.exec(ctx, q.deleteResultConfirmationsStmt, deleteResultConfirmations, matchID)
+	return err
+}
+
+const getResult = `-- name: GetResult :one
+SELECT
+    match_id,
+    guild_id,
+    channel_name,
+    scheduled_at,
+    reported_at,
+    reported_by,
+    status,
+    message_id,
+    finalized_at,
+    finalized_by,
+    division,
+    message_channel_id
+FROM results
+WHERE match_id = ?1
+`
+
+func (q *Queries) GetResult(ctx context.Context, matchID int64) (Result, error) {
+	row := q.queryRow(ctx, q.getResultStmt, getResult, matchID)
+	var i Result
+	err := row.Scan(
+		&i.MatchID,
+		&i.GuildID,
+		&i.ChannelName,
+		&i.ScheduledAt,
+		&i.ReportedAt,
+		&i.ReportedBy,
+		&i.Status,
+		&i.MessageID,
+		&i.FinalizedAt,
+		&i.FinalizedBy,
+		&i.Division,
+		&i.MessageChannelID,
+	)
+	return i, err
+}
+
+const getResultByMessage = `-- name: GetResultByMessage :one
+SELECT
+    match_id,
+    guild_id,
+    channel_name,
+    scheduled_at,
+    reported_at,
+    reported_by,
+    status,
+    message_id,
+    finalized_at,
+    finalized_by,
+    division,
+    message_channel_id
+FROM results
+WHERE message_channel_id = ?1
+AND message_id = ?2
+`
+
+type GetResultByMessageParams struct {
+	MessageChannelID string `db:"message_channel_id"`
+	MessageID        string `db:"message_id"`
+}
+
+func (q *Queries) GetResultByMessage(ctx context.Context, arg GetResultByMessageParams) (Result, error) {
+	row := q.queryRow(ctx, q.getResultByMessageStmt, getResultByMessage, arg.MessageChannelID, arg.MessageID)
+	var i Result
+	err := row.Scan(
+		&i.MatchID,
+		&i.GuildID,
+		&i.ChannelName,
+		&i.ScheduledAt,
+		&i.ReportedAt,
+		&i.ReportedBy,
+		&i.Status,
+		&i.MessageID,
+		&i.FinalizedAt,
+		&i.FinalizedBy,
+		&i.Division,
+		&i.MessageChannelID,
+	)
+	return i, err
+}
+
+const listDivisionFinalTeamResults = `-- name: ListDivisionFinalTeamResults :many
+SELECT
+    t.match_id,
+    t.role_id,
+    t.score,
+    r.scheduled_at
+FROM results AS r
+JOIN team_results AS t
+ON r.match_id = t.match_id
+JOIN matches AS m
+ON r.match_id = m.match_id
+WHERE r.guild_id = ?1
+AND r.division = ?2
+AND r.status = 'CONFIRMED'
+AND m.cancelled_at = 0
+ORDER BY r.scheduled_at, t.match_id, t.role_id
+`
+
+type ListDivisionFinalTeamResultsParams struct {
+	GuildID  string `db:"guild_id"`
+	Division string `db:"division"`
+}
+
+type ListDivisionFinalTeamResultsRow struct {
+	MatchID     int64  `db:"match_id"`
+	RoleID      string `db:"role_id"`
+	Score       int64  `db:"score"`
+	ScheduledAt int64  `db:"scheduled_at"`
+}
+
+func (q *Queries) ListDivisionFinalTeamResults(ctx context.Context, arg ListDivisionFinalTeamResultsParams) ([]ListDivisionFinalTeamResultsRow, error) {
+	rows, err := q.query(ctx, q.listDivisionFinalTeamResultsStmt, listDivisionFinalTeamResults, arg.GuildID, arg.Division)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListDivisionFinalTeamResultsRow{}
+	for rows.Next() {
+		var i ListDivisionFinalTeamResultsRow
+		if err := rows.Scan(
+			&i.MatchID,
+			&i.RoleID,
+			&i.Score,
+			&i.ScheduledAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listGuildFinalTeamResults = `-- name: ListGuildFinalTeamResults :many
+SELECT
+    t.match_id,
+    t.role_id,
+    t.score,
+    r.scheduled_at
+FROM results AS r
+JOIN team_results AS t
+ON r.match_id = t.match_id
+JOIN matches AS m
+ON r.match_id = m.match_id
+WHERE r.guild_id = ?1
+AND r.status = 'CONFIRMED'
+AND m.cancelled_at = 0
+ORDER BY r.scheduled_at, t.match_id, t.role_id
+`
+
+type ListGuildFinalTeamResultsRow struct {
+	MatchID     int64  `db:"match_id"`
+	RoleID      string `db:"role_id"`
+	Score       int64  `db:"score"`
+	ScheduledAt int64  `db:"scheduled_at"`
+}
+
+func (q *Queries) ListGuildFinalTeamResults(ctx context.Context, guildID string) ([]ListGuildFinalTeamResultsRow, error) {
+	rows, err := q.query(ctx, q.listGuildFinalTeamResultsStmt, listGuildFinalTeamResults, guildID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListGuildFinalTeamResultsRow{}
+	for rows.Next() {
+		var i ListGuildFinalTeamResultsRow
+		if err := rows.Scan(
+			&i.MatchID,
+			&i.RoleID,
+			&i.Score,
+			&i.ScheduledAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTeamResults = `-- name: ListTeamResults :many
+SELECT
+    match_id,
+    role_id,
+    score,
+    time,
+    screenshot_name,
+    demo_name,
+    reported_at,
+    reported_by
+FROM team_results
+WHERE match_id = ?1
+ORDER BY role_id
+`
+
+type ListTeamResultsRow struct {
+	MatchID        int64  `db:"match_id"`
+	RoleID         string `db:"role_id"`
+	Score          int64  `db:"score"`
+	Time           int64  `db:"time"`
+	ScreenshotName string `db:"screenshot_name"`
+	DemoName       string `db:"demo_name"`
+	ReportedAt     int64  `db:"reported_at"`
+	ReportedBy     string `db:"reported_by"`
+}
+
+func (q *Queries) ListTeamResults(ctx context.Context, matchID int64) ([]ListTeamResultsRow, error) {
+	rows, err := q.query(ctx, q.listTeamResultsStmt, listTeamResults, matchID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListTeamResultsRow{}
+	for rows.Next() {
+		var i ListTeamResultsRow
+		if err := rows.Scan(
+			&i.MatchID,
+			&i.RoleID,
+			&i.Score,
+			&i.Time,
+			&i.ScreenshotName,
+			&i.DemoName,
+			&i.ReportedAt,
+			&i.ReportedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateResultMessage = `-- name: UpdateResultMessage :exec
+UPDATE results
+SET
+    message_channel_id = ?1,
+    message_id = ?2
+WHERE match_id = ?3
+`
+
+type UpdateResultMessageParams struct {
+	MessageChannelID string `db:"message_channel_id"`
+	MessageID        string `db:"message_id"`
+	MatchID          int64  `db:"match_id"`
+}
+
+func (q *Queries) UpdateResultMessage(ctx context.Context, arg UpdateResultMessageParams) error {
+	_, err := q.exec(ctx, q.updateResultMessageStmt, updateResultMessage, arg.MessageChannelID, arg.MessageID, arg.MatchID)
+	return err
+}
+
+const updateResultStatus = `-- name: UpdateResultStatus :exec
+UPDATE results
+SET
+    status = ?1,
+    finalized_at = ?2,
+    finalized_by = ?3
+WHERE match_id = ?4
+`
+
+type UpdateResultStatusParams struct {
+	Status      string `db:"status"`
+	FinalizedAt int64  `db:"finalized_at"`
+	FinalizedBy string `db:"finalized_by"`
+	MatchID     int64  `db:"match_id"`
+}
+
+func (q *Queries) UpdateResultStatus(ctx context.Context, arg UpdateResultStatusParams) error {
+	_, err := q.exec(ctx, q.updateResultStatusStmt, updateResultStatus,
+		arg.Status,
+		arg.FinalizedAt,
+		arg.FinalizedBy,
+		arg.MatchID,
+	)
+	return err
+}
@@ -0,0 +1,166 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: schedule_boards.sql
+
+package sqlc
+
+import (
+	"context"
+)
+
+const addScheduleBoard = `-- name: AddScheduleBoard :exec
+INSERT OR REPLACE INTO schedule_boards (
+    guild_id,
+    channel_id,
+    days
+) VALUES (
+    ?1,
+    ?2,
+    ?3
+)
+`
+
+type AddScheduleBoardParams struct {
+	GuildID   string `db:"guild_id"`
+	ChannelID string `db:"channel_id"`
+	Days      int64  `db:"days"`
+}
+
+func (q *Queries) AddScheduleBoard(ctx context.Context, arg AddScheduleBoardParams) error {
+	_, err := q.exec(ctx, q.addScheduleBoardStmt, addScheduleBoard, arg.GuildID, arg.ChannelID, arg.Days)
+	return err
+}
+
+const addScheduleBoardMessage = `-- name: AddScheduleBoardMessage :exec
+INSERT OR REPLACE INTO schedule_board_messages (
+    guild_id,
+    position,
+    message_id
+) VALUES (
+    ?1,
+    ?2,
+    ?3
+)
+`
+
+type AddScheduleBoardMessageParams struct {
+	GuildID   string `db:"guild_id"`
+	Position  int64  `db:"position"`
+	MessageID string `db:"message_id"`
+}
+
+func (q *Queries) AddScheduleBoardMessage(ctx context.Context, arg AddScheduleBoardMessageParams) error {
+	_, err := q.exec(ctx, q.addScheduleBoardMessageStmt, addScheduleBoardMessage, arg.GuildID, arg.Position, arg.MessageID)
+	return err
+}
+
+const deleteScheduleBoard = `-- name: DeleteScheduleBoard :exec
+DELETE FROM schedule_boards
+WHERE guild_id = ?1
+`
+
+func (q *Queries) DeleteScheduleBoard(ctx context.Context, guildID string) error {
+	_, err := q.exec(ctx, q.deleteScheduleBoardStmt, deleteScheduleBoard, guildID)
+	return err
+}
+
+const deleteScheduleBoardMessagesFrom = `-- name: DeleteScheduleBoardMessagesFrom :exec
+DELETE FROM schedule_board_messages
+WHERE guild_id = ?1
+AND position >= ?2
+`
+
+type DeleteScheduleBoardMessagesFromParams struct {
+	GuildID  string `db:"guild_id"`
+	Position int64  `db:"position"`
+}
+
+func (q *Queries) DeleteScheduleBoardMessagesFrom(ctx context.Context, arg DeleteScheduleBoardMessagesFromParams) error {
+	_, err := q.exec(ctx, q.deleteScheduleBoardMessagesFromStmt, deleteScheduleBoardMessagesFrom, arg.GuildID, arg.Position)
+	return err
+}
+
+const getScheduleBoard = `-- name: GetScheduleBoard :one
+SELECT
+    guild_id,
+    channel_id,
+    days
+FROM schedule_boards
+WHERE guild_id = ?1
+`
+
+func (q *Queries) GetScheduleBoard(ctx context.Context, guildID string) (ScheduleBoard, error) {
+	row := q.queryRow(ctx, q.getScheduleBoardStmt, getScheduleBoard, guildID)
+	var i ScheduleBoard
+	err := row.Scan(&i.GuildID, &i.ChannelID, &i.Days)
+	return i, err
+}
+
+const listEnabledScheduleBoards = `-- name: ListEnabledScheduleBoards :many
+SELECT
+    s.guild_id,
+    s.channel_id,
+    s.days
+FROM guild_config AS g
+JOIN schedule_boards AS s
+ON g.guild_id = s.guild_id
+WHERE g.enabled = 1
+ORDER BY s.guild_id
+`
+
+func (q *Queries) ListEnabledScheduleBoards(ctx context.Context) ([]ScheduleBoard, error) {
+	rows, err := q.query(ctx, q.listEnabledScheduleBoardsStmt, listEnabledScheduleBoards)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ScheduleBoard{}
+	for rows.Next() {
+		var i ScheduleBoard
+		if err := rows.Scan(&i.GuildID, &i.ChannelID, &i.Days); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listScheduleBoardMessages = `-- name: ListScheduleBoardMessages :many
+SELECT
+    guild_id,
+    position,
+    message_id
+FROM schedule_board_messages
+WHERE guild_id = ?1
+ORDER BY position ASC
+`
+
+func (q *Queries) ListScheduleBoardMessages(ctx context.Context, guildID string) ([]ScheduleBoardMessage, error) {
+	rows, err := q.query(ctx, q.listScheduleBoardMessagesStmt, listScheduleBoardMessages, guildID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ScheduleBoardMessage{}
+	for rows.Next() {
+		var i ScheduleBoardMessage
+		if err := rows.Scan(&i.GuildID, &i.Position, &i.MessageID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
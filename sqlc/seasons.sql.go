@@ -0,0 +1,532 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: seasons.sql
+
+package sqlc
+
+import (
+	"context"
+)
+
+const addFixture = `-- name: AddFixture :one
+INSERT INTO fixtures (
+    season_id,
+    round,
+    scheduled_at,
+    moderator_id
+) VALUES (
+    ?1,
+    ?2,
+    ?3,
+    ?4
+) RETURNING fixture_id
+`
+
+type AddFixtureParams struct {
+	SeasonID    int64  `db:"season_id"`
+	Round       int64  `db:"round"`
+	ScheduledAt int64  `db:"scheduled_at"`
+	ModeratorID string `db:"moderator_id"`
+}
+
+func (q *Queries) AddFixture(ctx context.Context, arg AddFixtureParams) (int64, error) {
+	row := q.queryRow(ctx, q.addFixtureStmt, addFixture,
+		arg.SeasonID,
+		arg.Round,
+		arg.ScheduledAt,
+		arg.ModeratorID,
+	)
+	var fixture_id int64
+	err := row.Scan(&fixture_id)
+	return fixture_id, err
+}
+
+const addFixtureTeam = `-- name: AddFixtureTeam :exec
+INSERT INTO fixture_teams (
+    fixture_id,
+    role_id,
+    position
+) VALUES (
+    ?1,
+    ?2,
+    ?3
+)
+`
+
+type AddFixtureTeamParams struct {
+	FixtureID int64  `db:"fixture_id"`
+	RoleID    string `db:"role_id"`
+	Position  int64  `db:"position"`
+}
+
+func (q *Queries) AddFixtureTeam(ctx context.Context, arg AddFixtureTeamParams) error {
+	_, err := q.exec(ctx, q.addFixtureTeamStmt, addFixtureTeam, arg.FixtureID, arg.RoleID, arg.Position)
+	return err
+}
+
+const addSeason = `-- name: AddSeason :one
+INSERT INTO seasons (
+    guild_id,
+    participants_per_team,
+    confirmed,
+    created_at,
+    created_by
+) VALUES (
+    ?1,
+    ?2,
+    0,
+    ?3,
+    ?4
+) RETURNING season_id
+`
+
+type AddSeasonParams struct {
+	GuildID             string `db:"guild_id"`
+	ParticipantsPerTeam int64  `db:"participants_per_team"`
+	CreatedAt           int64  `db:"created_at"`
+	CreatedBy           string `db:"created_by"`
+}
+
+func (q *Queries) AddSeason(ctx context.Context, arg AddSeasonParams) (int64, error) {
+	row := q.queryRow(ctx, q.addSeasonStmt, addSeason,
+		arg.GuildID,
+		arg.ParticipantsPerTeam,
+		arg.CreatedAt,
+		arg.CreatedBy,
+	)
+	var season_id int64
+	err := row.Scan(&season_id)
+	return season_id, err
+}
+
+const confirmSeason = `-- name: ConfirmSeason :exec
+UPDATE seasons
+SET confirmed = 1
+WHERE season_id = ?1
+`
+
+func (q *Queries) ConfirmSeason(ctx context.Context, seasonID int64) error {
+	_, err := q.exec(ctx, q.confirmSeasonStmt, confirmSeason, seasonID)
+	return err
+}
+
+const countSeasonFixtures = `-- name: CountSeasonFixtures :one
+SELECT COUNT(*)
+FROM fixtures
+WHERE season_id = ?1
+`
+
+func (q *Queries) CountSeasonFixtures(ctx context.Context, seasonID int64) (int64, error) {
+	row := q.queryRow(ctx, q.countSeasonFixturesStmt, countSeasonFixtures, seasonID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const deleteFixture = `-- name: DeleteFixture :exec
+DELETE FROM fixtures
+WHERE fixture_id = ?1
+`
+
+func (q *Queries) DeleteFixture(ctx context.Context, fixtureID int64) error {
+	_, err := q.exec(ctx, q.deleteFixtureStmt, deleteFixture, fixtureID)
+	return err
+}
+
+const deleteSeason = `-- name: DeleteSeason :exec
+DELETE FROM seasons
+WHERE season_id = ?1
+`
+
+func (q *Queries) DeleteSeason(ctx context.Context, seasonID int64) error {
+	_, err := q.exec(ctx, q.deleteSeasonStmt, deleteSeason, seasonID)
+	return err
+}
+
+const deleteSeasonDrafts = `-- name: DeleteSeasonDrafts :exec
+DELETE FROM seasons
+WHERE guild_id = ?1
+AND created_by = ?2
+AND confirmed = 0
+`
+
+type DeleteSeasonDraftsParams struct {
+	GuildID   string `db:"guild_id"`
+	CreatedBy string `db:"created_by"`
+}
+
+func (q *Queries) DeleteSeasonDrafts(ctx context.Context, arg DeleteSeasonDraftsParams) error {
+	_, err := q.exec(ctx, q.deleteSeasonDraftsStmt, deleteSeasonDrafts, arg.GuildID, arg.CreatedBy)
+	return err
+}
+
+const getFixture = `-- name: GetFixture :one
+SELECT
+    fixture_id,
+    season_id,
+    round,
+    scheduled_at,
+    moderator_id,
+    match_id
+FROM fixtures
+WHERE fixture_id = ?1
+`
+
+func (q *Queries) GetFixture(ctx context.Context, fixtureID int64) (Fixture, error) {
+	row := q.queryRow(ctx, q.getFixtureStmt, getFixture, fixtureID)
+	var i Fixture
+	err := row.Scan(
+		&i.FixtureID,
+		&i.SeasonID,
+		&i.Round,
+		&i.ScheduledAt,
+		&i.ModeratorID,
+		&i.MatchID,
+	)
+	return i, err
+}
+
+const getFixtureByMatch = `-- name: GetFixtureByMatch :one
+SELECT
+    fixture_id,
+    season_id,
+    round,
+    scheduled_at,
+    moderator_id,
+    match_id
+FROM fixtures
+WHERE match_id = ?1
+`
+
+func (q *Queries) GetFixtureByMatch(ctx context.Context, matchID int64) (Fixture, error) {
+	row := q.queryRow(ctx, q.getFixtureByMatchStmt, getFixtureByMatch, matchID)
+	var i Fixture
+	err := row.Scan(
+		&i.FixtureID,
+		&i.SeasonID,
+		&i.Round,
+		&i.ScheduledAt,
+		&i.ModeratorID,
+		&i.MatchID,
+	)
+	return i, err
+}
+
+const getSeasonDraft = `-- name: GetSeasonDraft :one
+SELECT
+    season_id,
+    guild_id,
+    participants_per_team,
+    confirmed,
+    created_at,
+    created_by
+FROM seasons
+WHERE guild_id = ?1
+AND created_by = ?2
+AND confirmed = 0
+ORDER BY season_id DESC
+LIMIT 1
+`
+
+type GetSeasonDraftParams struct {
+	GuildID   string `db:"guild_id"`
+	CreatedBy string `db:"created_by"`
+}
+
+func (q *Queries) GetSeasonDraft(ctx context.Context, arg GetSeasonDraftParams) (Season, error) {
+	row := q.queryRow(ctx, q.getSeasonDraftStmt, getSeasonDraft, arg.GuildID, arg.CreatedBy)
+	var i Season
+	err := row.Scan(
+		&i.SeasonID,
+		&i.GuildID,
+		&i.ParticipantsPerTeam,
+		&i.Confirmed,
+		&i.CreatedAt,
+		&i.CreatedBy,
+	)
+	return i, err
+}
+
+const listConfirmedSeasonsWithoutMatches = `-- name: ListConfirmedSeasonsWithoutMatches :many
+SELECT DISTINCT f.season_id
+FROM fixtures AS f
+JOIN seasons AS s
+ON f.season_id = s.season_id
+WHERE s.confirmed = 1
+AND f.match_id = 0
+ORDER BY f.season_id
+`
+
+func (q *Queries) ListConfirmedSeasonsWithoutMatches(ctx context.Context) ([]int64, error) {
+	rows, err := q.query(ctx, q.listConfirmedSeasonsWithoutMatchesStmt, listConfirmedSeasonsWithoutMatches)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var season_id int64
+		if err := rows.Scan(&season_id); err != nil {
+			return nil, err
+		}
+		items = append(items, season_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listFixtureTeams = `-- name: ListFixtureTeams :many
+SELECT
+    fixture_id,
+    role_id,
+    position
+FROM fixture_teams
+WHERE fixture_id = ?1
+ORDER BY position
+`
+
+func (q *Queries) ListFixtureTeams(ctx context.Context, fixtureID int64) ([]FixtureTeam, error) {
+	rows, err := q.query(ctx, q.listFixtureTeamsStmt, listFixtureTeams, fixtureID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FixtureTeam{}
+	for rows.Next() {
+		var i FixtureTeam
+		if err := rows.Scan(&i.FixtureID, &i.RoleID, &i.Position); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSeasonFixtureResults = `-- name: ListSeasonFixtureResults :many
+SELECT
+    f.fixture_id,
+    f.round,
+    f.match_id,
+    ft.role_id,
+    ft.position,
+    CAST(COALESCE(r.status = 'CONFIRMED', 0) AS INTEGER) AS finished,
+    CAST(COALESCE(tr.score, 0) AS INTEGER) AS score
+FROM fixtures AS f
+JOIN fixture_teams AS ft
+ON f.fixture_id = ft.fixture_id
+LEFT JOIN results AS r
+ON f.match_id != 0
+AND r.match_id = f.match_id
+LEFT JOIN team_results AS tr
+ON tr.match_id = r.match_id
+AND tr.role_id = ft.role_id
+WHERE f.season_id = ?1
+ORDER BY f.round, f.fixture_id, ft.position
+`
+
+type ListSeasonFixtureResultsRow struct {
+	FixtureID int64  `db:"fixture_id"`
+	Round     int64  `db:"round"`
+	MatchID   int64  `db:"match_id"`
+	RoleID    string `db:"role_id"`
+	Position  int64  `db:"position"`
+	Finished  int64  `db:"finished"`
+	Score     int64  `db:"score"`
+}
+
+func (q *Queries) ListSeasonFixtureResults(ctx context.Context, seasonID int64) ([]ListSeasonFixtureResultsRow, error) {
+	rows, err := q.query(ctx, q.listSeasonFixtureResultsStmt, listSeasonFixtureResults, seasonID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListSeasonFixtureResultsRow{}
+	for rows.Next() {
+		var i ListSeasonFixtureResultsRow
+		if err := rows.Scan(
+			&i.FixtureID,
+			&i.Round,
+			&i.MatchID,
+			&i.RoleID,
+			&i.Position,
+			&i.Finished,
+			&i.Score,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSeasonFixtures = `-- name: ListSeasonFixtures :many
+SELECT
+    fixture_id,
+    season_id,
+    round,
+    scheduled_at,
+    moderator_id,
+    match_id
+FROM fixtures
+WHERE season_id = ?1
+ORDER BY scheduled_at, fixture_id
+`
+
+func (q *Queries) ListSeasonFixtures(ctx context.Context, seasonID int64) ([]Fixture, error) {
+	rows, err := q.query(ctx, q.listSeasonFixturesStmt, listSeasonFixtures, seasonID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Fixture{}
+	for rows.Next() {
+		var i Fixture
+		if err := rows.Scan(
+			&i.FixtureID,
+			&i.SeasonID,
+			&i.Round,
+			&i.ScheduledAt,
+			&i.ModeratorID,
+			&i.MatchID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSeasonFixturesWithoutMatch = `-- name: ListSeasonFixturesWithoutMatch :many
+SELECT
+    f.fixture_id,
+    f.season_id,
+    f.round,
+    f.scheduled_at,
+    f.moderator_id,
+    s.guild_id,
+    s.participants_per_team,
+    s.created_by
+FROM fixtures AS f
+JOIN seasons AS s
+ON f.season_id = s.season_id
+WHERE f.season_id = ?1
+AND f.match_id = 0
+ORDER BY f.scheduled_at, f.fixture_id
+`
+
+type ListSeasonFixturesWithoutMatchRow struct {
+	FixtureID           int64  `db:"fixture_id"`
+	SeasonID            int64  `db:"season_id"`
+	Round               int64  `db:"round"`
+	ScheduledAt         int64  `db:"scheduled_at"`
+	ModeratorID         string `db:"moderator_id"`
+	GuildID             string `db:"guild_id"`
+	ParticipantsPerTeam int64  `db:"participants_per_team"`
+	CreatedBy           string `db:"created_by"`
+}
+
+func (q *Queries) ListSeasonFixturesWithoutMatch(ctx context.Context, seasonID int64) ([]ListSeasonFixturesWithoutMatchRow, error) {
+	rows, err := q.query(ctx, q.listSeasonFixturesWithoutMatchStmt, listSeasonFixturesWithoutMatch, seasonID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListSeasonFixturesWithoutMatchRow{}
+	for rows.Next() {
+		var i ListSeasonFixturesWithoutMatchRow
+		if err := rows.Scan(
+			&i.FixtureID,
+			&i.SeasonID,
+			&i.Round,
+			&i.ScheduledAt,
+			&i.ModeratorID,
+			&i.GuildID,
+			&i.ParticipantsPerTeam,
+			&i.CreatedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSeasonMatchIDsWithoutChannel = `-- name: ListSeasonMatchIDsWithoutChannel :many
+SELECT m.match_id
+FROM fixtures AS f
+JOIN matches AS m
+ON f.match_id = m.match_id
+WHERE f.season_id = ?1
+AND m.channel_id = ''
+AND m.channel_deleted = 0
+ORDER BY m.match_id
+`
+
+func (q *Queries) ListSeasonMatchIDsWithoutChannel(ctx context.Context, seasonID int64) ([]int64, error) {
+	rows, err := q.query(ctx, q.listSeasonMatchIDsWithoutChannelStmt, listSeasonMatchIDsWithoutChannel, seasonID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var match_id int64
+		if err := rows.Scan(&match_id); err != nil {
+			return nil, err
+		}
+		items = append(items, match_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateFixtureMatch = `-- name: UpdateFixtureMatch :exec
+UPDATE fixtures
+SET match_id = ?1
+WHERE fixture_id = ?2
+`
+
+type UpdateFixtureMatchParams struct {
+	MatchID   int64 `db:"match_id"`
+	FixtureID int64 `db:"fixture_id"`
+}
+
+func (q *Queries) UpdateFixtureMatch(ctx context.Context, arg UpdateFixtureMatchParams) error {
+	_, err := q.exec(ctx, q.updateFixtureMatchStmt, updateFixtureMatch, arg.MatchID, arg.FixtureID)
+	return err
+}
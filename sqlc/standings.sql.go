@@ -0,0 +1,73 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: standings.sql
+
+package sqlc
+
+import (
+	"context"
+)
+
+const addStandingsMessage = `-- name: AddStandingsMessage :exec
+INSERT OR REPLACE INTO standings_messages (
+    guild_id,
+    channel_id,
+    message_id,
+    division
+) VALUES (
+    ?1,
+    ?2,
+    ?3,
+    ?4
+)
+`
+
+type AddStandingsMessageParams struct {
+	GuildID   string `db:"guild_id"`
+	ChannelID string `db:"channel_id"`
+	MessageID string `db:"message_id"`
+	Division  string `db:"division"`
+}
+
+func (q *Queries) AddStandingsMessage(ctx context.Context, arg AddStandingsMessageParams) error {
+	_, err := q.exec(ctx, q.addStandingsMessageStmt, addStandingsMessage,
+		arg.GuildID,
+		arg.ChannelID,
+		arg.MessageID,
+		arg.Division,
+	)
+	return err
+}
+
+const deleteStandingsMessage = `-- name: DeleteStandingsMessage :exec
+DELETE FROM standings_messages
+WHERE guild_id = ?1
+`
+
+func (q *Queries) DeleteStandingsMessage(ctx context.Context, guildID string) error {
+	_, err := q.exec(ctx, q.deleteStandingsMessageStmt, deleteStandingsMessage, guildID)
+	return err
+}
+
+const getStandingsMessage = `-- name: GetStandingsMessage :one
+SELECT
+    guild_id,
+    channel_id,
+    message_id,
+    division
+FROM standings_messages
+WHERE guild_id = ?1
+`
+
+func (q *Queries) GetStandingsMessage(ctx context.Context, guildID string) (StandingsMessage, error) {
+	row := q.queryRow(ctx, q.getStandingsMessageStmt, getStandingsMessage, guildID)
+	var i StandingsMessage
+	err := row.Scan(
+		&i.GuildID,
+		&i.ChannelID,
+		&i.MessageID,
+		&i.Division,
+	)
+	return i, err
+}
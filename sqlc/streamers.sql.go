@@ -12,7 +12,7 @@ import (
 
 const addMatchStreamer = `-- name: AddMatchStreamer :exec
 INSERT INTO streamers (
-    channel_id,
+    match_id,
     user_id,
     url
 ) VALUES (
@@ -23,57 +23,57 @@ INSERT INTO streamers (
 `
 
 type AddMatchStreamerParams struct {
-	ChannelID string `db:"channel_id"`
-	UserID    string `db:"user_id"`
-	Url       string `db:"url"`
+	MatchID int64  `db:"match_id"`
+	UserID  string `db:"user_id"`
+	Url     string `db:"url"`
 }
 
 func (q *Queries) AddMatchStreamer(ctx context.Context, arg AddMatchStreamerParams) error {
-	_, err := q.exec(ctx, q.addMatchStreamerStmt, addMatchStreamer, arg.ChannelID, arg.UserID, arg.Url)
+	_, err := q.exec(ctx, q.addMatchStreamerStmt, addMatchStreamer, arg.MatchID, arg.UserID, arg.Url)
 	return err
 }
 
 const deleteAllMatchStreamers = `-- name: DeleteAllMatchStreamers :exec
 DELETE FROM streamers
-WHERE channel_id = ?1
+WHERE match_id = ?1
 `
 
-func (q *Queries) DeleteAllMatchStreamers(ctx context.Context, channelID string) error {
-	_, err := q.exec(ctx, q.deleteAllMatchStreamersStmt, deleteAllMatchStreamers, channelID)
+func (q *Queries) DeleteAllMatchStreamers(ctx context.Context, matchID int64) error {
+	_, err := q.exec(ctx, q.deleteAllMatchStreamersStmt, deleteAllMatchStreamers, matchID)
 	return err
 }
 
 const deleteMatchStreamer = `-- name: DeleteMatchStreamer :exec
 DELETE FROM streamers
-WHERE channel_id = ?1
+WHERE match_id = ?1
 AND user_id = ?2
 `
 
 type DeleteMatchStreamerParams struct {
-	ChannelID string `db:"channel_id"`
-	UserID    string `db:"user_id"`
+	MatchID int64  `db:"match_id"`
+	UserID  string `db:"user_id"`
 }
 
 func (q *Queries) DeleteMatchStreamer(ctx context.Context, arg DeleteMatchStreamerParams) error {
-	_, err := q.exec(ctx, q.deleteMatchStreamerStmt, deleteMatchStreamer, arg.ChannelID, arg.UserID)
+	_, err := q.exec(ctx, q.deleteMatchStreamerStmt, deleteMatchStreamer, arg.MatchID, arg.UserID)
 	return err
 }
 
 const deleteMatchStreamers = `-- name: DeleteMatchStreamers :exec
 DELETE FROM streamers
-WHERE channel_id = ?1
+WHERE match_id = ?1
 AND user_id IN (/*SLICE:user_id*/?)
 `
 
 type DeleteMatchStreamersParams struct {
-	ChannelID string   `db:"channel_id"`
-	UserID    []string `db:"user_id"`
+	MatchID int64    `db:"match_id"`
+	UserID  []string `db:"user_id"`
 }
 
 func (q *Queries) DeleteMatchStreamers(ctx context.Context, arg DeleteMatchStreamersParams) error {
 	query := deleteMatchStreamers
 	var queryParams []interface{}
-	queryParams = append(queryParams, arg.ChannelID)
+	queryParams = append(queryParams, arg.MatchID)
 	if len(arg.UserID) > 0 {
 		for _, v := range arg.UserID {
 			queryParams = append(queryParams, v)
@@ -88,16 +88,16 @@ func (q *Queries) DeleteMatchStreamers(ctx context.Context, arg DeleteMatchStrea
 
 const listMatchStreamers = `-- name: ListMatchStreamers :many
 SELECT
-    channel_id,
+    match_id,
     user_id,
     url
 FROM streamers
-WHERE channel_id = ?1
+WHERE match_id = ?1
 ORDER BY user_id
 `
 
-func (q *Queries) ListMatchStreamers(ctx context.Context, channelID string) ([]Streamer, error) {
-	rows, err := q.query(ctx, q.listMatchStreamersStmt, listMatchStreamers, channelID)
+func (q *Queries) ListMatchStreamers(ctx context.Context, matchID int64) ([]Streamer, error) {
+	rows, err := q.query(ctx, q.listMatchStreamersStmt, listMatchStreamers, matchID)
 	if err != nil {
 		return nil, err
 	}
@@ -105,7 +105,7 @@ func (q *Queries) ListMatchStreamers(ctx context.Context, channelID string) ([]S
 	items := []Streamer{}
 	for rows.Next() {
 		var i Streamer
-		if err := rows.Scan(&i.ChannelID, &i.UserID, &i.Url); err != nil {
+		if err := rows.Scan(&i.MatchID, &i.UserID, &i.Url); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
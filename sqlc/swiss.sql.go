@@ -0,0 +1,260 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: swiss.sql
+
+package sqlc
+
+import (
+	"context"
+)
+
+const addSwissBye = `-- name: AddSwissBye :exec
+INSERT INTO swiss_byes (
+    swiss_id,
+    round,
+    role_id
+) VALUES (
+    ?1,
+    ?2,
+    ?3
+)
+`
+
+type AddSwissByeParams struct {
+	SwissID int64  `db:"swiss_id"`
+	Round   int64  `db:"round"`
+	RoleID  string `db:"role_id"`
+}
+
+func (q *Queries) AddSwissBye(ctx context.Context, arg AddSwissByeParams) error {
+	_, err := q.exec(ctx, q.addSwissByeStmt, addSwissBye, arg.SwissID, arg.Round, arg.RoleID)
+	return err
+}
+
+const addSwissTeam = `-- name: AddSwissTeam :exec
+INSERT OR IGNORE INTO swiss_teams (
+    swiss_id,
+    role_id
+) VALUES (
+    ?1,
+    ?2
+)
+`
+
+type AddSwissTeamParams struct {
+	SwissID int64  `db:"swiss_id"`
+	RoleID  string `db:"role_id"`
+}
+
+func (q *Queries) AddSwissTeam(ctx context.Context, arg AddSwissTeamParams) error {
+	_, err := q.exec(ctx, q.addSwissTeamStmt, addSwissTeam, arg.SwissID, arg.RoleID)
+	return err
+}
+
+const addSwissTournament = `-- name: AddSwissTournament :one
+INSERT INTO swiss_tournaments (
+    guild_id,
+    season_id,
+    name,
+    slots,
+    location,
+    moderator_ids,
+    created_at,
+    created_by
+) VALUES (
+    ?1,
+    ?2,
+    ?3,
+    ?4,
+    ?5,
+    ?6,
+    ?7,
+    ?8
+) RETURNING swiss_id
+`
+
+type AddSwissTournamentParams struct {
+	GuildID      string `db:"guild_id"`
+	SeasonID     int64  `db:"season_id"`
+	Name         string `db:"name"`
+	Slots        string `db:"slots"`
+	Location     string `db:"location"`
+	ModeratorIds string `db:"moderator_ids"`
+	CreatedAt    int64  `db:"created_at"`
+	CreatedBy    string `db:"created_by"`
+}
+
+func (q *Queries) AddSwissTournament(ctx context.Context, arg AddSwissTournamentParams) (int64, error) {
+	row := q.queryRow(ctx, q.addSwissTournamentStmt, addSwissTournament,
+		arg.GuildID,
+		arg.SeasonID,
+		arg.Name,
+		arg.Slots,
+		arg.Location,
+		arg.ModeratorIds,
+		arg.CreatedAt,
+		arg.CreatedBy,
+	)
+	var swiss_id int64
+	err := row.Scan(&swiss_id)
+	return swiss_id, err
+}
+
+const getSwissTournamentByName = `-- name: GetSwissTournamentByName :one
+SELECT
+    swiss_id,
+    guild_id,
+    season_id,
+    name,
+    slots,
+    location,
+    moderator_ids,
+    moderator_turn,
+    current_round,
+    created_at,
+    created_by
+FROM swiss_tournaments
+WHERE guild_id = ?1
+AND name = ?2
+`
+
+type GetSwissTournamentByNameParams struct {
+	GuildID string `db:"guild_id"`
+	Name    string `db:"name"`
+}
+
+func (q *Queries) GetSwissTournamentByName(ctx context.Context, arg GetSwissTournamentByNameParams) (SwissTournament, error) {
+	row := q.queryRow(ctx, q.getSwissTournamentByNameStmt, getSwissTournamentByName, arg.GuildID, arg.Name)
+	var i SwissTournament
+	err := row.Scan(
+		&i.SwissID,
+		&i.GuildID,
+		&i.SeasonID,
+		&i.Name,
+		&i.Slots,
+		&i.Location,
+		&i.ModeratorIds,
+		&i.ModeratorTurn,
+		&i.CurrentRound,
+		&i.CreatedAt,
+		&i.CreatedBy,
+	)
+	return i, err
+}
+
+const listGuildSwissTournamentNames = `-- name: ListGuildSwissTournamentNames :many
+SELECT name
+FROM swiss_tournaments
+WHERE guild_id = ?1
+ORDER BY name
+`
+
+func (q *Queries) ListGuildSwissTournamentNames(ctx context.Context, guildID string) ([]string, error) {
+	rows, err := q.query(ctx, q.listGuildSwissTournamentNamesStmt, listGuildSwissTournamentNames, guildID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		items = append(items, name)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSwissByes = `-- name: ListSwissByes :many
+SELECT
+    round,
+    role_id
+FROM swiss_byes
+WHERE swiss_id = ?1
+ORDER BY round
+`
+
+type ListSwissByesRow struct {
+	Round  int64  `db:"round"`
+	RoleID string `db:"role_id"`
+}
+
+func (q *Queries) ListSwissByes(ctx context.Context, swissID int64) ([]ListSwissByesRow, error) {
+	rows, err := q.query(ctx, q.listSwissByesStmt, listSwissByes, swissID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListSwissByesRow{}
+	for rows.Next() {
+		var i ListSwissByesRow
+		if err := rows.Scan(&i.Round, &i.RoleID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSwissTeams = `-- name: ListSwissTeams :many
+SELECT role_id
+FROM swiss_teams
+WHERE swiss_id = ?1
+ORDER BY role_id
+`
+
+func (q *Queries) ListSwissTeams(ctx context.Context, swissID int64) ([]string, error) {
+	rows, err := q.query(ctx, q.listSwissTeamsStmt, listSwissTeams, swissID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var role_id string
+		if err := rows.Scan(&role_id); err != nil {
+			return nil, err
+		}
+		items = append(items, role_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateSwissTournamentRound = `-- name: UpdateSwissTournamentRound :exec
+UPDATE swiss_tournaments
+SET
+    current_round = ?1,
+    moderator_turn = ?2
+WHERE swiss_id = ?3
+`
+
+type UpdateSwissTournamentRoundParams struct {
+	CurrentRound  int64 `db:"current_round"`
+	ModeratorTurn int64 `db:"moderator_turn"`
+	SwissID       int64 `db:"swiss_id"`
+}
+
+func (q *Queries) UpdateSwissTournamentRound(ctx context.Context, arg UpdateSwissTournamentRoundParams) error {
+	_, err := q.exec(ctx, q.updateSwissTournamentRoundStmt, updateSwissTournamentRound, arg.CurrentRound, arg.ModeratorTurn, arg.SwissID)
+	return err
+}
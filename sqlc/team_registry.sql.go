@@ -0,0 +1,555 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: team_registry.sql
+
+package sqlc
+
+import (
+	"context"
+	"strings"
+)
+
+const addRegisteredTeam = `-- name: AddRegisteredTeam :exec
+INSERT INTO registered_teams (
+    guild_id,
+    role_id,
+    name,
+    tag,
+    logo_url,
+    created_at,
+    created_by,
+    updated_at,
+    updated_by
+) VALUES (
+    ?1,
+    ?2,
+    ?3,
+    ?4,
+    ?5,
+    ?6,
+    ?7,
+    ?8,
+    ?9
+)
+`
+
+type AddRegisteredTeamParams struct {
+	GuildID   string `db:"guild_id"`
+	RoleID    string `db:"role_id"`
+	Name      string `db:"name"`
+	Tag       string `db:"tag"`
+	LogoUrl   string `db:"logo_url"`
+	CreatedAt int64  `db:"created_at"`
+	CreatedBy string `db:"created_by"`
+	UpdatedAt int64  `db:"updated_at"`
+	UpdatedBy string `db:"updated_by"`
+}
+
+func (q *Queries) AddRegisteredTeam(ctx context.Context, arg AddRegisteredTeamParams) error {
+	_, err := q.exec(ctx, q.addRegisteredTeamStmt, addRegisteredTeam,
+		arg.GuildID,
+		arg.RoleID,
+		arg.Name,
+		arg.Tag,
+		arg.LogoUrl,
+		arg.CreatedAt,
+		arg.CreatedBy,
+		arg.UpdatedAt,
+		arg.UpdatedBy,
+	)
+	return err
+}
+
+const addTeamCaptain = `-- name: AddTeamCaptain :exec
+INSERT INTO team_members (
+    guild_id,
+    role_id,
+    user_id,
+    captain
+) VALUES (
+    ?1,
+    ?2,
+    ?3,
+    1
+) ON CONFLICT (guild_id, role_id, user_id) DO UPDATE SET captain = 1
+`
+
+type AddTeamCaptainParams struct {
+	GuildID string `db:"guild_id"`
+	RoleID  string `db:"role_id"`
+	UserID  string `db:"user_id"`
+}
+
+func (q *Queries) AddTeamCaptain(ctx context.Context, arg AddTeamCaptainParams) error {
+	_, err := q.exec(ctx, q.addTeamCaptainStmt, addTeamCaptain, arg.GuildID, arg.RoleID, arg.UserID)
+	return err
+}
+
+const addTeamMember = `-- name: AddTeamMember :exec
+INSERT OR IGNORE INTO team_members (
+    guild_id,
+    role_id,
+    user_id
+) VALUES (
+    ?1,
+    ?2,
+    ?3
+)
+`
+
+type AddTeamMemberParams struct {
+	GuildID string `db:"guild_id"`
+	RoleID  string `db:"role_id"`
+	UserID  string `db:"user_id"`
+}
+
+func (q *Queries) AddTeamMember(ctx context.Context, arg AddTeamMemberParams) error {
+	_, err := q.exec(ctx, q.addTeamMemberStmt, addTeamMember, arg.GuildID, arg.RoleID, arg.UserID)
+	return err
+}
+
+const deleteRegisteredTeam = `-- name: DeleteRegisteredTeam :exec
+DELETE FROM registered_teams
+WHERE guild_id = ?1
+AND role_id = ?2
+`
+
+type DeleteRegisteredTeamParams struct {
+	GuildID string `db:"guild_id"`
+	RoleID  string `db:"role_id"`
+}
+
+func (q *Queries) DeleteRegisteredTeam(ctx context.Context, arg DeleteRegisteredTeamParams) error {
+	_, err := q.exec(ctx, q.deleteRegisteredTeamStmt, deleteRegisteredTeam, arg.GuildID, arg.RoleID)
+	return err
+}
+
+const getRegisteredTeam = `-- name: GetRegisteredTeam :one
+SELECT
+    guild_id,
+    role_id,
+    name,
+    tag,
+    logo_url,
+    created_at,
+    created_by,
+    updated_at,
+    updated_by
+FROM registered_teams
+WHERE guild_id = ?1
+AND role_id = ?2
+`
+
+type GetRegisteredTeamParams struct {
+	GuildID string `db:"guild_id"`
+	RoleID  string `db:"role_id"`
+}
+
+func (q *Queries) GetRegisteredTeam(ctx context.Context, arg GetRegisteredTeamParams) (RegisteredTeam, error) {
+	row := q.queryRow(ctx, q.getRegisteredTeamStmt, getRegisteredTeam, arg.GuildID, arg.RoleID)
+	var i RegisteredTeam
+	err := row.Scan(
+		&i.GuildID,
+		&i.RoleID,
+		&i.Name,
+		&i.Tag,
+		&i.LogoUrl,
+		&i.CreatedAt,
+		&i.CreatedBy,
+		&i.UpdatedAt,
+		&i.UpdatedBy,
+	)
+	return i, err
+}
+
+const getRegisteredTeamByName = `-- name: GetRegisteredTeamByName :one
+SELECT
+    guild_id,
+    role_id,
+    name,
+    tag,
+    logo_url,
+    created_at,
+    created_by,
+    updated_at,
+    updated_by
+FROM registered_teams
+WHERE guild_id = ?1
+AND name = ?2 COLLATE NOCASE
+`
+
+type GetRegisteredTeamByNameParams struct {
+	GuildID string `db:"guild_id"`
+	Name    string `db:"name"`
+}
+
+func (q *Queries) GetRegisteredTeamByName(ctx context.Context, arg GetRegisteredTeamByNameParams) (RegisteredTeam, error) {
+	row := q.queryRow(ctx, q.getRegisteredTeamByNameStmt, getRegisteredTeamByName, arg.GuildID, arg.Name)
+	var i RegisteredTeam
+	err := row.Scan(
+		&i.GuildID,
+		&i.RoleID,
+		&i.Name,
+		&i.Tag,
+		&i.LogoUrl,
+		&i.CreatedAt,
+		&i.CreatedBy,
+		&i.UpdatedAt,
+		&i.UpdatedBy,
+	)
+	return i, err
+}
+
+const isMatchCaptain = `-- name: IsMatchCaptain :one
+SELECT COUNT(*) > 0
+FROM team_members tm
+JOIN matches m ON m.guild_id = tm.guild_id
+JOIN teams t ON t.match_id = m.match_id AND t.role_id = tm.role_id
+WHERE m.match_id = ?1
+AND tm.user_id = ?2
+AND tm.captain = 1
+`
+
+type IsMatchCaptainParams struct {
+	MatchID int64  `db:"match_id"`
+	UserID  string `db:"user_id"`
+}
+
+func (q *Queries) IsMatchCaptain(ctx context.Context, arg IsMatchCaptainParams) (bool, error) {
+	row := q.queryRow(ctx, q.isMatchCaptainStmt, isMatchCaptain, arg.MatchID, arg.UserID)
+	var column_1 bool
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const isTeamCaptain = `-- name: IsTeamCaptain :one
+SELECT COUNT(*) > 0
+FROM team_members
+WHERE guild_id = ?1
+AND role_id = ?2
+AND user_id = ?3
+AND captain = 1
+`
+
+type IsTeamCaptainParams struct {
+	GuildID string `db:"guild_id"`
+	RoleID  string `db:"role_id"`
+	UserID  string `db:"user_id"`
+}
+
+func (q *Queries) IsTeamCaptain(ctx context.Context, arg IsTeamCaptainParams) (bool, error) {
+	row := q.queryRow(ctx, q.isTeamCaptainStmt, isTeamCaptain, arg.GuildID, arg.RoleID, arg.UserID)
+	var column_1 bool
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const listMatchCaptainRoles = `-- name: ListMatchCaptainRoles :many
+SELECT t.role_id
+FROM team_members tm
+JOIN matches m ON m.guild_id = tm.guild_id
+JOIN teams t ON t.match_id = m.match_id AND t.role_id = tm.role_id
+WHERE m.match_id = ?1
+AND tm.user_id = ?2
+AND tm.captain = 1
+ORDER BY t.role_id
+`
+
+type ListMatchCaptainRolesParams struct {
+	MatchID int64  `db:"match_id"`
+	UserID  string `db:"user_id"`
+}
+
+func (q *Queries) ListMatchCaptainRoles(ctx context.Context, arg ListMatchCaptainRolesParams) ([]string, error) {
+	rows, err := q.query(ctx, q.listMatchCaptainRolesStmt, listMatchCaptainRoles, arg.MatchID, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var role_id string
+		if err := rows.Scan(&role_id); err != nil {
+			return nil, err
+		}
+		items = append(items, role_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMatchTeamCaptains = `-- name: ListMatchTeamCaptains :many
+SELECT
+    tm.role_id,
+    tm.user_id
+FROM team_members tm
+JOIN matches m ON m.guild_id = tm.guild_id
+JOIN teams t ON t.match_id = m.match_id AND t.role_id = tm.role_id
+WHERE m.match_id = ?1
+AND tm.captain = 1
+ORDER BY tm.role_id, tm.user_id
+`
+
+type ListMatchTeamCaptainsRow struct {
+	RoleID string `db:"role_id"`
+	UserID string `db:"user_id"`
+}
+
+func (q *Queries) ListMatchTeamCaptains(ctx context.Context, matchID int64) ([]ListMatchTeamCaptainsRow, error) {
+	rows, err := q.query(ctx, q.listMatchTeamCaptainsStmt, listMatchTeamCaptains, matchID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListMatchTeamCaptainsRow{}
+	for rows.Next() {
+		var i ListMatchTeamCaptainsRow
+		if err := rows.Scan(&i.RoleID, &i.UserID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRegisteredTeams = `-- name: ListRegisteredTeams :many
+SELECT
+    guild_id,
+    role_id,
+    name,
+    tag,
+    logo_url,
+    created_at,
+    created_by,
+    updated_at,
+    updated_by
+FROM registered_teams
+WHERE guild_id = ?1
+ORDER BY name
+`
+
+func (q *Queries) ListRegisteredTeams(ctx context.Context, guildID string) ([]RegisteredTeam, error) {
+	rows, err := q.query(ctx, q.listRegisteredTeamsStmt, listRegisteredTeams, guildID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []RegisteredTeam{}
+	for rows.Next() {
+		var i RegisteredTeam
+		if err := rows.Scan(
+			&i.GuildID,
+			&i.RoleID,
+			&i.Name,
+			&i.Tag,
+			&i.LogoUrl,
+			&i.CreatedAt,
+			&i.CreatedBy,
+			&i.UpdatedAt,
+			&i.UpdatedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRegisteredTeamsByRoles = `-- name: ListRegisteredTeamsByRoles :many
+SELECT
+    guild_id,
+    role_id,
+    name,
+    tag,
+    logo_url,
+    created_at,
+    created_by,
+    updated_at,
+    updated_by
+FROM registered_teams
+WHERE guild_id = ?1
+AND role_id IN (/*SLICE::role_ids*/?)
+ORDER BY name
+`
+
+type ListRegisteredTeamsByRolesParams struct {
+	GuildID string   `db:"guild_id"`
+	RoleIds []string `db:":role_ids"`
+}
+
+func (q *Queries) ListRegisteredTeamsByRoles(ctx context.Context, arg ListRegisteredTeamsByRolesParams) ([]RegisteredTeam, error) {
+	query := listRegisteredTeamsByRoles
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.GuildID)
+	if len(arg.RoleIds) > 0 {
+		for _, v := range arg.RoleIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE::role_ids*/?", strings.Repeat(",?", len(arg.RoleIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE::role_ids*/?", "NULL", 1)
+	}
+	rows, err := q.query(ctx, nil, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []RegisteredTeam{}
+	for rows.Next() {
+		var i RegisteredTeam
+		if err := rows.Scan(
+			&i.GuildID,
+			&i.RoleID,
+			&i.Name,
+			&i.Tag,
+			&i.LogoUrl,
+			&i.CreatedAt,
+			&i.CreatedBy,
+			&i.UpdatedAt,
+			&i.UpdatedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTeamMembers = `-- name: ListTeamMembers :many
+SELECT
+    guild_id,
+    role_id,
+    user_id,
+    captain
+FROM team_members
+WHERE guild_id = ?1
+AND role_id = ?2
+ORDER BY captain DESC, user_id
+`
+
+type ListTeamMembersParams struct {
+	GuildID string `db:"guild_id"`
+	RoleID  string `db:"role_id"`
+}
+
+func (q *Queries) ListTeamMembers(ctx context.Context, arg ListTeamMembersParams) ([]TeamMember, error) {
+	rows, err := q.query(ctx, q.listTeamMembersStmt, listTeamMembers, arg.GuildID, arg.RoleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []TeamMember{}
+	for rows.Next() {
+		var i TeamMember
+		if err := rows.Scan(
+			&i.GuildID,
+			&i.RoleID,
+			&i.UserID,
+			&i.Captain,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const removeTeamMember = `-- name: RemoveTeamMember :exec
+DELETE FROM team_members
+WHERE guild_id = ?1
+AND role_id = ?2
+AND user_id = ?3
+`
+
+type RemoveTeamMemberParams struct {
+	GuildID string `db:"guild_id"`
+	RoleID  string `db:"role_id"`
+	UserID  string `db:"user_id"`
+}
+
+func (q *Queries) RemoveTeamMember(ctx context.Context, arg RemoveTeamMemberParams) error {
+	_, err := q.exec(ctx, q.removeTeamMemberStmt, removeTeamMember, arg.GuildID, arg.RoleID, arg.UserID)
+	return err
+}
+
+const resetTeamCaptains = `-- name: ResetTeamCaptains :exec
+UPDATE team_members
+SET captain = 0
+WHERE guild_id = ?1
+AND role_id = ?2
+`
+
+type ResetTeamCaptainsParams struct {
+	GuildID string `db:"guild_id"`
+	RoleID  string `db:"role_id"`
+}
+
+func (q *Queries) ResetTeamCaptains(ctx context.Context, arg ResetTeamCaptainsParams) error {
+	_, err := q.exec(ctx, q.resetTeamCaptainsStmt, resetTeamCaptains, arg.GuildID, arg.RoleID)
+	return err
+}
+
+const updateRegisteredTeam = `-- name: UpdateRegisteredTeam :exec
+UPDATE registered_teams
+SET
+    name = ?1,
+    tag = ?2,
+    logo_url = ?3,
+    updated_at = ?4,
+    updated_by = ?5
+WHERE guild_id = ?6
+AND role_id = ?7
+`
+
+type UpdateRegisteredTeamParams struct {
+	Name      string `db:"name"`
+	Tag       string `db:"tag"`
+	LogoUrl   string `db:"logo_url"`
+	UpdatedAt int64  `db:"updated_at"`
+	UpdatedBy string `db:"updated_by"`
+	GuildID   string `db:"guild_id"`
+	RoleID    string `db:"role_id"`
+}
+
+func (q *Queries) UpdateRegisteredTeam(ctx context.Context, arg UpdateRegisteredTeamParams) error {
+	_, err := q.exec(ctx, q.updateRegisteredTeamStmt, updateRegisteredTeam,
+		arg.Name,
+		arg.Tag,
+		arg.LogoUrl,
+		arg.UpdatedAt,
+		arg.UpdatedBy,
+		arg.GuildID,
+		arg.RoleID,
+	)
+	return err
+}
@@ -12,7 +12,7 @@ import (
 
 const addMatchTeam = `-- name: AddMatchTeam :exec
 INSERT INTO teams (
-    channel_id,
+    match_id,
     role_id
 ) VALUES (
     ?1,
@@ -21,142 +21,101 @@ INSERT INTO teams (
 `
 
 type AddMatchTeamParams struct {
-	ChannelID string `db:"channel_id"`
-	RoleID    string `db:"role_id"`
+	MatchID int64  `db:"match_id"`
+	RoleID  string `db:"role_id"`
 }
 
 func (q *Queries) AddMatchTeam(ctx context.Context, arg AddMatchTeamParams) error {
-	_, err := q.exec(ctx, q.addMatchTeamStmt, addMatchTeam, arg.ChannelID, arg.RoleID)
-	return err
-}
-
-const addMatchTeamResults = `-- name: AddMatchTeamResults :exec
-UPDATE teams
-SET
-    score = ?1,
-    screenshot = ?2,
-    demo = ?3
-WHERE channel_id = ?4
-AND role_id = ?5
-`
-
-type AddMatchTeamResultsParams struct {
-	Score      int64  `db:"score"`
-	Screenshot []byte `db:"screenshot"`
-	Demo       []byte `db:"demo"`
-	ChannelID  string `db:"channel_id"`
-	RoleID     string `db:"role_id"`
-}
-
-func (q *Queries) AddMatchTeamResults(ctx context.Context, arg AddMatchTeamResultsParams) error {
-	_, err := q.exec(ctx, q.addMatchTeamResultsStmt, addMatchTeamResults,
-		arg.Score,
-		arg.Screenshot,
-		arg.Demo,
-		arg.ChannelID,
-		arg.RoleID,
-	)
+	_, err := q.exec(ctx, q.addMatchTeamStmt, addMatchTeam, arg.MatchID, arg.RoleID)
 	return err
 }
 
 const decreaseMatchTeamConfirmedParticipants = `-- name: DecreaseMatchTeamConfirmedParticipants :exec
 UPDATE teams
 SET confirmed_participants = confirmed_participants - 1
-WHERE channel_id = ?1
+WHERE match_id = ?1
 AND role_id = ?2
 AND confirmed_participants > 0
 `
 
 type DecreaseMatchTeamConfirmedParticipantsParams struct {
-	ChannelID string `db:"channel_id"`
-	RoleID    string `db:"role_id"`
+	MatchID int64  `db:"match_id"`
+	RoleID  string `db:"role_id"`
 }
 
 func (q *Queries) DecreaseMatchTeamConfirmedParticipants(ctx context.Context, arg DecreaseMatchTeamConfirmedParticipantsParams) error {
-	_, err := q.exec(ctx, q.decreaseMatchTeamConfirmedParticipantsStmt, decreaseMatchTeamConfirmedParticipants, arg.ChannelID, arg.RoleID)
+	_, err := q.exec(ctx, q.decreaseMatchTeamConfirmedParticipantsStmt, decreaseMatchTeamConfirmedParticipants, arg.MatchID, arg.RoleID)
 	return err
 }
 
 const deleteAllMatchTeams = `-- name: DeleteAllMatchTeams :exec
 DELETE FROM teams
-WHERE channel_id = ?1
+WHERE match_id = ?1
 `
 
-func (q *Queries) DeleteAllMatchTeams(ctx context.Context, channelID string) error {
-	_, err := q.exec(ctx, q.deleteAllMatchTeamsStmt, deleteAllMatchTeams, channelID)
+func (q *Queries) DeleteAllMatchTeams(ctx context.Context, matchID int64) error {
+	_, err := q.exec(ctx, q.deleteAllMatchTeamsStmt, deleteAllMatchTeams, matchID)
 	return err
 }
 
 const deleteMatchTeam = `-- name: DeleteMatchTeam :exec
 DELETE FROM teams
-WHERE channel_id = ?1
+WHERE match_id = ?1
 AND role_id = ?2
 `
 
 type DeleteMatchTeamParams struct {
-	ChannelID string `db:"channel_id"`
-	RoleID    string `db:"role_id"`
+	MatchID int64  `db:"match_id"`
+	RoleID  string `db:"role_id"`
 }
 
 func (q *Queries) DeleteMatchTeam(ctx context.Context, arg DeleteMatchTeamParams) error {
-	_, err := q.exec(ctx, q.deleteMatchTeamStmt, deleteMatchTeam, arg.ChannelID, arg.RoleID)
+	_, err := q.exec(ctx, q.deleteMatchTeamStmt, deleteMatchTeam, arg.MatchID, arg.RoleID)
 	return err
 }
 
 const getMatchTeam = `-- name: GetMatchTeam :one
 SELECT
-    channel_id,
+    match_id,
     role_id,
     confirmed_participants
 FROM teams
-WHERE channel_id = ?1
+WHERE match_id = ?1
 AND role_id = ?2
 `
 
 type GetMatchTeamParams struct {
-	ChannelID string `db:"channel_id"`
-	RoleID    string `db:"role_id"`
-}
-
-type GetMatchTeamRow struct {
-	ChannelID             string `db:"channel_id"`
-	RoleID                string `db:"role_id"`
-	ConfirmedParticipants int64  `db:"confirmed_participants"`
+	MatchID int64  `db:"match_id"`
+	RoleID  string `db:"role_id"`
 }
 
-func (q *Queries) GetMatchTeam(ctx context.Context, arg GetMatchTeamParams) (GetMatchTeamRow, error) {
-	row := q.queryRow(ctx, q.getMatchTeamStmt, getMatchTeam, arg.ChannelID, arg.RoleID)
-	var i GetMatchTeamRow
-	err := row.Scan(&i.ChannelID, &i.RoleID, &i.ConfirmedParticipants)
+func (q *Queries) GetMatchTeam(ctx context.Context, arg GetMatchTeamParams) (Team, error) {
+	row := q.queryRow(ctx, q.getMatchTeamStmt, getMatchTeam, arg.MatchID, arg.RoleID)
+	var i Team
+	err := row.Scan(&i.MatchID, &i.RoleID, &i.ConfirmedParticipants)
 	return i, err
 }
 
 const getMatchTeamByRoles = `-- name: GetMatchTeamByRoles :many
 SELECT
-    channel_id,
+    match_id,
     role_id,
     confirmed_participants
 FROM teams
-WHERE channel_id = ?1
+WHERE match_id = ?1
 AND role_id IN (/*SLICE::role_ids*/?)
 ORDER BY role_id
 `
 
 type GetMatchTeamByRolesParams struct {
-	ChannelID string   `db:"channel_id"`
-	RoleIds   []string `db:":role_ids"`
-}
-
-type GetMatchTeamByRolesRow struct {
-	ChannelID             string `db:"channel_id"`
-	RoleID                string `db:"role_id"`
-	ConfirmedParticipants int64  `db:"confirmed_participants"`
+	MatchID int64    `db:"match_id"`
+	RoleIds []string `db:":role_ids"`
 }
 
-func (q *Queries) GetMatchTeamByRoles(ctx context.Context, arg GetMatchTeamByRolesParams) ([]GetMatchTeamByRolesRow, error) {
+func (q *Queries) GetMatchTeamByRoles(ctx context.Context, arg GetMatchTeamByRolesParams) ([]Team, error) {
 	query := getMatchTeamByRoles
 	var queryParams []interface{}
-	queryParams = append(queryParams, arg.ChannelID)
+	queryParams = append(queryParams, arg.MatchID)
 	if len(arg.RoleIds) > 0 {
 		for _, v := range arg.RoleIds {
 			queryParams = append(queryParams, v)
@@ -170,10 +129,10 @@ func (q *Queries) GetMatchTeamByRoles(ctx context.Context, arg GetMatchTeamByRol
 		return nil, err
 	}
 	defer rows.Close()
-	items := []GetMatchTeamByRolesRow{}
+	items := []Team{}
 	for rows.Next() {
-		var i GetMatchTeamByRolesRow
-		if err := rows.Scan(&i.ChannelID, &i.RoleID, &i.ConfirmedParticipants); err != nil {
+		var i Team
+		if err := rows.Scan(&i.MatchID, &i.RoleID, &i.ConfirmedParticipants); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -190,46 +149,40 @@ func (q *Queries) GetMatchTeamByRoles(ctx context.Context, arg GetMatchTeamByRol
 const increaseMatchTeamConfirmedParticipants = `-- name: IncreaseMatchTeamConfirmedParticipants :exec
 UPDATE teams
 SET confirmed_participants = confirmed_participants + 1
-WHERE channel_id = ?1
+WHERE match_id = ?1
 AND role_id = ?2
 `
 
 type IncreaseMatchTeamConfirmedParticipantsParams struct {
-	ChannelID string `db:"channel_id"`
-	RoleID    string `db:"role_id"`
+	MatchID int64  `db:"match_id"`
+	RoleID  string `db:"role_id"`
 }
 
 func (q *Queries) IncreaseMatchTeamConfirmedParticipants(ctx context.Context, arg IncreaseMatchTeamConfirmedParticipantsParams) error {
-	_, err := q.exec(ctx, q.increaseMatchTeamConfirmedParticipantsStmt, increaseMatchTeamConfirmedParticipants, arg.ChannelID, arg.RoleID)
+	_, err := q.exec(ctx, q.increaseMatchTeamConfirmedParticipantsStmt, increaseMatchTeamConfirmedParticipants, arg.MatchID, arg.RoleID)
 	return err
 }
 
 const listMatchTeams = `-- name: ListMatchTeams :many
 SELECT
-    channel_id,
+    match_id,
     role_id,
     confirmed_participants
 FROM teams
-WHERE channel_id = ?1
+WHERE match_id = ?1
 ORDER BY role_id
 `
 
-type ListMatchTeamsRow struct {
-	ChannelID             string `db:"channel_id"`
-	RoleID                string `db:"role_id"`
-	ConfirmedParticipants int64  `db:"confirmed_participants"`
-}
-
-func (q *Queries) ListMatchTeams(ctx context.Context, channelID string) ([]ListMatchTeamsRow, error) {
-	rows, err := q.query(ctx, q.listMatchTeamsStmt, listMatchTeams, channelID)
+func (q *Queries) ListMatchTeams(ctx context.Context, matchID int64) ([]Team, error) {
+	rows, err := q.query(ctx, q.listMatchTeamsStmt, listMatchTeams, matchID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []ListMatchTeamsRow{}
+	items := []Team{}
 	for rows.Next() {
-		var i ListMatchTeamsRow
-		if err := rows.Scan(&i.ChannelID, &i.RoleID, &i.ConfirmedParticipants); err != nil {
+		var i Team
+		if err := rows.Scan(&i.MatchID, &i.RoleID, &i.ConfirmedParticipants); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -242,3 +195,21 @@ func (q *Queries) ListMatchTeams(ctx context.Context, channelID string) ([]ListM
 	}
 	return items, nil
 }
+
+const setMatchTeamConfirmedParticipants = `-- name: SetMatchTeamConfirmedParticipants :exec
+UPDATE teams
+SET confirmed_participants = ?1
+WHERE match_id = ?2
+AND role_id = ?3
+`
+
+type SetMatchTeamConfirmedParticipantsParams struct {
+	ConfirmedParticipants int64  `db:"confirmed_participants"`
+	MatchID               int64  `db:"match_id"`
+	RoleID                string `db:"role_id"`
+}
+
+func (q *Queries) SetMatchTeamConfirmedParticipants(ctx context.Context, arg SetMatchTeamConfirmedParticipantsParams) error {
+	_, err := q.exec(ctx, q.setMatchTeamConfirmedParticipantsStmt, setMatchTeamConfirmedParticipants, arg.ConfirmedParticipants, arg.MatchID, arg.RoleID)
+	return err
+}